@@ -0,0 +1,102 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// authCmd is the parent command for storing the API key in the OS keyring
+// instead of the plain-text config file.
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Store the API key in the OS keyring",
+}
+
+// authLoginCmd saves the API key in the OS keyring (macOS Keychain, Linux
+// Secret Service, or Windows Credential Manager) and sets keyring: true in
+// the config so every command reads it from there. The key is read from
+// stdin without echo, offering MASSIVE_API_KEY when it is set, so it never
+// appears in shell history or on screen.
+// Usage: massive auth login
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Save your API key in the OS keyring",
+	Long:  "Store the API key in the OS keyring and switch the config to read it from there. Any api_key in ~/.config/massive/config.json is removed. MASSIVE_API_KEY and MASSIVE_API_KEY_FILE still take precedence when set.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reader := bufio.NewReader(os.Stdin)
+
+		var key string
+		if envKey := os.Getenv("MASSIVE_API_KEY"); envKey != "" {
+			fmt.Printf("Found API key in environment variable. Use it? [Y/n]: ")
+			answer, _ := reader.ReadString('\n')
+			answer = strings.TrimSpace(strings.ToLower(answer))
+			if answer == "" || answer == "y" || answer == "yes" {
+				key = envKey
+			}
+		}
+
+		if key == "" {
+			fmt.Print("Enter your Massive API key: ")
+			key = readSecret(reader)
+		}
+
+		if err := config.StoreAPIKeyInKeyring(key); err != nil {
+			return err
+		}
+
+		fmt.Println("API key saved in the OS keyring")
+		return nil
+	},
+}
+
+// readSecret reads one line of input for a secret such as the API key.
+// When stdin is a terminal the input is not echoed; piped input is read
+// from reader as usual.
+func readSecret(reader *bufio.Reader) string {
+	if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+		secret, err := term.ReadPassword(fd)
+		fmt.Println()
+		if err == nil {
+			return strings.TrimSpace(string(secret))
+		}
+	}
+
+	line, _ := reader.ReadString('\n')
+	return strings.TrimSpace(line)
+}
+
+// authLogoutCmd removes the API key from the OS keyring and turns keyring
+// lookups off in the config.
+// Usage: massive auth logout
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove your API key from the OS keyring",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.DeleteAPIKeyFromKeyring(); err != nil {
+			return err
+		}
+
+		fmt.Println("API key removed from the OS keyring")
+		return nil
+	},
+}
+
+// init registers the auth subcommands with the root command.
+func init() {
+	authCmd.AddCommand(authLoginCmd)
+	authCmd.AddCommand(authLogoutCmd)
+	rootCmd.AddCommand(authCmd)
+}
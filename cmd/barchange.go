@@ -0,0 +1,42 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+)
+
+// barChangePercents returns the CHG% cell for each bar: the percent change
+// of its close from the chronologically prior bar's close, so bars fetched
+// with --sort desc still compare against the previous period. point
+// extracts a bar's timestamp and close. The earliest bar, and any bar whose
+// prior close is zero, shows "-".
+func barChangePercents[T any](bars []T, point func(T) (timestamp int64, close float64)) []string {
+	timestamps := make([]int64, len(bars))
+	closes := make([]float64, len(bars))
+	order := make([]int, len(bars))
+	for i, bar := range bars {
+		timestamps[i], closes[i] = point(bar)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return timestamps[order[a]] < timestamps[order[b]]
+	})
+
+	cells := make([]string, len(bars))
+	for n, i := range order {
+		cells[i] = "-"
+		if n == 0 {
+			continue
+		}
+		if prev := closes[order[n-1]]; prev != 0 {
+			cells[i] = fmt.Sprintf("%.2f%%", (closes[i]-prev)/prev*100)
+		}
+	}
+
+	return cells
+}
@@ -0,0 +1,133 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// fetchEachConcurrency is the most single-ticker requests fetchEach runs
+// in parallel before the adaptive fetcher backs off.
+const fetchEachConcurrency = 4
+
+// tickerArgs upper-cases positional ticker arguments, also splitting any
+// comma-separated lists, so "X:BTCUSD x:ethusd,X:SOLUSD" yields three
+// tickers.
+func tickerArgs(args []string) []string {
+	tickers := make([]string, 0, len(args))
+	for _, arg := range args {
+		for _, t := range strings.Split(arg, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				tickers = append(tickers, strings.ToUpper(t))
+			}
+		}
+	}
+	return tickers
+}
+
+// addTickersFileFlag registers --tickers-file on a command that takes a
+// list of tickers, so large symbol universes can be read from a file or
+// stdin instead of the command line.
+func addTickersFileFlag(cmd *cobra.Command) {
+	cmd.Flags().String("tickers-file", "", "Read tickers from this file, newline- or comma-separated (- for stdin)")
+}
+
+// batchTickers combines positional ticker arguments with any read from
+// --tickers-file, normalized by tickerArgs and with duplicates removed in
+// first-seen order. It fails when neither source names a ticker.
+func batchTickers(cmd *cobra.Command, args []string) ([]string, error) {
+	raw := args
+	if path, _ := cmd.Flags().GetString("tickers-file"); path != "" {
+		fromFile, err := readTickersFile(path, cmd.InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+		raw = append(append([]string{}, args...), fromFile...)
+	}
+
+	seen := make(map[string]bool)
+	var tickers []string
+	for _, t := range tickerArgs(raw) {
+		if !seen[t] {
+			seen[t] = true
+			tickers = append(tickers, t)
+		}
+	}
+
+	if len(tickers) == 0 {
+		return nil, fmt.Errorf("at least one ticker is required (as arguments or with --tickers-file)")
+	}
+
+	return tickers, nil
+}
+
+// readTickersFile reads whitespace-, newline-, or comma-separated tickers
+// from path, or from stdin when path is "-". Text after a # on a line is
+// treated as a comment.
+func readTickersFile(path string, stdin io.Reader) ([]string, error) {
+	r := stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open tickers file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var tickers []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		tickers = append(tickers, strings.Fields(line)...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tickers file: %w", err)
+	}
+
+	return tickers, nil
+}
+
+// fetchEach calls fetch once per key with rate-limit-aware concurrency and
+// returns the results in key order. Any failure fails the whole batch;
+// with more than one key the error is labeled with the key that failed.
+func fetchEach[T any](client *api.Client, keys []string, fetch func(key string) (T, error)) ([]T, error) {
+	results := make([]T, len(keys))
+
+	fetcher := api.NewAdaptiveFetcher(client, fetchEachConcurrency)
+	err := fetcher.Run(len(keys), func(i int) error {
+		result, err := fetch(keys[i])
+		if err != nil {
+			if len(keys) > 1 {
+				return fmt.Errorf("%s: %w", keys[i], err)
+			}
+			return err
+		}
+		results[i] = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// printJSONEach prints a single result as-is, keeping the output of a
+// one-ticker call unchanged, or several results as a JSON array.
+func printJSONEach[T any](results []T) error {
+	if len(results) == 1 {
+		return printJSON(results[0])
+	}
+	return printJSON(results)
+}
@@ -6,7 +6,10 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"math/big"
 	"os"
 	"strings"
 	"text/tabwriter"
@@ -47,6 +50,17 @@ var benzingaNewsCmd = &cobra.Command{
 		author, _ := cmd.Flags().GetString("author")
 		limit, _ := cmd.Flags().GetString("limit")
 		sort, _ := cmd.Flags().GetString("sort")
+		search, _ := cmd.Flags().GetString("search")
+		useRegex, _ := cmd.Flags().GetBool("regex")
+
+		// Build the search first so a bad pattern fails before the request.
+		var matcher *api.BenzingaNewsSearch
+		if search != "" {
+			matcher, err = api.NewBenzingaNewsSearch(search, useRegex)
+			if err != nil {
+				return err
+			}
+		}
 
 		params := api.BenzingaNewsParams{
 			Tickers:      strings.ToUpper(tickers),
@@ -66,12 +80,19 @@ var benzingaNewsCmd = &cobra.Command{
 			return err
 		}
 
+		// The API has no full-text search, so --search filters the fetched
+		// page by title, teaser, and body.
+		if matcher != nil {
+			result.Results = matcher.Filter(result.Results)
+			result.Count = len(result.Results)
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
 		// Display results count header
-		fmt.Printf("Benzinga News Articles: %d\n\n", result.Count)
+		printSummary("Benzinga News Articles: %d", result.Count)
 
 		if len(result.Results) == 0 {
 			fmt.Println("No news articles found.")
@@ -80,8 +101,7 @@ var benzingaNewsCmd = &cobra.Command{
 
 		// Print each news article in a readable table format
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tAUTHOR\tTICKERS\tTITLE")
-		fmt.Fprintln(w, "----\t------\t-------\t-----")
+		writeHeader(w, "DATE\tAUTHOR\tTICKERS\tTITLE", "----\t------\t-------\t-----")
 
 		for _, article := range result.Results {
 			date := formatBenzingaDate(article.Published)
@@ -97,6 +117,69 @@ var benzingaNewsCmd = &cobra.Command{
 	},
 }
 
+// benzingaChannelsCmd lists the distinct news channels seen across all
+// Benzinga articles in a publication date range, with how many articles
+// carried each channel. Every page of results is fetched.
+// Usage: massive benzinga channels --published-from 2025-01-01 --published-to 2025-01-07
+var benzingaChannelsCmd = &cobra.Command{
+	Use:   "channels",
+	Short: "List news channels seen in a date range",
+	Long:  "Fetch every Benzinga news article in a publication date range, optionally limited to tickers, and list the distinct channels with their article counts.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		tickers, _ := cmd.Flags().GetString("tickers")
+		publishedGte, _ := cmd.Flags().GetString("published-from")
+		publishedLte, _ := cmd.Flags().GetString("published-to")
+		limit, _ := cmd.Flags().GetString("limit")
+
+		page, err := client.GetBenzingaNews(api.BenzingaNewsParams{
+			Tickers:      strings.ToUpper(tickers),
+			PublishedGte: publishedGte,
+			PublishedLte: publishedLte,
+			Limit:        limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		articles := page.Results
+		for page.NextURL != "" {
+			page, err = client.GetBenzingaNewsNext(page.NextURL)
+			if err != nil {
+				return err
+			}
+			articles = append(articles, page.Results...)
+		}
+
+		channels := api.CountBenzingaChannels(articles)
+
+		if outputFormat == "json" {
+			return printJSON(channels)
+		}
+
+		printSummary("Benzinga Channels: %d | Articles: %d", len(channels), len(articles))
+
+		if len(channels) == 0 {
+			fmt.Println("No channels found.")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "CHANNEL\tARTICLES", "-------\t--------")
+
+		for _, ch := range channels {
+			fmt.Fprintf(w, "%s\t%d\n", ch.Channel, ch.Articles)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
 // benzingaRatingsCmd retrieves Benzinga analyst ratings from the Massive API.
 // Supports filtering by ticker, date range, rating action, price target action,
 // and importance level. Results can be displayed as a table or raw JSON.
@@ -145,7 +228,7 @@ var benzingaRatingsCmd = &cobra.Command{
 		}
 
 		// Display results count header
-		fmt.Printf("Benzinga Analyst Ratings: %d\n\n", result.Count)
+		printSummary("Benzinga Analyst Ratings: %d", result.Count)
 
 		if len(result.Results) == 0 {
 			fmt.Println("No analyst ratings found.")
@@ -154,8 +237,7 @@ var benzingaRatingsCmd = &cobra.Command{
 
 		// Print each rating in a readable table format
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tTICKER\tFIRM\tACTION\tRATING\tPT\tPREV PT")
-		fmt.Fprintln(w, "----\t------\t----\t------\t------\t--\t-------")
+		writeHeader(w, "DATE\tTICKER\tFIRM\tACTION\tRATING\tPT\tPREV PT", "----\t------\t----\t------\t------\t--\t-------")
 
 		for _, rating := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.2f\t%.2f\n",
@@ -170,6 +252,83 @@ var benzingaRatingsCmd = &cobra.Command{
 	},
 }
 
+// benzingaFirmsCmd aggregates every analyst rating in a date range by
+// research firm: rating count, tickers covered, upgrades and downgrades,
+// and the average price target. Every page of ratings is fetched.
+// Usage: massive benzinga firms --date-from 2025-01-01 --date-to 2025-01-31
+var benzingaFirmsCmd = &cobra.Command{
+	Use:   "firms",
+	Short: "Summarize analyst ratings by research firm",
+	Long:  "Fetch every Benzinga analyst rating in a date range, optionally limited to tickers, and summarize each research firm's ratings: count, tickers covered, upgrades, downgrades, upgrade/downgrade ratio, and average price target.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker, _ := cmd.Flags().GetString("ticker")
+		tickerAnyOf, _ := cmd.Flags().GetString("ticker-any-of")
+		dateGte, _ := cmd.Flags().GetString("date-from")
+		dateLte, _ := cmd.Flags().GetString("date-to")
+		importance, _ := cmd.Flags().GetString("importance")
+		limit, _ := cmd.Flags().GetString("limit")
+
+		page, err := client.GetBenzingaRatings(api.BenzingaRatingsParams{
+			Ticker:      strings.ToUpper(ticker),
+			TickerAnyOf: strings.ToUpper(tickerAnyOf),
+			DateGte:     dateGte,
+			DateLte:     dateLte,
+			Importance:  importance,
+			Limit:       limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		ratings := page.Results
+		for page.NextURL != "" {
+			page, err = client.GetBenzingaRatingsNext(page.NextURL)
+			if err != nil {
+				return err
+			}
+			ratings = append(ratings, page.Results...)
+		}
+
+		firms := api.SummarizeBenzingaFirms(ratings)
+
+		if outputFormat == "json" {
+			return printJSON(firms)
+		}
+
+		printSummary("Benzinga Firms: %d | Ratings: %d", len(firms), len(ratings))
+
+		if len(firms) == 0 {
+			fmt.Println("No analyst ratings found.")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "FIRM\tRATINGS\tTICKERS\tUPGRADES\tDOWNGRADES\tUP/DOWN\tAVG PT", "----\t-------\t-------\t--------\t----------\t-------\t------")
+
+		for _, f := range firms {
+			ratio := "-"
+			if f.UpgradeDowngradeRatio != nil {
+				ratio = fmt.Sprintf("%.2f", *f.UpgradeDowngradeRatio)
+			}
+			avgPT := "-"
+			if f.PriceTargets > 0 {
+				avgPT = fmt.Sprintf("%.2f", f.AvgPriceTarget)
+			}
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
+				truncateBenzingaString(f.Firm, 30),
+				f.Ratings, f.Tickers, f.Upgrades, f.Downgrades, ratio, avgPT)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
 // benzingaEarningsCmd retrieves Benzinga earnings data from the Massive API.
 // Supports filtering by ticker, date range, fiscal period, date status,
 // and importance level. Results can be displayed as a table or raw JSON.
@@ -220,31 +379,34 @@ var benzingaEarningsCmd = &cobra.Command{
 		}
 
 		// Display results count header
-		fmt.Printf("Benzinga Earnings Reports: %d\n\n", result.Count)
+		printSummary("Benzinga Earnings Reports: %d", result.Count)
 
 		if len(result.Results) == 0 {
 			fmt.Println("No earnings reports found.")
 			return nil
 		}
 
-		// Print each earnings record in a readable table format
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tTICKER\tCOMPANY\tSTATUS\tACT EPS\tEST EPS\tEPS SURP%\tPERIOD")
-		fmt.Fprintln(w, "----\t------\t-------\t------\t-------\t-------\t---------\t------")
+		printBenzingaEarningsTable(os.Stdout, result.Results)
+		return nil
+	},
+}
 
-		for _, earn := range result.Results {
-			company := truncateBenzingaString(earn.CompanyName, 20)
-			period := fmt.Sprintf("%s %d", earn.FiscalPeriod, earn.FiscalYear)
+// printBenzingaEarningsTable writes earnings records as a table, with
+// revenue figures as plain numbers exactly as the API sent them.
+func printBenzingaEarningsTable(out io.Writer, results []api.BenzingaEarnings) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	writeHeader(w, "DATE\tTICKER\tCOMPANY\tSTATUS\tACT EPS\tEST EPS\tEPS SURP%\tACT REV\tEST REV\tPERIOD", "----\t------\t-------\t------\t-------\t-------\t---------\t-------\t-------\t------")
 
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f\t%.2f\t%.2f%%\t%s\n",
-				earn.Date, earn.Ticker, company, earn.DateStatus,
-				earn.ActualEPS, earn.EstimatedEPS, earn.EPSSurprisePercent,
-				period)
-		}
-		w.Flush()
+	for _, earn := range results {
+		company := truncateBenzingaString(earn.CompanyName, 20)
+		period := fmt.Sprintf("%s %d", earn.FiscalPeriod, earn.FiscalYear)
 
-		return nil
-	},
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f\t%.2f\t%.2f%%\t%s\t%s\t%s\n",
+			earn.Date, earn.Ticker, company, earn.DateStatus,
+			earn.ActualEPS, earn.EstimatedEPS, earn.EPSSurprisePercent,
+			plainNumber(earn.ActualRevenue), plainNumber(earn.EstimatedRevenue), period)
+	}
+	w.Flush()
 }
 
 // benzingaGuidanceCmd retrieves Benzinga corporate guidance data from the
@@ -298,7 +460,7 @@ var benzingaGuidanceCmd = &cobra.Command{
 		}
 
 		// Display results count header
-		fmt.Printf("Benzinga Corporate Guidance: %d\n\n", result.Count)
+		printSummary("Benzinga Corporate Guidance: %d", result.Count)
 
 		if len(result.Results) == 0 {
 			fmt.Println("No corporate guidance found.")
@@ -307,14 +469,13 @@ var benzingaGuidanceCmd = &cobra.Command{
 
 		// Print each guidance record in a readable table format
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tTICKER\tCOMPANY\tPOS\tEPS RANGE\tREV RANGE\tPERIOD")
-		fmt.Fprintln(w, "----\t------\t-------\t---\t---------\t---------\t------")
+		writeHeader(w, "DATE\tTICKER\tCOMPANY\tPOS\tEPS RANGE\tREV RANGE\tPERIOD", "----\t------\t-------\t---\t---------\t---------\t------")
 
 		for _, guide := range result.Results {
 			company := truncateBenzingaString(guide.CompanyName, 20)
 			period := fmt.Sprintf("%s %d", guide.FiscalPeriod, guide.FiscalYear)
 			epsRange := fmt.Sprintf("%.2f-%.2f", guide.MinEPSGuidance, guide.MaxEPSGuidance)
-			revRange := fmt.Sprintf("%.0f-%.0f", guide.MinRevenueGuidance, guide.MaxRevenueGuidance)
+			revRange := plainNumber(guide.MinRevenueGuidance) + "-" + plainNumber(guide.MaxRevenueGuidance)
 
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 				guide.Date, guide.Ticker, company, guide.Positioning,
@@ -366,7 +527,7 @@ var benzingaAnalystsCmd = &cobra.Command{
 		}
 
 		// Display results count header
-		fmt.Printf("Benzinga Analysts: %d\n\n", len(result.Results))
+		printSummary("Benzinga Analysts: %d", len(result.Results))
 
 		if len(result.Results) == 0 {
 			fmt.Println("No analysts found.")
@@ -375,8 +536,7 @@ var benzingaAnalystsCmd = &cobra.Command{
 
 		// Print each analyst in a readable table format
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "NAME\tFIRM\tSCORE\tSUCCESS RATE\tAVG RETURN\tRATINGS")
-		fmt.Fprintln(w, "----\t----\t-----\t------------\t----------\t-------")
+		writeHeader(w, "NAME\tFIRM\tSCORE\tSUCCESS RATE\tAVG RETURN\tRATINGS", "----\t----\t-----\t------------\t----------\t-------")
 
 		for _, analyst := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%.1f\t%.0f%%\t%.1f%%\t%.0f\n",
@@ -413,15 +573,44 @@ func truncateBenzingaString(s string, max int) string {
 	return s[:max-3] + "..."
 }
 
+// plainNumber renders an API number in plain decimal notation without
+// losing digits: 1.2e+11 prints as 120000000000 and integers beyond 2^53
+// print exactly. A missing value prints as "-", and anything that does not
+// parse as a number is shown as sent.
+func plainNumber(n json.Number) string {
+	if n == "" {
+		return "-"
+	}
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return n.String()
+	}
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	// A decimal input has a denominator of the form 2^a*5^b, so it is
+	// exact with max(a, b) decimals; find the fewest that reproduce it.
+	for decimals := 1; decimals <= 64; decimals++ {
+		s := r.FloatString(decimals)
+		if back, _ := new(big.Rat).SetString(s); back.Cmp(r) == 0 {
+			return s
+		}
+	}
+	return n.String()
+}
+
 // init registers the benzinga parent command with the root command and
-// registers all Benzinga subcommands (news, ratings, earnings, guidance,
-// analysts) along with their flags.
+// registers all Benzinga subcommands (news, channels, ratings, firms,
+// earnings, guidance, analysts) along with their flags.
 func init() {
 	rootCmd.AddCommand(benzingaCmd)
 
 	// Register subcommands under benzinga parent
 	benzingaCmd.AddCommand(benzingaNewsCmd)
+	benzingaCmd.AddCommand(benzingaChannelsCmd)
 	benzingaCmd.AddCommand(benzingaRatingsCmd)
+	benzingaCmd.AddCommand(benzingaFirmsCmd)
 	benzingaCmd.AddCommand(benzingaEarningsCmd)
 	benzingaCmd.AddCommand(benzingaGuidanceCmd)
 	benzingaCmd.AddCommand(benzingaAnalystsCmd)
@@ -437,6 +626,14 @@ func init() {
 	benzingaNewsCmd.Flags().String("author", "", "Filter by author name")
 	benzingaNewsCmd.Flags().String("limit", "10", "Number of results to return (max 50000)")
 	benzingaNewsCmd.Flags().String("sort", "published.desc", "Sort order (e.g., published.asc, published.desc)")
+	benzingaNewsCmd.Flags().String("search", "", "Only show articles whose title, teaser, or body contains this text (case-insensitive)")
+	benzingaNewsCmd.Flags().Bool("regex", false, "Treat --search as a regular expression")
+
+	// Benzinga Channels flags
+	benzingaChannelsCmd.Flags().String("tickers", "", "Only count articles about these tickers (e.g., AAPL)")
+	benzingaChannelsCmd.Flags().String("published-from", "", "Count articles published on or after this date (ISO 8601)")
+	benzingaChannelsCmd.Flags().String("published-to", "", "Count articles published on or before this date (ISO 8601)")
+	benzingaChannelsCmd.Flags().String("limit", "1000", "Articles fetched per page (max 50000)")
 
 	// Benzinga Ratings flags
 	benzingaRatingsCmd.Flags().String("ticker", "", "Filter by ticker symbol (e.g., AAPL)")
@@ -450,6 +647,14 @@ func init() {
 	benzingaRatingsCmd.Flags().String("limit", "10", "Number of results to return (max 50000)")
 	benzingaRatingsCmd.Flags().String("sort", "date.desc", "Sort order (e.g., date.asc, date.desc)")
 
+	// Benzinga Firms flags
+	benzingaFirmsCmd.Flags().String("ticker", "", "Only aggregate ratings for this ticker (e.g., AAPL)")
+	benzingaFirmsCmd.Flags().String("ticker-any-of", "", "Only aggregate ratings for any of these tickers (comma-separated)")
+	benzingaFirmsCmd.Flags().String("date-from", "", "Aggregate ratings on or after this date (YYYY-MM-DD)")
+	benzingaFirmsCmd.Flags().String("date-to", "", "Aggregate ratings on or before this date (YYYY-MM-DD)")
+	benzingaFirmsCmd.Flags().String("importance", "", "Only aggregate ratings with this importance level (0-5)")
+	benzingaFirmsCmd.Flags().String("limit", "1000", "Ratings fetched per page (max 50000)")
+
 	// Benzinga Earnings flags
 	benzingaEarningsCmd.Flags().String("ticker", "", "Filter by ticker symbol (e.g., AAPL)")
 	benzingaEarningsCmd.Flags().String("ticker-any-of", "", "Filter by any of these tickers (comma-separated)")
@@ -0,0 +1,58 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestPlainNumber verifies API numbers print in plain decimal notation
+// with every digit kept.
+func TestPlainNumber(t *testing.T) {
+	tests := []struct {
+		in   json.Number
+		want string
+	}{
+		{"124500000000", "124500000000"},
+		{"9007199254740993", "9007199254740993"},
+		{"1.2e+11", "120000000000"},
+		{"35082000000.5", "35082000000.5"},
+		{"-84918000000.25", "-84918000000.25"},
+		{"1.5E-2", "0.015"},
+		{"0", "0"},
+		{"", "-"},
+	}
+
+	for _, tt := range tests {
+		if got := plainNumber(tt.in); got != tt.want {
+			t.Errorf("plainNumber(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestPrintBenzingaEarningsTable verifies the earnings table renders
+// revenue above 2^53 and in exponent form as exact plain integers.
+func TestPrintBenzingaEarningsTable(t *testing.T) {
+	var result api.BenzingaEarningsResponse
+	body := `{"results":[{"ticker":"AAPL","actual_revenue":9007199254740993,"estimated_revenue":1.2e+11,"fiscal_period":"Q1","fiscal_year":2026}]}`
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	printBenzingaEarningsTable(&buf, result.Results)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	row := strings.Fields(lines[len(lines)-1])
+	if len(row) < 8 || row[len(row)-4] != "9007199254740993" || row[len(row)-3] != "120000000000" {
+		t.Errorf("earnings row = %q, want ACT REV 9007199254740993 and EST REV 120000000000", lines[len(lines)-1])
+	}
+}
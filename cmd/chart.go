@@ -0,0 +1,83 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"sort"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// chartJSONFormat is the --output value that prints bars in the OHLC
+// shape expected by charting libraries such as TradingView Lightweight
+// Charts and ECharts.
+const chartJSONFormat = "chart-json"
+
+// chartJSONAnnotation marks commands that support chart-json output.
+// Other commands reject the format instead of silently printing a table.
+const chartJSONAnnotation = "chart-json"
+
+// supportsChartJSON marks cmd as able to render chart-json output.
+func supportsChartJSON(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[chartJSONAnnotation] = "true"
+}
+
+// chartBar is one bar in chart-json output. Time is in epoch seconds.
+type chartBar struct {
+	Time   int64   `json:"time"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"`
+}
+
+// chartBarsFromAggs converts aggregate bars, whose timestamps are in
+// milliseconds, to chart bars.
+func chartBarsFromAggs(bars []api.Bar) []chartBar {
+	out := make([]chartBar, len(bars))
+	for i, b := range bars {
+		out[i] = chartBar{
+			Time:   b.Timestamp / 1000,
+			Open:   b.Open,
+			High:   b.High,
+			Low:    b.Low,
+			Close:  b.Close,
+			Volume: b.Volume,
+		}
+	}
+	return out
+}
+
+// chartBarsFromFutures converts futures bars, whose window start is in
+// nanoseconds, to chart bars.
+func chartBarsFromFutures(bars []api.FuturesBar) []chartBar {
+	out := make([]chartBar, len(bars))
+	for i, b := range bars {
+		out[i] = chartBar{
+			Time:   b.WindowStart / 1e9,
+			Open:   b.Open,
+			High:   b.High,
+			Low:    b.Low,
+			Close:  b.Close,
+			Volume: b.Volume,
+		}
+	}
+	return out
+}
+
+// printChartJSON prints bars as a JSON array sorted oldest first, since
+// charting libraries require ascending time regardless of --sort.
+func printChartJSON(bars []chartBar) error {
+	sort.SliceStable(bars, func(i, j int) bool {
+		return bars[i].Time < bars[j].Time
+	})
+	return printJSON(bars)
+}
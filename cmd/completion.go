@@ -0,0 +1,144 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// completionMarkets are the markets whose ticker index backs shell
+// completion, and the default set completion refresh syncs.
+var completionMarkets = []string{"stocks", "crypto", "fx", "indices"}
+
+// tickerIndexMarkets are the markets the reference tickers endpoint can
+// sync into a ticker index.
+var tickerIndexMarkets = []string{"stocks", "otc", "crypto", "fx", "indices"}
+
+// completionRefreshResult records the outcome of syncing one market's
+// ticker index. Err is set when the sync failed.
+type completionRefreshResult struct {
+	Market    string    `json:"market"`
+	Count     int       `json:"count"`
+	Refreshed bool      `json:"refreshed"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Error     string    `json:"error,omitempty"`
+	Err       error     `json:"-"`
+}
+
+// completionRefreshCmd pre-warms the ticker indexes that shell completion
+// reads, so suggestions work offline from the first tab. It shares the
+// sync-tickers machinery, one market after another.
+// Usage: massive completion refresh --markets stocks,crypto
+var completionRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Download the ticker lists that shell completion uses",
+	Long:  "Pull every active ticker for each completion market (stocks, crypto, fx, and indices by default) into the local index, the same one 'massive reference sync-tickers' writes, and print the count per market. Indexes younger than 24 hours are kept unless --force is given. A failed market does not stop the others; the command exits non-zero if any failed.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		markets, _ := cmd.Flags().GetStringSlice("markets")
+		force, _ := cmd.Flags().GetBool("force")
+		for i, market := range markets {
+			markets[i] = strings.ToLower(strings.TrimSpace(market))
+			if !slices.Contains(tickerIndexMarkets, markets[i]) {
+				return fmt.Errorf("unknown market %q: must be one of %s", market, strings.Join(tickerIndexMarkets, ", "))
+			}
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		dir, err := config.CacheDir()
+		if err != nil {
+			return err
+		}
+
+		results := make([]completionRefreshResult, len(markets))
+		for i, market := range markets {
+			results[i].Market = market
+
+			ix, refreshed, err := syncTickerIndex(client, dir, market, force)
+			if err != nil {
+				results[i].Err = err
+				results[i].Error = err.Error()
+				continue
+			}
+			results[i].Count = len(ix.Tickers)
+			results[i].Refreshed = refreshed
+			results[i].FetchedAt = ix.FetchedAt
+		}
+
+		var failed []completionRefreshResult
+		for _, r := range results {
+			if r.Err != nil {
+				failed = append(failed, r)
+			}
+		}
+
+		if outputFormat == "json" {
+			if err := printJSON(results); err != nil {
+				return err
+			}
+		} else {
+			printCompletionRefresh(results, dir)
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("%d of %d markets failed to refresh; first: %s: %w", len(failed), len(results), failed[0].Market, failed[0].Err)
+		}
+		return nil
+	},
+}
+
+// printCompletionRefresh prints one row per market with its ticker count
+// and whether it was pulled or already fresh, or the error that stopped it.
+func printCompletionRefresh(results []completionRefreshResult, dir string) {
+	printSummary("Markets: %d | Directory: %s", len(results), dir)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "MARKET\tSTATUS\tTICKERS", "------\t------\t-------")
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			fmt.Fprintf(w, "%s\tFAILED\t-\n", r.Market)
+		case r.Refreshed:
+			fmt.Fprintf(w, "%s\tSynced\t%d\n", r.Market, r.Count)
+		default:
+			fmt.Fprintf(w, "%s\tFresh\t%d\n", r.Market, r.Count)
+		}
+	}
+	w.Flush()
+}
+
+// initCompletionCmd creates Cobra's default completion command early, so
+// refresh can sit next to its bash/zsh/fish/powershell subcommands. It
+// must run after every init has added its commands, since Cobra only
+// creates the completion command for roots that have subcommands.
+func initCompletionCmd() {
+	rootCmd.InitDefaultCompletionCmd()
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "completion" {
+			c.AddCommand(completionRefreshCmd)
+			return
+		}
+	}
+}
+
+// init registers the completion refresh flags. The command itself is
+// attached by initCompletionCmd.
+func init() {
+	completionRefreshCmd.Flags().StringSlice("markets", completionMarkets, "Markets to sync (stocks, otc, crypto, fx, indices)")
+	completionRefreshCmd.Flags().Bool("force", false, "Refresh even if a cached list is still fresh")
+}
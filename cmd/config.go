@@ -116,6 +116,7 @@ var configShowCmd = &cobra.Command{
 
 		fmt.Printf("Base URL:       %s\n", cfg.BaseURL)
 		fmt.Printf("API Key:        %s\n", maskedKey)
+		fmt.Printf("Keyring:        %v\n", cfg.Keyring)
 		fmt.Printf("S3 Endpoint:    %s\n", cfg.S3Endpoint)
 		fmt.Printf("S3 Access Key:  %s\n", maskedS3Access)
 		fmt.Printf("S3 Secret Key:  %s\n", maskedS3Secret)
@@ -7,11 +7,17 @@ package cmd
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 
+	"github.com/cloudmanic/massive-cli/internal/analytics"
 	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/spf13/cobra"
 )
@@ -44,12 +50,22 @@ var cryptoBarsCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		multiplier, _ := cmd.Flags().GetString("multiplier")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
 		adjusted, _ := cmd.Flags().GetString("adjusted")
 		sort, _ := cmd.Flags().GetString("sort")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitAggs, limit)
 
 		params := api.BarsParams{
 			Multiplier: multiplier,
@@ -61,29 +77,125 @@ var cryptoBarsCmd = &cobra.Command{
 			Limit:      limit,
 		}
 
+		if err := confirmExplain(barsExplanation{
+			Ticker:     ticker,
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   adjusted,
+			Sort:       sort,
+			Limit:      limit,
+		}); err != nil {
+			return err
+		}
+
 		result, err := client.GetCryptoBars(ticker, params)
 		if err != nil {
 			return err
 		}
+		checkBarCounts(result.QueryCount, result.ResultsCount, limit)
+
+		if outputFormat == chartJSONFormat {
+			return printChartJSON(chartBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromAggs(result.Results))
+		}
 
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Bars: %d | Adjusted: %v\n\n", result.Ticker, result.ResultsCount, result.Adjusted)
+		printCryptoBarsTable(result, "2006-01-02")
 
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----\t------\t----\t------")
+		return nil
+	},
+}
 
-		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+// printCryptoBarsTable renders crypto aggregate bars as a table with a
+// summary header line and the optional --stats footer. layout formats each
+// bar's timestamp, so intraday views can include the time of day.
+func printCryptoBarsTable(result *api.BarsResponse, layout string) {
+	printSummary("Ticker: %s | Bars: %d | Adjusted: %v", result.Ticker, result.ResultsCount, result.Adjusted)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tCHG%\tRANGE\tVOLUME\tVWAP\tTRADES", "----\t----\t----\t---\t-----\t----\t-----\t------\t----\t------")
+
+	var stats barStats
+	stats.setAdjusted(result.Adjusted)
+	changes := barChangePercents(result.Results, func(b api.Bar) (int64, float64) { return b.Timestamp, b.Close })
+	for i, bar := range result.Results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+			timestampCell(bar.Timestamp, api.UnitMilliseconds, layout),
+			priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+			priceCell("%.4f", bar.Close), changes[i], priceCell("%.4f", bar.High-bar.Low),
+			bar.Volume, priceCell("%.4f", bar.VWAP), bar.NumTrades)
+		stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, bar.Volume)
+	}
+	stats.writeFooter(w, 10, "%.4f", 2, 3, 4, 7)
+	w.Flush()
+}
+
+// cryptoIntradayCmd retrieves the most recent N intraday bars of a given
+// minute size without any date math: the time window is computed from the
+// current time. Minutes with no trades produce no bar, so the window is
+// padded and the newest --bars bars are kept.
+// Usage: massive crypto intraday X:BTCUSD --minutes 5 --bars 100
+var cryptoIntradayCmd = &cobra.Command{
+	Use:   "intraday [ticker]",
+	Short: "Get the most recent intraday minute bars for a crypto ticker",
+	Long:  "Retrieve the latest N minute bars (e.g. the last 100 five-minute bars) for a crypto ticker, computing the time window ending now automatically.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		minutes, _ := cmd.Flags().GetInt("minutes")
+		bars, _ := cmd.Flags().GetInt("bars")
+
+		if minutes <= 0 || bars <= 0 {
+			return fmt.Errorf("--minutes and --bars must be positive integers")
+		}
+
+		from, to := intradayWindow(time.Now(), minutes, bars)
+
+		// Fetch newest first so the limit keeps the latest bars, then flip
+		// to oldest first for display.
+		params := api.BarsParams{
+			Multiplier: strconv.Itoa(minutes),
+			Timespan:   "minute",
+			From:       from,
+			To:         to,
+			Adjusted:   "true",
+			Sort:       "desc",
+			Limit:      clampLimit(api.LimitAggs, strconv.Itoa(bars)),
+		}
+
+		result, err := client.GetCryptoBars(ticker, params)
+		if err != nil {
+			return err
+		}
+
+		slices.Reverse(result.Results)
+
+		if outputFormat == chartJSONFormat {
+			return printChartJSON(chartBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
 		}
-		w.Flush()
+
+		printCryptoBarsTable(result, "2006-01-02 15:04")
 
 		return nil
 	},
@@ -91,8 +203,9 @@ var cryptoBarsCmd = &cobra.Command{
 
 // cryptoDailyMarketSummaryCmd retrieves the grouped daily OHLC summary
 // for all crypto tickers on a specified date. Useful for broad crypto
-// market analysis and screening.
-// Usage: massive crypto daily-market-summary 2024-01-09
+// market analysis and screening: --sort-by and --top surface the most
+// active pairs, and --group-by market totals them by quote currency.
+// Usage: massive crypto daily-market-summary 2024-01-09 --sort-by volume --top 20
 var cryptoDailyMarketSummaryCmd = &cobra.Command{
 	Use:   "daily-market-summary [date]",
 	Short: "Get daily market summary for all crypto tickers",
@@ -104,28 +217,38 @@ var cryptoDailyMarketSummaryCmd = &cobra.Command{
 			return err
 		}
 
+		screen, err := summaryScreenFlags(cmd)
+		if err != nil {
+			return err
+		}
+
 		date := args[0]
 		adjusted, _ := cmd.Flags().GetString("adjusted")
+		locale, _ := cmd.Flags().GetString("locale")
 
-		result, err := client.GetCryptoDailyMarketSummary(date, adjusted)
+		result, err := client.GetCryptoDailyMarketSummary(date, adjusted, locale)
 		if err != nil {
 			return err
 		}
 
+		if screen.GroupBy != "" {
+			return printSummaryGroups(screen, date, result.Results, analytics.CryptoQuoteCurrency)
+		}
+		screen.apply(result)
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Date: %s | Tickers: %d | Adjusted: %v\n\n", date, result.ResultsCount, result.Adjusted)
+		printSummary("Date: %s | Tickers: %d | Adjusted: %v", date, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "------\t----\t----\t---\t-----\t------\t----\t------")
+		writeHeader(w, "TICKER\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES", "------\t----\t----\t---\t-----\t------\t----\t------")
 
 		for _, s := range result.Results {
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				s.Ticker, s.Open, s.High, s.Low, s.Close,
-				s.Volume, s.VWAP, s.NumTrades)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				s.Ticker, priceCell("%.4f", s.Open), priceCell("%.4f", s.High), priceCell("%.4f", s.Low),
+				priceCell("%.4f", s.Close), s.Volume, priceCell("%.4f", s.VWAP), s.NumTrades)
 		}
 		w.Flush()
 
@@ -163,19 +286,17 @@ var cryptoDailyTickerSummaryCmd = &cobra.Command{
 		}
 
 		fmt.Printf("Symbol: %s | Date: %s | UTC: %v\n", result.Symbol, result.Day, result.IsUTC)
-		fmt.Printf("Open:   %.4f\n", result.Open)
-		fmt.Printf("Close:  %.4f\n\n", result.Close)
+		fmt.Printf("Open:   %s\n", priceCell("%.4f", result.Open))
+		fmt.Printf("Close:  %s\n\n", priceCell("%.4f", result.Close))
 
 		if len(result.OpenTrades) > 0 {
 			fmt.Printf("Open Trades: %d\n", len(result.OpenTrades))
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "ID\tPRICE\tSIZE\tEXCHANGE\tTIMESTAMP")
-			fmt.Fprintln(w, "--\t-----\t----\t--------\t---------")
+			writeHeader(w, "ID\tPRICE\tSIZE\tEXCHANGE\tTIMESTAMP", "--\t-----\t----\t--------\t---------")
 			for _, trade := range result.OpenTrades {
-				t := time.UnixMilli(trade.Timestamp)
-				fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%d\t%s\n",
-					trade.ID, trade.Price, trade.Size, trade.Exchange,
-					t.Format("2006-01-02 15:04:05"))
+				fmt.Fprintf(w, "%s\t%s\t%.4f\t%d\t%s\n",
+					trade.ID, priceCell("%.4f", trade.Price), trade.Size, trade.Exchange,
+					timestampCell(trade.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04:05"))
 			}
 			w.Flush()
 			fmt.Println()
@@ -184,13 +305,11 @@ var cryptoDailyTickerSummaryCmd = &cobra.Command{
 		if len(result.ClosingTrades) > 0 {
 			fmt.Printf("Closing Trades: %d\n", len(result.ClosingTrades))
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "ID\tPRICE\tSIZE\tEXCHANGE\tTIMESTAMP")
-			fmt.Fprintln(w, "--\t-----\t----\t--------\t---------")
+			writeHeader(w, "ID\tPRICE\tSIZE\tEXCHANGE\tTIMESTAMP", "--\t-----\t----\t--------\t---------")
 			for _, trade := range result.ClosingTrades {
-				t := time.UnixMilli(trade.Timestamp)
-				fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%d\t%s\n",
-					trade.ID, trade.Price, trade.Size, trade.Exchange,
-					t.Format("2006-01-02 15:04:05"))
+				fmt.Fprintf(w, "%s\t%s\t%.4f\t%d\t%s\n",
+					trade.ID, priceCell("%.4f", trade.Price), trade.Size, trade.Exchange,
+					timestampCell(trade.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04:05"))
 			}
 			w.Flush()
 		}
@@ -226,19 +345,21 @@ var cryptoPreviousDayBarCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Adjusted: %v\n\n", result.Ticker, result.Adjusted)
+		printSummary("Ticker: %s | Adjusted: %v", result.Ticker, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----\t------\t----\t------")
+		writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES", "----\t----\t----\t---\t-----\t------\t----\t------")
 
+		var stats barStats
+		stats.setAdjusted(result.Adjusted)
 		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close), bar.Volume, priceCell("%.4f", bar.VWAP), bar.NumTrades)
+			stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, bar.Volume)
 		}
+		stats.writeFooter(w, 8, "%.4f", 2, 3, 4, 5)
 		w.Flush()
 
 		return nil
@@ -273,19 +394,7 @@ var cryptoConditionsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Conditions: %d\n\n", result.Count)
-
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "ID\tNAME\tTYPE\tASSET CLASS\tDATA TYPES")
-		fmt.Fprintln(w, "--\t----\t----\t-----------\t----------")
-
-		for _, c := range result.Results {
-			dataTypes := strings.Join(c.DataTypes, ", ")
-			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
-				c.ID, c.Name, c.Type, c.AssetClass, dataTypes)
-		}
-		w.Flush()
-
+		printConditionsTable(result)
 		return nil
 	},
 }
@@ -313,11 +422,10 @@ var cryptoExchangesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Exchanges: %d\n\n", result.Count)
+		printSummary("Exchanges: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "ID\tNAME\tACRONYM\tTYPE\tLOCALE")
-		fmt.Fprintln(w, "--\t----\t-------\t----\t------")
+		writeHeader(w, "ID\tNAME\tACRONYM\tTYPE\tLOCALE", "--\t----\t-------\t----\t------")
 
 		for _, e := range result.Results {
 			acronym := e.Acronym
@@ -362,11 +470,10 @@ var cryptoMarketHolidaysCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Upcoming Market Holidays: %d\n\n", len(result))
+		printSummary("Upcoming Market Holidays: %d", len(result))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tEXCHANGE\tNAME\tSTATUS\tOPEN\tCLOSE")
-		fmt.Fprintln(w, "----\t--------\t----\t------\t----\t-----")
+		writeHeader(w, "DATE\tEXCHANGE\tNAME\tSTATUS\tOPEN\tCLOSE", "----\t--------\t----\t------\t----\t-----")
 
 		for _, h := range result {
 			openTime := "-"
@@ -414,14 +521,12 @@ var cryptoMarketStatusCmd = &cobra.Command{
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
-		fmt.Fprintln(w, "CURRENCIES")
-		fmt.Fprintln(w, "----------")
+		writeSection(w, "CURRENCIES")
 		fmt.Fprintf(w, "Crypto\t%s\n", result.Currencies.Crypto)
 		fmt.Fprintf(w, "Forex\t%s\n", result.Currencies.FX)
 		fmt.Fprintln(w)
 
-		fmt.Fprintln(w, "EXCHANGES")
-		fmt.Fprintln(w, "--------")
+		writeSection(w, "EXCHANGES")
 		fmt.Fprintf(w, "NYSE\t%s\n", result.Exchanges.NYSE)
 		fmt.Fprintf(w, "NASDAQ\t%s\n", result.Exchanges.Nasdaq)
 		fmt.Fprintf(w, "OTC\t%s\n", result.Exchanges.OTC)
@@ -436,59 +541,158 @@ var cryptoMarketStatusCmd = &cobra.Command{
 // Snapshot Commands
 // -------------------------------------------------------------------
 
-// cryptoSnapshotCmd retrieves the most recent snapshot for a single
-// crypto ticker including the current day's bar, previous day's bar,
-// latest minute bar, last trade, and fair market value.
-// Usage: massive crypto snapshot X:BTCUSD
+// cryptoSnapshotCmd retrieves the most recent snapshot for one or more
+// crypto tickers including the current day's bar, previous day's bar,
+// latest minute bar, last trade, and fair market value. Several tickers
+// are fetched concurrently and rendered one after another.
+// Usage: massive crypto snapshot X:BTCUSD X:ETHUSD X:SOLUSD
 var cryptoSnapshotCmd = &cobra.Command{
-	Use:   "snapshot [ticker]",
-	Short: "Get snapshot for a single crypto ticker",
-	Long:  "Retrieve the most recent snapshot for a single crypto ticker including current day, previous day, minute bar, last trade, and fair market value.",
-	Args:  cobra.ExactArgs(1),
+	Use:   "snapshot [ticker...]",
+	Short: "Get snapshots for one or more crypto tickers",
+	Long:  "Retrieve the most recent snapshot for one or more crypto tickers including current day, previous day, minute bar, last trade, and fair market value.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := newClient()
+		tickers, err := batchTickers(cmd, args)
 		if err != nil {
 			return err
 		}
 
-		ticker := strings.ToUpper(args[0])
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
 
-		result, err := client.GetCryptoSnapshotSingleTicker(ticker)
+		results, err := fetchEach(client, tickers, client.GetCryptoSnapshotSingleTicker)
 		if err != nil {
 			return err
 		}
 
-		if outputFormat == "json" {
-			return printJSON(result)
+		var ages []snapshotAge
+		for _, result := range results {
+			ages = append(ages, cryptoSnapshotAges(result.Ticker)...)
+		}
+		if err := checkSnapshotAges(cmd, ages); err != nil {
+			return err
 		}
 
-		t := result.Ticker
-		fmt.Printf("Ticker: %s | Change: %.4f (%.2f%%) | FMV: %.4f\n\n",
-			t.Ticker, t.TodaysChange, t.TodaysChangePct, t.FMV)
+		classify, _ := cmd.Flags().GetBool("classify")
 
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "PERIOD\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP")
-		fmt.Fprintln(w, "------\t----\t----\t---\t-----\t------\t----")
+		if outputFormat == "json" {
+			if !classify {
+				return printJSONEach(results)
+			}
 
-		fmt.Fprintf(w, "Day\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\n",
-			t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-			t.Day.Volume, t.Day.VWAP)
+			classified := make([]cryptoClassifiedSnapshot, len(results))
+			for i, result := range results {
+				classified[i] = cryptoClassifiedSnapshot{
+					CryptoSingleSnapshotResponse: result,
+					Classification:               classifyCryptoTrade(result.Ticker),
+				}
+			}
+			return printJSONEach(classified)
+		}
 
-		fmt.Fprintf(w, "Prev Day\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\n",
-			t.PrevDay.Open, t.PrevDay.High, t.PrevDay.Low, t.PrevDay.Close,
-			t.PrevDay.Volume, t.PrevDay.VWAP)
+		for i, result := range results {
+			if i > 0 {
+				fmt.Println()
+			}
+			printCryptoSnapshot(result.Ticker)
+			if classify {
+				printCryptoTradeClassification(classifyCryptoTrade(result.Ticker))
+			}
+		}
 
-		fmt.Fprintf(w, "Minute\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\n",
-			t.Min.Open, t.Min.High, t.Min.Low, t.Min.Close,
-			t.Min.Volume, t.Min.VWAP)
+		return nil
+	},
+}
 
-		w.Flush()
+// cryptoTradeClassification is the --classify verdict on a snapshot's last
+// trade: the inferred side, the rule that decided it (quote, tick, or
+// none), and the reference prices it was judged against. Prior is the
+// open of the latest minute bar, used by the tick test.
+type cryptoTradeClassification struct {
+	Side  analytics.TradeSide `json:"side"`
+	Rule  string              `json:"rule"`
+	Price float64             `json:"price"`
+	Bid   float64             `json:"bid,omitempty"`
+	Ask   float64             `json:"ask,omitempty"`
+	Prior float64             `json:"prior,omitempty"`
+}
 
-		fmt.Printf("\nLast Trade: Price=%.4f Size=%.4f Exchange=%d\n",
-			t.LastTrade.Price, t.LastTrade.Size, t.LastTrade.Exchange)
+// cryptoClassifiedSnapshot is a crypto snapshot response with the
+// --classify verdict added alongside the API fields. Classification is
+// null when the snapshot has no last trade.
+type cryptoClassifiedSnapshot struct {
+	*api.CryptoSingleSnapshotResponse
+	Classification *cryptoTradeClassification `json:"classification"`
+}
 
+// classifyCryptoTrade classifies a snapshot's last trade with the
+// Lee-Ready rule (analytics.ClassifyTrade), comparing it with the last
+// quote's midpoint and falling back to a tick test against the latest
+// minute bar's open. It returns nil when the snapshot has no last trade.
+func classifyCryptoTrade(t api.CryptoSnapshotTicker) *cryptoTradeClassification {
+	if t.LastTrade.IsZero() {
 		return nil
-	},
+	}
+
+	c := &cryptoTradeClassification{
+		Price: t.LastTrade.Price,
+		Bid:   t.LastQuote.Bid,
+		Ask:   t.LastQuote.Ask,
+		Prior: t.Min.Open,
+	}
+	c.Side, c.Rule = analytics.ClassifyTrade(c.Price, c.Bid, c.Ask, c.Prior)
+
+	return c
+}
+
+// printCryptoTradeClassification prints the --classify line below a
+// snapshot, naming the quote or minute open the side was judged against.
+func printCryptoTradeClassification(c *cryptoTradeClassification) {
+	switch {
+	case c == nil:
+		fmt.Println("Classification: -")
+	case c.Rule == analytics.RuleQuote:
+		fmt.Printf("Classification: %s (quote rule, bid %s / ask %s)\n", c.Side, priceCell("%.4f", c.Bid), priceCell("%.4f", c.Ask))
+	case c.Rule == analytics.RuleTick:
+		fmt.Printf("Classification: %s (tick test vs minute open %s)\n", c.Side, priceCell("%.4f", c.Prior))
+	default:
+		fmt.Printf("Classification: %s (no quote or price change to compare)\n", c.Side)
+	}
+}
+
+// printCryptoSnapshot prints one crypto ticker snapshot: a change and FMV
+// summary line, a table of the day, previous day, and minute bars, and the
+// last trade.
+func printCryptoSnapshot(t api.CryptoSnapshotTicker) {
+	fmt.Printf("Ticker: %s | Change: %s (%.2f%%) | FMV: %s\n\n",
+		t.Ticker, priceCell("%.4f", t.TodaysChange), t.TodaysChangePct, priceCell("%.4f", t.FMV))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "PERIOD\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP", "------\t----\t----\t---\t-----\t------\t----")
+
+	day, prev, minute := !t.Day.IsZero(), !t.PrevDay.IsZero(), !t.Min.IsZero()
+
+	fmt.Fprintf(w, "Day\t%s\t%s\t%s\n",
+		priceCells(day, "%.4f", t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close),
+		formatCells(day, "%.0f", t.Day.Volume), priceCells(day, "%.4f", t.Day.VWAP))
+
+	fmt.Fprintf(w, "Prev Day\t%s\t%s\t%s\n",
+		priceCells(prev, "%.4f", t.PrevDay.Open, t.PrevDay.High, t.PrevDay.Low, t.PrevDay.Close),
+		formatCells(prev, "%.0f", t.PrevDay.Volume), priceCells(prev, "%.4f", t.PrevDay.VWAP))
+
+	fmt.Fprintf(w, "Minute\t%s\t%s\t%s\n",
+		priceCells(minute, "%.4f", t.Min.Open, t.Min.High, t.Min.Low, t.Min.Close),
+		formatCells(minute, "%.0f", t.Min.Volume), priceCells(minute, "%.4f", t.Min.VWAP))
+
+	w.Flush()
+
+	if t.LastTrade.IsZero() {
+		fmt.Println("\nLast Trade: -")
+	} else {
+		fmt.Printf("\nLast Trade: Price=%s Size=%.4f Exchange=%d\n",
+			priceCell("%.4f", t.LastTrade.Price), t.LastTrade.Size, t.LastTrade.Exchange)
+	}
 }
 
 // cryptoSnapshotMarketCmd retrieves snapshot data for all crypto tickers
@@ -516,20 +720,198 @@ var cryptoSnapshotMarketCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, cryptoSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
+		enrich, _ := cmd.Flags().GetBool("enrich")
+		if !enrich {
+			if outputFormat == "json" {
+				return printJSON(result)
+			}
+			return printCryptoSnapshotMarketTable(result.Tickers, nil)
+		}
+
+		tickerSymbols := make([]string, len(result.Tickers))
+		for i, t := range result.Tickers {
+			tickerSymbols[i] = t.Ticker
+		}
+		overviews, errs := client.GetCryptoTickerOverviews(tickerSymbols, cryptoEnrichConcurrency)
+
+		if outputFormat == "json" {
+			enriched := cryptoEnrichedSnapshotResponse{
+				Status:    result.Status,
+				RequestID: result.RequestID,
+				Errors:    enrichErrorStrings(errs),
+			}
+			for _, t := range result.Tickers {
+				enriched.Tickers = append(enriched.Tickers, cryptoEnrichedSnapshotTicker{
+					Name:                 overviews[strings.ToUpper(t.Ticker)].Name,
+					CryptoSnapshotTicker: t,
+				})
+			}
+			return printJSON(enriched)
+		}
+
+		warnEnrichErrors(errs)
+		return printCryptoSnapshotMarketTable(result.Tickers, overviews)
+	},
+}
+
+// printCryptoSnapshotMarketTable renders crypto snapshots as a table. When
+// overviews is non-nil (--enrich), a NAME column from the ticker overviews
+// follows the ticker, with "-" for tickers whose overview is missing.
+func printCryptoSnapshotMarketTable(tickers []api.CryptoSnapshotTicker, overviews map[string]api.CryptoTickerOverview) error {
+	printSummary("Tickers: %d", len(tickers))
+
+	header := "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %\tFMV"
+	sep := "------\t--------\t--------\t-------\t---------\t------\t------\t--------\t---"
+	if overviews != nil {
+		header = "TICKER\tNAME\t" + strings.TrimPrefix(header, "TICKER\t")
+		sep = "------\t----\t" + strings.TrimPrefix(sep, "------\t")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, header, sep)
+
+	for _, t := range tickers {
+		label := t.Ticker
+		if overviews != nil {
+			label += "\t" + overviewName(overviews, t.Ticker)
+		}
+
+		day := !t.Day.IsZero()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f%%\t%s\n",
+			label, priceCells(day, "%.4f", t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close),
+			formatCells(day, "%.0f", t.Day.Volume), priceCell("%.4f", t.TodaysChange), t.TodaysChangePct,
+			priceCell("%.4f", t.FMV))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// cryptoEnrichConcurrency is the most overview requests --enrich runs in
+// parallel. The adaptive fetcher lowers it when the rate limit runs low.
+const cryptoEnrichConcurrency = 8
+
+// cryptoEnrichedSnapshotTicker is a snapshot ticker with the name from its
+// ticker overview, used for snapshot-market --enrich JSON output.
+type cryptoEnrichedSnapshotTicker struct {
+	Name string `json:"name"`
+	api.CryptoSnapshotTicker
+}
+
+// cryptoEnrichedSnapshotResponse is the snapshot-market --enrich JSON
+// output. Errors maps tickers whose overview could not be fetched to the
+// reason.
+type cryptoEnrichedSnapshotResponse struct {
+	Status    string                         `json:"status"`
+	RequestID string                         `json:"request_id"`
+	Tickers   []cryptoEnrichedSnapshotTicker `json:"tickers"`
+	Errors    map[string]string              `json:"enrich_errors,omitempty"`
+}
+
+// overviewName returns the overview name for ticker, or "-" when its
+// overview was not fetched.
+func overviewName(overviews map[string]api.CryptoTickerOverview, ticker string) string {
+	return dashIfEmpty(overviews[strings.ToUpper(ticker)].Name)
+}
+
+// dashIfEmpty returns s, or "-" when s is empty, for table cells.
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// enrichErrorStrings converts per-ticker --enrich errors to strings for
+// JSON output. It returns nil when there are none so the field is omitted.
+func enrichErrorStrings(errs map[string]error) map[string]string {
+	if len(errs) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(errs))
+	for ticker, err := range errs {
+		out[ticker] = err.Error()
+	}
+	return out
+}
+
+// warnEnrichErrors reports tickers whose overview could not be fetched on
+// stderr, in ticker order, so the table still prints for the rest.
+func warnEnrichErrors(errs map[string]error) {
+	if len(errs) == 0 {
+		return
+	}
+
+	tickers := make([]string, 0, len(errs))
+	for ticker := range errs {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	warnf("could not load overviews for %d ticker(s):", len(tickers))
+	for _, ticker := range tickers {
+		infof("  %s: %v", ticker, errs[ticker])
+	}
+}
+
+// cryptoBookCmd retrieves the current L2 order book for a crypto pair and
+// renders the top bid and ask levels side by side with the aggregated
+// size at each price. The --depth flag controls how many levels are shown.
+// Usage: massive crypto book X:BTCUSD --depth 10
+var cryptoBookCmd = &cobra.Command{
+	Use:   "book [ticker]",
+	Short: "Get the L2 order book for a crypto ticker",
+	Long:  "Retrieve the current level-2 order book snapshot for a crypto pair, showing the top bid and ask price levels with the size aggregated across exchanges.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		depth, _ := cmd.Flags().GetInt("depth")
+		if depth <= 0 {
+			return fmt.Errorf("--depth must be greater than zero")
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+
+		result, err := client.GetCryptoOrderBook(ticker)
+		if err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Tickers: %d\n\n", len(result.Tickers))
+		book := result.Data
+		fmt.Printf("Ticker: %s | Bids: %d | Asks: %d | Spread: %s\n\n",
+			book.Ticker, len(book.Bids), len(book.Asks), priceCell("%.4f", book.Spread))
+
+		rows := depth
+		if len(book.Bids) < rows && len(book.Asks) < rows {
+			rows = max(len(book.Bids), len(book.Asks))
+		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %\tFMV")
-		fmt.Fprintln(w, "------\t--------\t--------\t-------\t---------\t------\t------\t--------\t---")
+		writeHeader(w, "LEVEL\tBID SIZE\tBID\tASK\tASK SIZE", "-----\t--------\t---\t---\t--------")
 
-		for _, t := range result.Tickers {
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%.2f%%\t%.4f\n",
-				t.Ticker, t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-				t.Day.Volume, t.TodaysChange, t.TodaysChangePct, t.FMV)
+		for i := 0; i < rows; i++ {
+			bidSize, bidPrice, askPrice, askSize := "-", "-", "-", "-"
+			if i < len(book.Bids) {
+				bidSize = fmt.Sprintf("%.4f", book.Bids[i].Size())
+				bidPrice = priceCell("%.4f", book.Bids[i].Price)
+			}
+			if i < len(book.Asks) {
+				askPrice = priceCell("%.4f", book.Asks[i].Price)
+				askSize = fmt.Sprintf("%.4f", book.Asks[i].Size())
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", i+1, bidSize, bidPrice, askPrice, askSize)
 		}
 		w.Flush()
 
@@ -556,6 +938,10 @@ var cryptoGainersCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, cryptoSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -583,6 +969,10 @@ var cryptoLosersCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, cryptoSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -591,62 +981,190 @@ var cryptoLosersCmd = &cobra.Command{
 	},
 }
 
+// cryptoMovers holds the gainers and losers lists fetched together by the
+// crypto movers command and is the shape of its JSON output.
+type cryptoMovers struct {
+	Gainers []api.CryptoSnapshotTicker `json:"gainers"`
+	Losers  []api.CryptoSnapshotTicker `json:"losers"`
+}
+
+// cryptoMoversCmd fetches the top crypto gainers and losers concurrently
+// and prints them as two stacked tables in one invocation. The --count
+// flag trims each list client-side.
+// Usage: massive crypto movers --count 5
+var cryptoMoversCmd = &cobra.Command{
+	Use:   "movers",
+	Short: "Get top gaining and losing crypto tickers together",
+	Long:  "Retrieve the current top gainers and top losers in the crypto market in one call. Both lists are fetched concurrently and shown as two tables; use --count to limit each list.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		count, _ := cmd.Flags().GetInt("count")
+		if count < 0 {
+			return fmt.Errorf("--count must be zero or greater")
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		directions := []string{"gainers", "losers"}
+		results := make([]*api.CryptoSnapshotResponse, len(directions))
+
+		fetcher := api.NewAdaptiveFetcher(client, len(directions))
+		err = fetcher.Run(len(directions), func(i int) error {
+			resp, err := client.GetCryptoSnapshotTopMovers(directions[i])
+			if err != nil {
+				return fmt.Errorf("%s: %w", directions[i], err)
+			}
+			results[i] = resp
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			if count > 0 && len(result.Tickers) > count {
+				result.Tickers = result.Tickers[:count]
+			}
+		}
+
+		if err := checkSnapshotAges(cmd, cryptoSnapshotAges(slices.Concat(results[0].Tickers, results[1].Tickers)...)); err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(cryptoMovers{Gainers: results[0].Tickers, Losers: results[1].Tickers})
+		}
+
+		if err := printCryptoMoversTable("Gainers", results[0]); err != nil {
+			return err
+		}
+		fmt.Println()
+		return printCryptoMoversTable("Losers", results[1])
+	},
+}
+
 // printCryptoMoversTable formats and prints a table of crypto gainers or
 // losers snapshot data to stdout. The title parameter labels the output
 // as either "Gainers" or "Losers" for display clarity.
 func printCryptoMoversTable(title string, result *api.CryptoSnapshotResponse) error {
-	fmt.Printf("Top %s: %d tickers\n\n", title, len(result.Tickers))
+	printSummary("Top %s: %d tickers", title, len(result.Tickers))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %\tFMV")
-	fmt.Fprintln(w, "------\t--------\t--------\t-------\t---------\t------\t------\t--------\t---")
+	writeHeader(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %\tFMV", "------\t--------\t--------\t-------\t---------\t------\t------\t--------\t---")
 
 	for _, t := range result.Tickers {
-		fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%.2f%%\t%.4f\n",
-			t.Ticker, t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-			t.Day.Volume, t.TodaysChange, t.TodaysChangePct, t.FMV)
+		day := !t.Day.IsZero()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f%%\t%s\n",
+			t.Ticker, priceCells(day, "%.4f", t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close),
+			formatCells(day, "%.0f", t.Day.Volume), priceCell("%.4f", t.TodaysChange), t.TodaysChangePct,
+			priceCell("%.4f", t.FMV))
 	}
 	w.Flush()
 
 	return nil
 }
 
-// -------------------------------------------------------------------
-// Technical Indicator Commands
-// -------------------------------------------------------------------
-
-// cryptoSMACmd retrieves Simple Moving Average (SMA) data for a crypto
-// ticker over a specified time range. SMA smooths price data by calculating
-// the arithmetic mean over a rolling window period.
-// Usage: massive crypto sma X:BTCUSD --from 2025-01-06 --to 2025-01-10
-var cryptoSMACmd = &cobra.Command{
-	Use:   "sma [ticker]",
-	Short: "Get Simple Moving Average (SMA) for a crypto ticker",
-	Long:  "Retrieve Simple Moving Average (SMA) indicator data for a crypto ticker. SMA calculates the arithmetic mean of closing prices over a given window period.",
-	Args:  cobra.ExactArgs(1),
+// cryptoGapsCmd scans crypto snapshots for opening gaps: each ticker's
+// day open against its previous day's close, largest gap up first. All
+// tickers are scanned unless --tickers narrows the snapshot.
+// Usage: massive crypto gaps --tickers X:BTCUSD,X:ETHUSD --min-gap 2
+var cryptoGapsCmd = &cobra.Command{
+	Use:   "gaps",
+	Short: "Scan crypto tickers for gaps from the previous close",
+	Long:  "Compare each ticker's day open with the previous day's close from the market snapshot and list the gap percent, sorted from the largest gap up to the largest gap down. --min-gap keeps only gaps of at least that size in either direction.",
+	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		minGap, _ := cmd.Flags().GetFloat64("min-gap")
+		if minGap < 0 {
+			return fmt.Errorf("--min-gap must be zero or greater")
+		}
+
 		client, err := newClient()
 		if err != nil {
 			return err
 		}
 
-		ticker := strings.ToUpper(args[0])
-		params := buildCryptoIndicatorParams(cmd)
-
-		result, err := client.GetCryptoSMA(ticker, params)
+		tickers, _ := cmd.Flags().GetString("tickers")
+		result, err := client.GetCryptoSnapshotFullMarket(api.CryptoSnapshotParams{
+			Tickers: strings.ToUpper(tickers),
+		})
 		if err != nil {
 			return err
 		}
 
-		if outputFormat == "json" {
-			return printJSON(result)
+		if err := checkSnapshotAges(cmd, cryptoSnapshotAges(result.Tickers...)); err != nil {
+			return err
 		}
 
-		printIndicatorTable(ticker, "SMA", result)
+		var gaps []analytics.Gap
+		for _, t := range result.Tickers {
+			if g, ok := analytics.OpeningGap(t.Ticker, t.PrevDay.Close, t.Day.Open); ok {
+				gaps = append(gaps, g)
+			}
+		}
+		gaps = analytics.RankGaps(gaps, minGap)
+
+		if outputFormat == "json" {
+			return printJSON(gaps)
+		}
+
+		printSummary("Tickers: %d | Gaps: %d | Min Gap: %.2f%%", len(result.Tickers), len(gaps), minGap)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "TICKER	PREV CLOSE	OPEN	GAP	GAP %", "------	----------	----	---	-----")
+
+		for _, g := range gaps {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f%%\n",
+				g.Ticker, priceCell("%.4f", g.PrevClose), priceCell("%.4f", g.Open), priceCell("%.4f", g.Change), g.Percent)
+		}
+		w.Flush()
+
 		return nil
 	},
 }
 
+// -------------------------------------------------------------------
+// Technical Indicator Commands
+// -------------------------------------------------------------------
+
+// cryptoSMACmd retrieves Simple Moving Average (SMA) data for a crypto
+// ticker over a specified time range. SMA smooths price data by calculating
+// the arithmetic mean over a rolling window period.
+// Usage: massive crypto sma X:BTCUSD --from 2025-01-06 --to 2025-01-10
+var cryptoSMACmd = &cobra.Command{
+	Use:   "sma [ticker]",
+	Short: "Get Simple Moving Average (SMA) for a crypto ticker",
+	Long:  "Retrieve Simple Moving Average (SMA) indicator data for a crypto ticker. SMA calculates the arithmetic mean of closing prices over a given window period.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
+
+		result, err := client.GetCryptoSMA(ticker, params)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
+		}
+
+		printIndicatorTable(ticker, "SMA", result)
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
+	},
+}
+
 // cryptoEMACmd retrieves Exponential Moving Average (EMA) data for a
 // crypto ticker over a specified time range. EMA places greater weight
 // on recent prices compared to SMA for quicker trend detection.
@@ -663,62 +1181,925 @@ var cryptoEMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildCryptoIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetCryptoEMA(ticker, params)
 		if err != nil {
 			return err
 		}
 
-		if outputFormat == "json" {
-			return printJSON(result)
+		if outputFormat == "json" {
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
+		}
+
+		printIndicatorTable(ticker, "EMA", result)
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
+	},
+}
+
+// cryptoRSICmd retrieves Relative Strength Index (RSI) data for a crypto
+// ticker over a specified time range. RSI oscillates between 0 and 100
+// to identify overbought or oversold conditions.
+// Usage: massive crypto rsi X:BTCUSD --from 2025-01-06 --to 2025-01-10
+var cryptoRSICmd = &cobra.Command{
+	Use:   "rsi [ticker]",
+	Short: "Get Relative Strength Index (RSI) for a crypto ticker",
+	Long:  "Retrieve Relative Strength Index (RSI) indicator data for a crypto ticker. RSI measures the speed and magnitude of price changes, oscillating between 0 and 100.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
+
+		result, err := client.GetCryptoRSI(ticker, params)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
+		}
+
+		printIndicatorTable(ticker, "RSI", result)
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
+	},
+}
+
+// cryptoMACDCmd retrieves Moving Average Convergence/Divergence (MACD) data
+// for a crypto ticker over a specified time range. MACD is a momentum
+// indicator with three components: the MACD line, signal line, and histogram.
+// With --local the values are computed from aggregate bars instead of the
+// indicator endpoint.
+// Usage: massive crypto macd X:BTCUSD --from 2025-01-06 --to 2025-01-10
+var cryptoMACDCmd = &cobra.Command{
+	Use:   "macd [ticker]",
+	Short: "Get Moving Average Convergence/Divergence (MACD) for a crypto ticker",
+	Long:  "Retrieve MACD indicator data for a crypto ticker. MACD is a momentum indicator showing the relationship between two EMAs, with signal line and histogram. Pass --local to compute it client-side from aggregate bars, for windows the API rejects or to verify server values; local values start once the long and signal windows have warmed up within --from/--to.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		params, err := buildMACDParams(cmd)
+		if err != nil {
+			return err
+		}
+
+		var result *api.MACDResponse
+		if local, _ := cmd.Flags().GetBool("local"); local {
+			result, err = localCryptoMACD(client, ticker, params)
+		} else {
+			result, err = client.GetCryptoMACD(ticker, params)
+		}
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
+		}
+
+		printMACDTable(ticker, result)
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
+	},
+}
+
+// localCryptoMACD computes MACD client-side from crypto aggregate bars for
+// the same range, windows, series type, order, and limit as the indicator
+// endpoint, and returns it in the endpoint's response shape so the usual
+// table and JSON output apply. Bars before the signal line has warmed up
+// are left out.
+func localCryptoMACD(client *api.Client, ticker string, params api.MACDParams) (*api.MACDResponse, error) {
+	short, _ := strconv.Atoi(params.ShortWindow)
+	long, _ := strconv.Atoi(params.LongWindow)
+	signal, _ := strconv.Atoi(params.SignalWindow)
+
+	bars, err := client.GetCryptoBars(ticker, api.BarsParams{
+		Multiplier: "1",
+		Timespan:   params.Timespan,
+		From:       params.TimestampGTE,
+		To:         params.TimestampLTE,
+		Adjusted:   params.Adjusted,
+		Sort:       "asc",
+		Limit:      "50000",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	series := make([]float64, len(bars.Results))
+	for i, bar := range bars.Results {
+		switch params.SeriesType {
+		case "open":
+			series[i] = bar.Open
+		case "high":
+			series[i] = bar.High
+		case "low":
+			series[i] = bar.Low
+		case "close", "":
+			series[i] = bar.Close
+		default:
+			return nil, fmt.Errorf("invalid --series-type %q: must be open, high, low, or close", params.SeriesType)
+		}
+	}
+
+	macd, signalLine, hist := analytics.MACD(series, short, long, signal)
+
+	var values []api.MACDValue
+	for i, bar := range bars.Results {
+		if math.IsNaN(hist[i]) {
+			continue
+		}
+		values = append(values, api.MACDValue{
+			Timestamp: bar.Timestamp,
+			Value:     macd[i],
+			Signal:    signalLine[i],
+			Histogram: hist[i],
+		})
+	}
+
+	if params.Order != "asc" {
+		slices.Reverse(values)
+	}
+	if n, err := strconv.Atoi(params.Limit); err == nil && n >= 0 && n < len(values) {
+		values = values[:n]
+	}
+
+	return &api.MACDResponse{
+		Status:    "OK",
+		RequestID: bars.RequestID,
+		Results:   api.MACDResults{Values: values},
+	}, nil
+}
+
+// cryptoIndicatorRow holds the SMA, EMA, RSI, and MACD values computed for
+// a single timestamp. Fields are nil when an indicator has no value at that
+// timestamp so gaps render as "-" in tables and are omitted from JSON.
+type cryptoIndicatorRow struct {
+	Timestamp int64    `json:"timestamp"`
+	SMA       *float64 `json:"sma,omitempty"`
+	EMA       *float64 `json:"ema,omitempty"`
+	RSI       *float64 `json:"rsi,omitempty"`
+	MACD      *float64 `json:"macd,omitempty"`
+	Signal    *float64 `json:"signal,omitempty"`
+	Histogram *float64 `json:"histogram,omitempty"`
+}
+
+// cryptoIndicatorsCmd fetches SMA, EMA, RSI, and MACD concurrently for the
+// same crypto ticker, window, and timespan and renders a single table with
+// the values aligned by timestamp. This replaces four separate invocations
+// when building a technical dashboard.
+// Usage: massive crypto indicators X:BTCUSD --from 2025-01-06 --to 2025-01-10
+var cryptoIndicatorsCmd = &cobra.Command{
+	Use:   "indicators [ticker]",
+	Short: "Get SMA, EMA, RSI, and MACD for a crypto ticker in one table",
+	Long:  "Concurrently retrieve SMA, EMA, RSI, and MACD indicator data for a crypto ticker and display the values aligned by timestamp in a single combined table.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		adjusted, _ := cmd.Flags().GetString("adjusted")
+		smaWindow, _ := cmd.Flags().GetString("sma-window")
+		emaWindow, _ := cmd.Flags().GetString("ema-window")
+		rsiWindow, _ := cmd.Flags().GetString("rsi-window")
+		shortWindow, _ := cmd.Flags().GetString("short-window")
+		longWindow, _ := cmd.Flags().GetString("long-window")
+		signalWindow, _ := cmd.Flags().GetString("signal-window")
+		seriesType, _ := cmd.Flags().GetString("series-type")
+		order, _ := cmd.Flags().GetString("order")
+		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitIndicators, limit)
+
+		if err := validateCryptoIndicatorWindows(smaWindow, emaWindow, rsiWindow, shortWindow, longWindow, signalWindow); err != nil {
+			return err
+		}
+
+		base := api.IndicatorParams{
+			TimestampGTE: from,
+			TimestampLTE: to,
+			Timespan:     timespan,
+			Adjusted:     adjusted,
+			SeriesType:   seriesType,
+			Order:        order,
+			Limit:        limit,
+		}
+
+		smaParams, emaParams, rsiParams := base, base, base
+		smaParams.Window = smaWindow
+		emaParams.Window = emaWindow
+		rsiParams.Window = rsiWindow
+
+		macdParams := api.MACDParams{
+			TimestampGTE: from,
+			TimestampLTE: to,
+			Timespan:     timespan,
+			Adjusted:     adjusted,
+			ShortWindow:  shortWindow,
+			LongWindow:   longWindow,
+			SignalWindow: signalWindow,
+			SeriesType:   seriesType,
+			Order:        order,
+			Limit:        limit,
+		}
+
+		// Fan out the four indicator requests and wait for all of them.
+		var (
+			wg                     sync.WaitGroup
+			sma, ema, rsi          *api.IndicatorResponse
+			macd                   *api.MACDResponse
+			smaErr, emaErr, rsiErr error
+			macdErr                error
+		)
+
+		wg.Add(4)
+		go func() { defer wg.Done(); sma, smaErr = client.GetCryptoSMA(ticker, smaParams) }()
+		go func() { defer wg.Done(); ema, emaErr = client.GetCryptoEMA(ticker, emaParams) }()
+		go func() { defer wg.Done(); rsi, rsiErr = client.GetCryptoRSI(ticker, rsiParams) }()
+		go func() { defer wg.Done(); macd, macdErr = client.GetCryptoMACD(ticker, macdParams) }()
+		wg.Wait()
+
+		if smaErr != nil {
+			return fmt.Errorf("failed to fetch SMA: %w", smaErr)
+		}
+		if emaErr != nil {
+			return fmt.Errorf("failed to fetch EMA: %w", emaErr)
+		}
+		if rsiErr != nil {
+			return fmt.Errorf("failed to fetch RSI: %w", rsiErr)
+		}
+		if macdErr != nil {
+			return fmt.Errorf("failed to fetch MACD: %w", macdErr)
+		}
+
+		rows := mergeCryptoIndicators(sma, ema, rsi, macd, order)
+
+		if outputFormat == "json" {
+			return printJSON(rows)
+		}
+
+		printSummary("Ticker: %s | Indicators: SMA, EMA, RSI, MACD | Values: %d", ticker, len(rows))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "DATE\tSMA\tEMA\tRSI\tMACD\tSIGNAL\tHIST", "----\t---\t---\t---\t----\t------\t----")
+
+		for _, row := range rows {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				timestampCell(row.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04"),
+				formatIndicatorValue(row.SMA), formatIndicatorValue(row.EMA),
+				formatIndicatorValue(row.RSI), formatIndicatorValue(row.MACD),
+				formatIndicatorValue(row.Signal), formatIndicatorValue(row.Histogram))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// validateCryptoIndicatorWindows checks the combined indicators windows
+// in flag order (SMA, EMA, RSI, then the MACD windows) so that when
+// several are invalid the error always names the same one.
+func validateCryptoIndicatorWindows(smaWindow, emaWindow, rsiWindow, shortWindow, longWindow, signalWindow string) error {
+	windows := []struct{ flag, value string }{
+		{"sma-window", smaWindow},
+		{"ema-window", emaWindow},
+		{"rsi-window", rsiWindow},
+	}
+	for _, w := range windows {
+		if _, err := parseWindow(w.flag, w.value); err != nil {
+			return err
+		}
+	}
+	return validateMACDWindows(shortWindow, longWindow, signalWindow)
+}
+
+// mergeCryptoIndicators aligns the SMA, EMA, RSI, and MACD series by
+// timestamp into a single slice of rows. Rows are sorted ascending when
+// order is "asc" and descending otherwise, matching the API default.
+func mergeCryptoIndicators(sma, ema, rsi *api.IndicatorResponse, macd *api.MACDResponse, order string) []cryptoIndicatorRow {
+	byTime := make(map[int64]*cryptoIndicatorRow)
+	rowAt := func(ts int64) *cryptoIndicatorRow {
+		row, ok := byTime[ts]
+		if !ok {
+			row = &cryptoIndicatorRow{Timestamp: ts}
+			byTime[ts] = row
+		}
+		return row
+	}
+
+	for _, v := range sma.Results.Values {
+		val := v.Value
+		rowAt(v.Timestamp).SMA = &val
+	}
+	for _, v := range ema.Results.Values {
+		val := v.Value
+		rowAt(v.Timestamp).EMA = &val
+	}
+	for _, v := range rsi.Results.Values {
+		val := v.Value
+		rowAt(v.Timestamp).RSI = &val
+	}
+	for _, v := range macd.Results.Values {
+		m, sig, hist := v.Value, v.Signal, v.Histogram
+		row := rowAt(v.Timestamp)
+		row.MACD = &m
+		row.Signal = &sig
+		row.Histogram = &hist
+	}
+
+	rows := make([]cryptoIndicatorRow, 0, len(byTime))
+	for _, row := range byTime {
+		rows = append(rows, *row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if order == "asc" {
+			return rows[i].Timestamp < rows[j].Timestamp
+		}
+		return rows[i].Timestamp > rows[j].Timestamp
+	})
+
+	return rows
+}
+
+// formatIndicatorValue formats an optional indicator value to four decimal
+// places, returning "-" when the value is missing for that timestamp.
+func formatIndicatorValue(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.4f", *v)
+}
+
+// cryptoCrossoverCmd fetches a fast and a slow moving average for a crypto
+// ticker concurrently, aligns them by timestamp, and lists the golden
+// crosses (fast moving above slow) and death crosses (fast moving below).
+// Usage: massive crypto crossover X:BTCUSD --fast 50 --slow 200 --from 2024-01-01 --to 2025-01-01
+var cryptoCrossoverCmd = &cobra.Command{
+	Use:   "crossover [ticker]",
+	Short: "Find golden and death crosses of two moving averages for a crypto ticker",
+	Long:  "Fetch a fast and a slow SMA (or EMA with --type ema) for a crypto ticker, align them by timestamp, and list each golden cross (fast crosses above slow) and death cross (fast crosses below slow).",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		maType, _ := cmd.Flags().GetString("type")
+		fastWindow, _ := cmd.Flags().GetString("fast")
+		slowWindow, _ := cmd.Flags().GetString("slow")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		seriesType, _ := cmd.Flags().GetString("series-type")
+		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitIndicators, limit)
+
+		maType = strings.ToLower(maType)
+		fetch := client.GetCryptoSMA
+		switch maType {
+		case "sma":
+		case "ema":
+			fetch = client.GetCryptoEMA
+		default:
+			return fmt.Errorf("--type must be sma or ema, got %q", maType)
+		}
+
+		fast, err := parseWindow("fast", fastWindow)
+		if err != nil {
+			return err
+		}
+		slow, err := parseWindow("slow", slowWindow)
+		if err != nil {
+			return err
+		}
+		if fast >= slow {
+			return fmt.Errorf("--fast (%d) must be less than --slow (%d)", fast, slow)
+		}
+
+		base := api.IndicatorParams{
+			TimestampGTE: from,
+			TimestampLTE: to,
+			Timespan:     timespan,
+			SeriesType:   seriesType,
+			Order:        "asc",
+			Limit:        limit,
+		}
+		fastParams, slowParams := base, base
+		fastParams.Window = fastWindow
+		slowParams.Window = slowWindow
+
+		var (
+			wg               sync.WaitGroup
+			fastMA, slowMA   *api.IndicatorResponse
+			fastErr, slowErr error
+		)
+
+		wg.Add(2)
+		go func() { defer wg.Done(); fastMA, fastErr = fetch(ticker, fastParams) }()
+		go func() { defer wg.Done(); slowMA, slowErr = fetch(ticker, slowParams) }()
+		wg.Wait()
+
+		if fastErr != nil {
+			return fmt.Errorf("failed to fetch fast %s: %w", strings.ToUpper(maType), fastErr)
+		}
+		if slowErr != nil {
+			return fmt.Errorf("failed to fetch slow %s: %w", strings.ToUpper(maType), slowErr)
+		}
+
+		fastValues, slowValues, timestamps := alignIndicatorValues(fastMA.Results.Values, slowMA.Results.Values)
+		events := analytics.Crossovers(fastValues, slowValues, timestamps)
+
+		if outputFormat == "json" {
+			return printJSON(events)
+		}
+
+		printSummary("Ticker: %s | %s %d/%d | Values: %d | Crosses: %d",
+			ticker, strings.ToUpper(maType), fast, slow, len(timestamps), len(events))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "DATE\tSIGNAL\tFAST\tSLOW", "----\t------\t----\t----")
+
+		for _, e := range events {
+			signal := "GOLDEN CROSS"
+			if e.Direction == analytics.DeathCross {
+				signal = "DEATH CROSS"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				timestampCell(e.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04"),
+				signal, priceCell("%.4f", e.Fast), priceCell("%.4f", e.Slow))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// alignIndicatorValues pairs two indicator series on their shared
+// timestamps, returning the values of each and the timestamps in
+// ascending order. Timestamps present in only one series are dropped.
+func alignIndicatorValues(a, b []api.IndicatorValue) (aValues, bValues []float64, timestamps []int64) {
+	bByTime := make(map[int64]float64, len(b))
+	for _, v := range b {
+		bByTime[v.Timestamp] = v.Value
+	}
+
+	sorted := slices.Clone(a)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Timestamp < sorted[j].Timestamp })
+
+	for _, v := range sorted {
+		other, ok := bByTime[v.Timestamp]
+		if !ok {
+			continue
+		}
+		aValues = append(aValues, v.Value)
+		bValues = append(bValues, other)
+		timestamps = append(timestamps, v.Timestamp)
+	}
+
+	return aValues, bValues, timestamps
+}
+
+// cryptoWilliamsRRow holds a single bar's Williams %R value alongside the
+// high, low, and close used to compute it. WilliamsR is nil during the
+// warm-up period or when the window range is flat.
+type cryptoWilliamsRRow struct {
+	Timestamp int64    `json:"timestamp"`
+	High      float64  `json:"high"`
+	Low       float64  `json:"low"`
+	Close     float64  `json:"close"`
+	WilliamsR *float64 `json:"williams_r,omitempty"`
+}
+
+// cryptoWilliamsRCmd computes the Williams %R momentum oscillator locally
+// from crypto aggregate bars. Values range from 0 (close at the window
+// high) to -100 (close at the window low).
+// Usage: massive crypto willr X:BTCUSD --from 2025-01-01 --to 2025-03-01 --window 14
+var cryptoWilliamsRCmd = &cobra.Command{
+	Use:   "willr [ticker]",
+	Short: "Compute Williams %R for a crypto ticker from bars",
+	Long:  "Compute the Williams %R momentum oscillator client-side from crypto aggregate bars. Williams %R measures where the close sits within the high/low range of the lookback window, from 0 (at the high) to -100 (at the low).",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		multiplier, _ := cmd.Flags().GetString("multiplier")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
+		window, _ := cmd.Flags().GetInt("window")
+
+		if window <= 0 {
+			return fmt.Errorf("--window must be a positive integer")
+		}
+
+		params := api.BarsParams{
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   "true",
+			Sort:       "asc",
+			Limit:      "50000",
+		}
+
+		result, err := client.GetCryptoBars(ticker, params)
+		if err != nil {
+			return err
+		}
+
+		values := analytics.WilliamsR(result.Results, window)
+
+		rows := make([]cryptoWilliamsRRow, len(result.Results))
+		for i, bar := range result.Results {
+			rows[i] = cryptoWilliamsRRow{
+				Timestamp: bar.Timestamp,
+				High:      bar.High,
+				Low:       bar.Low,
+				Close:     bar.Close,
+			}
+			if !math.IsNaN(values[i]) {
+				v := values[i]
+				rows[i].WilliamsR = &v
+			}
+		}
+
+		if outputFormat == "json" {
+			return printJSON(rows)
+		}
+
+		printSummary("Ticker: %s | Indicator: Williams %%R (%d) | Bars: %d", ticker, window, len(rows))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "DATE\tHIGH\tLOW\tCLOSE\t%R", "----\t----\t---\t-----\t--")
+
+		for _, row := range rows {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				timestampCell(row.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04"),
+				priceCell("%.4f", row.High), priceCell("%.4f", row.Low), priceCell("%.4f", row.Close),
+				formatIndicatorValue(row.WilliamsR))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// cryptoChangeRow holds a single bar's close, the close window bars
+// earlier, and the change indicator computed from them. PriorClose and
+// Value are nil during the warm-up period.
+type cryptoChangeRow struct {
+	Timestamp  int64    `json:"timestamp"`
+	Close      float64  `json:"close"`
+	PriorClose *float64 `json:"prior_close,omitempty"`
+	Value      *float64 `json:"value,omitempty"`
+}
+
+// cryptoROCCmd computes the Rate-of-Change locally from crypto aggregate
+// bars: the percent change between each close and the close window bars
+// earlier.
+// Usage: massive crypto roc X:BTCUSD --from 2025-01-01 --to 2025-03-01 --window 10
+var cryptoROCCmd = &cobra.Command{
+	Use:   "roc [ticker]",
+	Short: "Compute Rate-of-Change for a crypto ticker from bars",
+	Long:  "Compute the Rate-of-Change momentum indicator client-side from crypto aggregate bars: (close - close n bars ago) / close n bars ago * 100.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCryptoChangeIndicator(cmd, args[0], "ROC", "ROC%", analytics.ROC)
+	},
+}
+
+// cryptoMomentumCmd computes momentum locally from crypto aggregate bars:
+// the price difference between each close and the close window bars
+// earlier.
+// Usage: massive crypto momentum X:BTCUSD --from 2025-01-01 --to 2025-03-01 --window 10
+var cryptoMomentumCmd = &cobra.Command{
+	Use:   "momentum [ticker]",
+	Short: "Compute momentum for a crypto ticker from bars",
+	Long:  "Compute the momentum indicator client-side from crypto aggregate bars: close - close n bars ago.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCryptoChangeIndicator(cmd, args[0], "Momentum", "MOMENTUM", analytics.Momentum)
+	},
+}
+
+// runCryptoChangeIndicator fetches ascending crypto bars for the command's
+// date range and renders an indicator that compares each close with the
+// close --window bars earlier. name labels the summary line and column
+// heads the indicator column in the table.
+func runCryptoChangeIndicator(cmd *cobra.Command, ticker, name, column string, compute func([]api.Bar, int) []float64) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	ticker = strings.ToUpper(ticker)
+	multiplier, _ := cmd.Flags().GetString("multiplier")
+	timespan, err := timespanFlag(cmd)
+	if err != nil {
+		return err
+	}
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	window, _ := cmd.Flags().GetInt("window")
+
+	if window <= 0 {
+		return fmt.Errorf("--window must be a positive integer")
+	}
+
+	params := api.BarsParams{
+		Multiplier: multiplier,
+		Timespan:   timespan,
+		From:       from,
+		To:         to,
+		Adjusted:   "true",
+		Sort:       "asc",
+		Limit:      "50000",
+	}
+
+	result, err := client.GetCryptoBars(ticker, params)
+	if err != nil {
+		return err
+	}
+
+	values := compute(result.Results, window)
+
+	rows := make([]cryptoChangeRow, len(result.Results))
+	for i, bar := range result.Results {
+		rows[i] = cryptoChangeRow{Timestamp: bar.Timestamp, Close: bar.Close}
+		if i >= window {
+			prior := result.Results[i-window].Close
+			rows[i].PriorClose = &prior
+		}
+		if !math.IsNaN(values[i]) {
+			v := values[i]
+			rows[i].Value = &v
+		}
+	}
+
+	if outputFormat == "json" {
+		return printJSON(rows)
+	}
+
+	printSummary("Ticker: %s | Indicator: %s (%d) | Bars: %d", ticker, name, window, len(rows))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "DATE\tCLOSE\tPRIOR CLOSE\t"+column, "----\t-----\t-----------\t"+strings.Repeat("-", len(column)))
+
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			timestampCell(row.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04"), priceCell("%.4f", row.Close),
+			formatIndicatorValue(row.PriorClose), formatIndicatorValue(row.Value))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// cryptoChannelRow holds a single bar's high, low, and close alongside the
+// channel bands computed from the bars. Bands are nil during warm-up.
+type cryptoChannelRow struct {
+	Timestamp int64    `json:"timestamp"`
+	High      float64  `json:"high"`
+	Low       float64  `json:"low"`
+	Close     float64  `json:"close"`
+	Upper     *float64 `json:"upper,omitempty"`
+	Middle    *float64 `json:"middle,omitempty"`
+	Lower     *float64 `json:"lower,omitempty"`
+}
+
+// cryptoKeltnerCmd computes Keltner Channels locally from crypto aggregate
+// bars: an EMA of closes with bands a multiple of the Average True Range
+// above and below it.
+// Usage: massive crypto keltner X:BTCUSD --from 2025-01-01 --to 2025-03-01 --window 20 --atr-window 10
+var cryptoKeltnerCmd = &cobra.Command{
+	Use:   "keltner [ticker]",
+	Short: "Compute Keltner Channels for a crypto ticker from bars",
+	Long:  "Compute Keltner Channels client-side from crypto aggregate bars. The middle band is the EMA of closes over --window and the upper and lower bands sit --atr-multiplier Average True Ranges (over --atr-window) above and below it.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		window, _ := cmd.Flags().GetInt("window")
+		atrWindow, _ := cmd.Flags().GetInt("atr-window")
+		atrMultiplier, _ := cmd.Flags().GetFloat64("atr-multiplier")
+
+		if window <= 0 || atrWindow <= 0 {
+			return fmt.Errorf("--window and --atr-window must be positive integers")
+		}
+		if atrMultiplier <= 0 {
+			return fmt.Errorf("--atr-multiplier must be greater than zero")
 		}
 
-		printIndicatorTable(ticker, "EMA", result)
-		return nil
+		name := fmt.Sprintf("Keltner Channels (EMA %d, ATR %d x %g)", window, atrWindow, atrMultiplier)
+		return runCryptoChannel(cmd, args[0], name, func(bars []api.Bar) analytics.Channel {
+			return analytics.KeltnerChannels(bars, window, atrWindow, atrMultiplier)
+		})
 	},
 }
 
-// cryptoRSICmd retrieves Relative Strength Index (RSI) data for a crypto
-// ticker over a specified time range. RSI oscillates between 0 and 100
-// to identify overbought or oversold conditions.
-// Usage: massive crypto rsi X:BTCUSD --from 2025-01-06 --to 2025-01-10
-var cryptoRSICmd = &cobra.Command{
-	Use:   "rsi [ticker]",
-	Short: "Get Relative Strength Index (RSI) for a crypto ticker",
-	Long:  "Retrieve Relative Strength Index (RSI) indicator data for a crypto ticker. RSI measures the speed and magnitude of price changes, oscillating between 0 and 100.",
+// cryptoDonchianCmd computes Donchian Channels locally from crypto
+// aggregate bars: the highest high and lowest low over the window and
+// their midpoint.
+// Usage: massive crypto donchian X:BTCUSD --from 2025-01-01 --to 2025-03-01 --window 20
+var cryptoDonchianCmd = &cobra.Command{
+	Use:   "donchian [ticker]",
+	Short: "Compute Donchian Channels for a crypto ticker from bars",
+	Long:  "Compute Donchian Channels client-side from crypto aggregate bars. The upper and lower bands are the highest high and lowest low of the last --window bars and the middle band is their midpoint.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := newClient()
-		if err != nil {
-			return err
+		window, _ := cmd.Flags().GetInt("window")
+		if window <= 0 {
+			return fmt.Errorf("--window must be a positive integer")
 		}
 
-		ticker := strings.ToUpper(args[0])
-		params := buildCryptoIndicatorParams(cmd)
+		name := fmt.Sprintf("Donchian Channels (%d)", window)
+		return runCryptoChannel(cmd, args[0], name, func(bars []api.Bar) analytics.Channel {
+			return analytics.DonchianChannels(bars, window)
+		})
+	},
+}
 
-		result, err := client.GetCryptoRSI(ticker, params)
-		if err != nil {
-			return err
-		}
+// runCryptoChannel fetches ascending crypto bars for the command's date
+// range and renders the upper, middle, and lower bands of the channel
+// returned by compute. name labels the summary line.
+func runCryptoChannel(cmd *cobra.Command, ticker, name string, compute func([]api.Bar) analytics.Channel) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
 
-		if outputFormat == "json" {
-			return printJSON(result)
+	ticker = strings.ToUpper(ticker)
+	multiplier, _ := cmd.Flags().GetString("multiplier")
+	timespan, err := timespanFlag(cmd)
+	if err != nil {
+		return err
+	}
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+
+	params := api.BarsParams{
+		Multiplier: multiplier,
+		Timespan:   timespan,
+		From:       from,
+		To:         to,
+		Adjusted:   "true",
+		Sort:       "asc",
+		Limit:      "50000",
+	}
+
+	result, err := client.GetCryptoBars(ticker, params)
+	if err != nil {
+		return err
+	}
+
+	channel := compute(result.Results)
+
+	rows := make([]cryptoChannelRow, len(result.Results))
+	for i, bar := range result.Results {
+		rows[i] = cryptoChannelRow{
+			Timestamp: bar.Timestamp,
+			High:      bar.High,
+			Low:       bar.Low,
+			Close:     bar.Close,
+			Upper:     optionalFloat(channel.Upper[i]),
+			Middle:    optionalFloat(channel.Middle[i]),
+			Lower:     optionalFloat(channel.Lower[i]),
 		}
+	}
 
-		printIndicatorTable(ticker, "RSI", result)
-		return nil
-	},
+	if outputFormat == "json" {
+		return printJSON(rows)
+	}
+
+	printSummary("Ticker: %s | Indicator: %s | Bars: %d", ticker, name, len(rows))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "DATE\tHIGH\tLOW\tCLOSE\tUPPER\tMIDDLE\tLOWER", "----\t----\t---\t-----\t-----\t------\t-----")
+
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			timestampCell(row.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04"),
+			priceCell("%.4f", row.High), priceCell("%.4f", row.Low), priceCell("%.4f", row.Close),
+			formatIndicatorValue(row.Upper), formatIndicatorValue(row.Middle), formatIndicatorValue(row.Lower))
+	}
+	w.Flush()
+
+	return nil
 }
 
-// cryptoMACDCmd retrieves Moving Average Convergence/Divergence (MACD) data
-// for a crypto ticker over a specified time range. MACD is a momentum
-// indicator with three components: the MACD line, signal line, and histogram.
-// Usage: massive crypto macd X:BTCUSD --from 2025-01-06 --to 2025-01-10
-var cryptoMACDCmd = &cobra.Command{
-	Use:   "macd [ticker]",
-	Short: "Get Moving Average Convergence/Divergence (MACD) for a crypto ticker",
-	Long:  "Retrieve MACD indicator data for a crypto ticker. MACD is a momentum indicator showing the relationship between two EMAs, with signal line and histogram.",
+// addCryptoChannelFlags registers the flags shared by the crypto keltner
+// and donchian subcommands: date range, bar size, and band window.
+func addCryptoChannelFlags(cmd *cobra.Command) {
+	cmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cmd.Flags().String("multiplier", "1", "Size of the timespan multiplier")
+	cmd.Flags().String("timespan", "day", "Timespan (minute, hour, day, week, month, quarter, year)")
+	cmd.Flags().Int("window", 20, "Number of bars in the band window")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+}
+
+// addCryptoIndicatorFlags registers the common flags shared by the crypto
+// SMA, EMA, and RSI indicator subcommands. These include date range,
+// window, timespan, series type, and pagination controls.
+func addCryptoIndicatorFlags(cmd *cobra.Command, defaultWindow string) {
+	cmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cmd.Flags().String("timespan", "day", "Aggregate time window (minute, hour, day, week, month, quarter, year)")
+	cmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
+	cmd.Flags().String("window", defaultWindow, "Number of periods for the indicator calculation")
+	cmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
+	cmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
+	addLimitFlag(cmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(cmd)
+
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cmd, "from", "to")
+}
+
+// addCryptoChangeFlags registers the flags shared by the crypto roc and
+// momentum subcommands: date range, bar size, and lookback window.
+func addCryptoChangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cmd.Flags().String("multiplier", "1", "Size of the timespan multiplier")
+	cmd.Flags().String("timespan", "day", "Timespan (minute, hour, day, week, month, quarter, year)")
+	cmd.Flags().Int("window", 10, "Number of bars to look back")
+
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+}
+
+// cryptoReturnDistribution is the JSON output of the return-distribution
+// command. Moments are nil when there are too few returns to compute them.
+type cryptoReturnDistribution struct {
+	Ticker   string                      `json:"ticker"`
+	Returns  int                         `json:"returns"`
+	Mean     *float64                    `json:"mean,omitempty"`
+	StdDev   *float64                    `json:"stddev,omitempty"`
+	Skewness *float64                    `json:"skewness,omitempty"`
+	Kurtosis *float64                    `json:"kurtosis,omitempty"`
+	Buckets  []analytics.HistogramBucket `json:"buckets"`
+}
+
+// returnHistogramWidth is the length in characters of the longest bar in
+// the return-distribution histogram.
+const returnHistogramWidth = 50
+
+// cryptoReturnDistributionCmd computes close-to-close percent returns from
+// crypto aggregate bars and prints their distribution as a text histogram
+// along with mean, standard deviation, skewness, and excess kurtosis.
+// Usage: massive crypto return-distribution X:BTCUSD --from 2024-01-01 --to 2025-01-01 --buckets 20
+var cryptoReturnDistributionCmd = &cobra.Command{
+	Use:   "return-distribution [ticker]",
+	Short: "Show a histogram of returns with skewness and kurtosis",
+	Long:  "Compute percent returns between consecutive crypto aggregate bars and print a text histogram of their distribution, plus mean, standard deviation, skewness, and excess kurtosis for risk analysis.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, err := newClient()
@@ -727,84 +2108,90 @@ var cryptoMACDCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
+		multiplier, _ := cmd.Flags().GetString("multiplier")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
 		from, _ := cmd.Flags().GetString("from")
 		to, _ := cmd.Flags().GetString("to")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		adjusted, _ := cmd.Flags().GetString("adjusted")
-		shortWindow, _ := cmd.Flags().GetString("short-window")
-		longWindow, _ := cmd.Flags().GetString("long-window")
-		signalWindow, _ := cmd.Flags().GetString("signal-window")
-		seriesType, _ := cmd.Flags().GetString("series-type")
-		order, _ := cmd.Flags().GetString("order")
-		limit, _ := cmd.Flags().GetString("limit")
+		buckets, _ := cmd.Flags().GetInt("buckets")
 
-		params := api.MACDParams{
-			TimestampGTE: from,
-			TimestampLTE: to,
-			Timespan:     timespan,
-			Adjusted:     adjusted,
-			ShortWindow:  shortWindow,
-			LongWindow:   longWindow,
-			SignalWindow: signalWindow,
-			SeriesType:   seriesType,
-			Order:        order,
-			Limit:        limit,
+		if buckets <= 0 {
+			return fmt.Errorf("--buckets must be a positive integer")
+		}
+
+		params := api.BarsParams{
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   "true",
+			Sort:       "asc",
+			Limit:      "50000",
 		}
 
-		result, err := client.GetCryptoMACD(ticker, params)
+		result, err := client.GetCryptoBars(ticker, params)
 		if err != nil {
 			return err
 		}
 
+		returns := analytics.Returns(result.Results)
+		dist := cryptoReturnDistribution{
+			Ticker:   ticker,
+			Returns:  len(returns),
+			Mean:     optionalFloat(analytics.Mean(returns)),
+			StdDev:   optionalFloat(analytics.StdDev(returns)),
+			Skewness: optionalFloat(analytics.Skewness(returns)),
+			Kurtosis: optionalFloat(analytics.Kurtosis(returns)),
+			Buckets:  analytics.Histogram(returns, buckets),
+		}
+
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printJSON(dist)
 		}
 
-		printMACDTable(ticker, result)
+		fmt.Printf("Ticker: %s | Returns: %d | Timespan: %s %s\n", ticker, dist.Returns, multiplier, timespan)
+		fmt.Printf("Mean: %s%% | Std Dev: %s%% | Skewness: %s | Excess Kurtosis: %s\n\n",
+			formatIndicatorValue(dist.Mean), formatIndicatorValue(dist.StdDev),
+			formatIndicatorValue(dist.Skewness), formatIndicatorValue(dist.Kurtosis))
+
+		if len(dist.Buckets) == 0 {
+			fmt.Println("Not enough bars to compute returns.")
+			return nil
+		}
+
+		peak := 0
+		for _, b := range dist.Buckets {
+			peak = max(peak, b.Count)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "RETURN RANGE %\tCOUNT\tHISTOGRAM", "--------------\t-----\t---------")
+
+		for _, b := range dist.Buckets {
+			bar := 0
+			if peak > 0 {
+				bar = b.Count * returnHistogramWidth / peak
+			}
+			if b.Count > 0 && bar == 0 {
+				bar = 1
+			}
+			fmt.Fprintf(w, "%.2f to %.2f\t%d\t%s\n", b.Lower, b.Upper, b.Count, strings.Repeat("#", bar))
+		}
+		w.Flush()
+
 		return nil
 	},
 }
 
-// buildCryptoIndicatorParams extracts the common indicator flags from the
-// cobra command and returns a populated IndicatorParams struct. This is
-// shared by the crypto SMA, EMA, and RSI commands.
-func buildCryptoIndicatorParams(cmd *cobra.Command) api.IndicatorParams {
-	from, _ := cmd.Flags().GetString("from")
-	to, _ := cmd.Flags().GetString("to")
-	timespan, _ := cmd.Flags().GetString("timespan")
-	adjusted, _ := cmd.Flags().GetString("adjusted")
-	window, _ := cmd.Flags().GetString("window")
-	seriesType, _ := cmd.Flags().GetString("series-type")
-	order, _ := cmd.Flags().GetString("order")
-	limit, _ := cmd.Flags().GetString("limit")
-
-	return api.IndicatorParams{
-		TimestampGTE: from,
-		TimestampLTE: to,
-		Timespan:     timespan,
-		Adjusted:     adjusted,
-		Window:       window,
-		SeriesType:   seriesType,
-		Order:        order,
-		Limit:        limit,
+// optionalFloat returns a pointer to v, or nil when v is NaN so missing
+// values print as "-" and are omitted from JSON.
+func optionalFloat(v float64) *float64 {
+	if math.IsNaN(v) {
+		return nil
 	}
-}
-
-// addCryptoIndicatorFlags registers the common flags shared by the crypto
-// SMA, EMA, and RSI indicator subcommands. These include date range,
-// window, timespan, series type, and pagination controls.
-func addCryptoIndicatorFlags(cmd *cobra.Command, defaultWindow string) {
-	cmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
-	cmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
-	cmd.Flags().String("timespan", "day", "Aggregate time window (minute, hour, day, week, month, quarter, year)")
-	cmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
-	cmd.Flags().String("window", defaultWindow, "Number of periods for the indicator calculation")
-	cmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
-	cmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	cmd.Flags().String("limit", "10", "Max number of results (max 5000)")
-
-	cmd.MarkFlagRequired("from")
-	cmd.MarkFlagRequired("to")
+	return &v
 }
 
 // -------------------------------------------------------------------
@@ -818,7 +2205,7 @@ func addCryptoIndicatorFlags(cmd *cobra.Command, defaultWindow string) {
 var cryptoTickersCmd = &cobra.Command{
 	Use:   "tickers",
 	Short: "List and search crypto tickers",
-	Long:  "Retrieve a list of crypto tickers with optional filtering by name, active status, and pagination controls.",
+	Long:  "Retrieve a list of crypto tickers with optional filtering by name, active status, as-of date, and pagination controls.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, err := newClient()
 		if err != nil {
@@ -827,13 +2214,16 @@ var cryptoTickersCmd = &cobra.Command{
 
 		search, _ := cmd.Flags().GetString("search")
 		active, _ := cmd.Flags().GetString("active")
+		date, _ := cmd.Flags().GetString("date")
 		sort, _ := cmd.Flags().GetString("sort")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTickers, limit)
 
 		params := api.CryptoTickersParams{
 			Search: search,
 			Active: active,
+			Date:   date,
 			Sort:   sort,
 			Order:  order,
 			Limit:  limit,
@@ -844,17 +2234,58 @@ var cryptoTickersCmd = &cobra.Command{
 			return err
 		}
 
+		enrich, _ := cmd.Flags().GetBool("enrich")
+		var overviews map[string]api.CryptoTickerOverview
+		var errs map[string]error
+		if enrich {
+			tickerSymbols := make([]string, len(result.Results))
+			for i, t := range result.Results {
+				tickerSymbols[i] = t.Ticker
+			}
+			overviews, errs = client.GetCryptoTickerOverviews(tickerSymbols, cryptoEnrichConcurrency)
+		}
+
 		if outputFormat == "json" {
-			return printJSON(result)
+			if !enrich {
+				return printJSON(result)
+			}
+
+			enriched := cryptoEnrichedTickersResponse{
+				Status:    result.Status,
+				Count:     result.Count,
+				RequestID: result.RequestID,
+				NextURL:   result.NextURL,
+				Errors:    enrichErrorStrings(errs),
+			}
+			for _, t := range result.Results {
+				o := overviews[strings.ToUpper(t.Ticker)]
+				enriched.Results = append(enriched.Results, cryptoEnrichedTicker{
+					BaseCurrencyName:  o.BaseCurrencyName,
+					QuoteCurrencyName: o.CurrencyName,
+					Ticker:            t,
+				})
+			}
+			return printJSON(enriched)
 		}
 
-		fmt.Printf("Results: %d\n\n", result.Count)
+		warnEnrichErrors(errs)
+
+		printSummary("Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tNAME\tMARKET\tACTIVE")
-		fmt.Fprintln(w, "------\t----\t------\t------")
+		if enrich {
+			writeHeader(w, "TICKER\tNAME\tBASE\tQUOTE\tMARKET\tACTIVE", "------\t----\t----\t-----\t------\t------")
+		} else {
+			writeHeader(w, "TICKER\tNAME\tMARKET\tACTIVE", "------\t----\t------\t------")
+		}
 
 		for _, t := range result.Results {
+			if enrich {
+				o := overviews[strings.ToUpper(t.Ticker)]
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n",
+					t.Ticker, t.Name, dashIfEmpty(o.BaseCurrencyName), dashIfEmpty(o.CurrencyName), t.Market, t.Active)
+				continue
+			}
 			fmt.Fprintf(w, "%s\t%s\t%s\t%v\n",
 				t.Ticker, t.Name, t.Market, t.Active)
 		}
@@ -864,47 +2295,80 @@ var cryptoTickersCmd = &cobra.Command{
 	},
 }
 
+// cryptoEnrichedTicker is a reference ticker with the base and quote
+// currency names from its ticker overview, used for tickers --enrich JSON
+// output.
+type cryptoEnrichedTicker struct {
+	BaseCurrencyName  string `json:"base_currency_name"`
+	QuoteCurrencyName string `json:"quote_currency_name"`
+	api.Ticker
+}
+
+// cryptoEnrichedTickersResponse is the tickers --enrich JSON output.
+// Errors maps tickers whose overview could not be fetched to the reason.
+type cryptoEnrichedTickersResponse struct {
+	Status    string                 `json:"status"`
+	Count     int                    `json:"count"`
+	RequestID string                 `json:"request_id"`
+	NextURL   string                 `json:"next_url"`
+	Results   []cryptoEnrichedTicker `json:"results"`
+	Errors    map[string]string      `json:"enrich_errors,omitempty"`
+}
+
 // cryptoTickerOverviewCmd retrieves detailed reference information for
-// a specific crypto ticker including currency details and active status.
-// Usage: massive crypto ticker-overview X:BTCUSD
+// one or more crypto tickers including currency details and active status.
+// Several tickers are fetched concurrently and rendered one after another.
+// Usage: massive crypto ticker-overview X:BTCUSD X:ETHUSD
 var cryptoTickerOverviewCmd = &cobra.Command{
-	Use:   "ticker-overview [ticker]",
-	Short: "Get detailed overview for a crypto ticker",
-	Long:  "Retrieve detailed reference information for a specific crypto ticker including currency details, base currency, and active status.",
-	Args:  cobra.ExactArgs(1),
+	Use:   "ticker-overview [ticker...]",
+	Short: "Get detailed overviews for one or more crypto tickers",
+	Long:  "Retrieve detailed reference information for one or more crypto tickers including currency details, base currency, and active status.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := newClient()
+		tickers, err := batchTickers(cmd, args)
 		if err != nil {
 			return err
 		}
 
-		ticker := strings.ToUpper(args[0])
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
 
-		result, err := client.GetCryptoTickerOverview(ticker)
+		results, err := fetchEach(client, tickers, client.GetCryptoTickerOverview)
 		if err != nil {
 			return err
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printJSONEach(results)
 		}
 
-		r := result.Results
-		fmt.Printf("Ticker:              %s\n", r.Ticker)
-		fmt.Printf("Name:                %s\n", r.Name)
-		fmt.Printf("Market:              %s\n", r.Market)
-		fmt.Printf("Locale:              %s\n", r.Locale)
-		fmt.Printf("Active:              %v\n", r.Active)
-		fmt.Printf("Currency Symbol:     %s\n", r.CurrencySymbol)
-		fmt.Printf("Currency Name:       %s\n", r.CurrencyName)
-		fmt.Printf("Base Currency Symbol: %s\n", r.BaseCurrencySymbol)
-		fmt.Printf("Base Currency Name:  %s\n", r.BaseCurrencyName)
-		fmt.Printf("Last Updated:        %s\n", r.LastUpdatedUTC)
+		for i, result := range results {
+			if i > 0 {
+				fmt.Println()
+			}
+			printCryptoTickerOverview(result.Results)
+		}
 
 		return nil
 	},
 }
 
+// printCryptoTickerOverview prints one crypto ticker's reference details
+// as aligned label/value lines.
+func printCryptoTickerOverview(r api.CryptoTickerOverview) {
+	fmt.Printf("Ticker:              %s\n", r.Ticker)
+	fmt.Printf("Name:                %s\n", r.Name)
+	fmt.Printf("Market:              %s\n", r.Market)
+	fmt.Printf("Locale:              %s\n", r.Locale)
+	fmt.Printf("Active:              %v\n", r.Active)
+	fmt.Printf("Currency Symbol:     %s\n", r.CurrencySymbol)
+	fmt.Printf("Currency Name:       %s\n", r.CurrencyName)
+	fmt.Printf("Base Currency Symbol: %s\n", r.BaseCurrencySymbol)
+	fmt.Printf("Base Currency Name:  %s\n", r.BaseCurrencyName)
+	fmt.Printf("Last Updated:        %s\n", r.LastUpdatedUTC)
+}
+
 // -------------------------------------------------------------------
 // Trades Commands
 // -------------------------------------------------------------------
@@ -925,13 +2389,23 @@ var cryptoTradesCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		timestamp, _ := cmd.Flags().GetString("timestamp")
-		timestampGte, _ := cmd.Flags().GetString("timestamp-gte")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
-		timestampLte, _ := cmd.Flags().GetString("timestamp-lte")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTrades, limit)
 		sort, _ := cmd.Flags().GetString("sort")
+		sinceFile, _ := cmd.Flags().GetString("since-file")
+		exchange, _ := cmd.Flags().GetString("exchange")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.CryptoTradesParams{
 			Timestamp:    timestamp,
@@ -942,6 +2416,27 @@ var cryptoTradesCmd = &cobra.Command{
 			Order:        order,
 			Limit:        limit,
 			Sort:         sort,
+			Cursor:       cursor,
+		}
+
+		// In incremental mode fetch oldest first from the saved position:
+		// the next page's cursor when the last run stopped mid-pull,
+		// otherwise trades after the saved high-watermark.
+		var since sinceState
+		if sinceFile != "" {
+			if err := checkSinceFileFlags(cmd); err != nil {
+				return err
+			}
+			since, err = readSinceFile(sinceFile)
+			if err != nil {
+				return err
+			}
+			params.Sort, params.Order = "timestamp", "asc"
+			if since.Cursor != "" {
+				params.Cursor = since.Cursor
+			} else if since.Timestamp > 0 {
+				params.TimestampGt = strconv.FormatInt(since.Timestamp, 10)
+			}
 		}
 
 		result, err := client.GetCryptoTrades(ticker, params)
@@ -949,76 +2444,240 @@ var cryptoTradesCmd = &cobra.Command{
 			return err
 		}
 
+		latest := since.Timestamp
+		for _, trade := range result.Results {
+			latest = max(latest, trade.ParticipantTimestamp)
+		}
+
+		// The API has no server-side exchange filter for crypto trades, so
+		// drop other venues' rows after fetching.
+		if exchange != "" {
+			id, err := resolveExchangeID(client, "crypto", exchange)
+			if err != nil {
+				return err
+			}
+			filtered := result.Results[:0]
+			for _, trade := range result.Results {
+				if trade.Exchange == id {
+					filtered = append(filtered, trade)
+				}
+			}
+			result.Results = filtered
+		}
+
 		if outputFormat == "json" {
-			return printJSON(result)
+			if err := printJSON(result); err != nil {
+				return err
+			}
+			return saveSince(sinceFile, since, latest, result.NextURL)
 		}
 
-		fmt.Printf("Ticker: %s | Trades: %d\n\n", ticker, len(result.Results))
+		printSummary("Ticker: %s | Trades: %d", ticker, len(result.Results))
 
+		legend, _ := cmd.Flags().GetBool("legend")
+		header, sep := legendColumns(legend, "TIMESTAMP\tPRICE\tSIZE\tEXCHANGE\tID", "---------\t-----\t----\t--------\t--")
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tPRICE\tSIZE\tEXCHANGE\tID")
-		fmt.Fprintln(w, "---------\t-----\t----\t--------\t--")
+		writeHeader(w, header, sep)
+
+		conditions := make([][]int, len(result.Results))
+		for i, trade := range result.Results {
+			row := fmt.Sprintf("%s\t%s\t%.4f\t%d\t%s",
+				timestampCell(trade.ParticipantTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", trade.Price), trade.Size, trade.Exchange, trade.ID)
+			if legend {
+				row += "\t" + conditionsCell(trade.Conditions)
+			}
+			fmt.Fprintln(w, row)
+			conditions[i] = trade.Conditions
+		}
+		w.Flush()
+		if legend {
+			printConditionLegend(client, "crypto", conditionTrade, conditions)
+		}
+		printNextCursor(result.NextURL)
 
-		for _, trade := range result.Results {
-			t := time.Unix(0, trade.ParticipantTimestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%d\t%s\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				trade.Price, trade.Size, trade.Exchange, trade.ID)
+		return saveSince(sinceFile, since, latest, result.NextURL)
+	},
+}
+
+// cryptoTradeStatsCmd summarizes every trade for a crypto ticker in a time
+// window: trade count, total volume, VWAP, average trade size, and trades
+// per minute. Pages are streamed into the aggregator one at a time, so
+// even busy windows run in bounded memory.
+// Usage: massive crypto trade-stats X:BTCUSD --from 2025-01-15 --to 2025-01-16
+var cryptoTradeStatsCmd = &cobra.Command{
+	Use:   "trade-stats [ticker]",
+	Short: "Summarize trade count, volume, VWAP, and trade rate for a window",
+	Long:  "Page through every trade for a crypto ticker between --from and --to and report trade count, total volume, VWAP, average trade size, price range, and trades per minute to quantify liquidity. Trades are aggregated as pages arrive rather than loaded into memory.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
+
+		params := api.CryptoTradesParams{
+			TimestampGte: from,
+			TimestampLte: to,
+			Limit:        "50000",
+		}
+
+		fetcher := api.NewAdaptiveFetcher(client, 1)
+		pages := 0
+
+		// Yield trades page by page, following next_url. A fetch error stops
+		// the iteration and is reported once the summary returns.
+		var fetchErr error
+		trades := func(yield func(api.CryptoTrade) bool) {
+			var page *api.CryptoTradesResponse
+			fetchErr = fetcher.Do(func() error {
+				var err error
+				page, err = client.GetCryptoTrades(ticker, params)
+				return err
+			})
+
+			for fetchErr == nil {
+				pages++
+				for _, trade := range page.Results {
+					if !yield(trade) {
+						return
+					}
+				}
+
+				if page.NextURL == "" {
+					return
+				}
+
+				nextURL := page.NextURL
+				fetchErr = fetcher.Do(func() error {
+					var err error
+					page, err = client.GetCryptoTradesNext(nextURL)
+					return err
+				})
+			}
+		}
+
+		stats := analytics.SummarizeTrades(trades)
+		if fetchErr != nil {
+			return fetchErr
+		}
+
+		if outputFormat == "json" {
+			return printJSON(stats)
 		}
+
+		printSummary("Ticker: %s | Window: %s to %s | Pages: %d", ticker, from, to, pages)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "METRIC\tVALUE", "------\t-----")
+		fmt.Fprintf(w, "Trades\t%d\n", stats.Trades)
+		fmt.Fprintf(w, "Volume\t%.8f\n", stats.Volume)
+		fmt.Fprintf(w, "Notional\t%.2f\n", stats.Notional)
+		fmt.Fprintf(w, "VWAP\t%s\n", priceCell("%.4f", stats.VWAP))
+		fmt.Fprintf(w, "Avg Trade Size\t%.8f\n", stats.AvgSize)
+		fmt.Fprintf(w, "High\t%s\n", priceCell("%.4f", stats.High))
+		fmt.Fprintf(w, "Low\t%s\n", priceCell("%.4f", stats.Low))
+		if stats.Trades > 0 {
+			fmt.Fprintf(w, "First Trade\t%s\n", timestampCell(stats.FirstTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
+			fmt.Fprintf(w, "Last Trade\t%s\n", timestampCell(stats.LastTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
+		}
+		fmt.Fprintf(w, "Trades/Minute\t%.2f\n", stats.TradesPerMinute)
 		w.Flush()
 
 		return nil
 	},
 }
 
-// cryptoLastTradeCmd retrieves the most recent trade for a specific crypto
-// pair. Returns price, size, exchange, and timestamp information useful
-// for monitoring current market activity.
+// cryptoLastTradeCmd retrieves the most recent trade for one or more
+// crypto pairs. Returns price, size, exchange, and timestamp information
+// useful for monitoring current market activity. A single pair can be
+// given as two currency arguments; several pairs are given as FROM/TO
+// arguments, fetched concurrently, and rendered one after another.
 // Usage: massive crypto last-trade BTC USD
+// Usage: massive crypto last-trade BTC/USD ETH/USD SOL/USD
 var cryptoLastTradeCmd = &cobra.Command{
-	Use:   "last-trade [from] [to]",
-	Short: "Get the most recent trade for a crypto pair",
-	Long:  "Retrieve the last available trade for a crypto currency pair including price, size, exchange, conditions, and timestamp.",
-	Args:  cobra.ExactArgs(2),
+	Use:   "last-trade [from] [to] | [from/to...]",
+	Short: "Get the most recent trade for one or more crypto pairs",
+	Long:  "Retrieve the last available trade for one or more crypto currency pairs including price, size, exchange, conditions, and timestamp. Pass a single pair as two arguments (BTC USD) or any number of pairs as FROM/TO arguments (BTC/USD ETH/USD).",
+	Args:  cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := newClient()
+		pairs, err := cryptoPairArgs(args)
 		if err != nil {
 			return err
 		}
 
-		from := strings.ToUpper(args[0])
-		to := strings.ToUpper(args[1])
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
 
-		result, err := client.GetCryptoLastTrade(from, to)
+		results, err := fetchEach(client, pairs, func(pair string) (*api.CryptoLastTradeResponse, error) {
+			from, to, _ := strings.Cut(pair, "/")
+			return client.GetCryptoLastTrade(from, to)
+		})
 		if err != nil {
 			return err
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printJSONEach(results)
 		}
 
-		last := result.Last
-		t := time.UnixMilli(last.Timestamp)
-
-		fmt.Printf("Symbol:    %s\n", result.Symbol)
-		fmt.Printf("Price:     %.4f\n", last.Price)
-		fmt.Printf("Size:      %.4f\n", last.Size)
-		fmt.Printf("Exchange:  %d\n", last.Exchange)
-		fmt.Printf("Timestamp: %s\n", t.Format("2006-01-02 15:04:05.000"))
-
-		if len(last.Conditions) > 0 {
-			condStrs := make([]string, len(last.Conditions))
-			for i, c := range last.Conditions {
-				condStrs[i] = fmt.Sprintf("%d", c)
+		for i, result := range results {
+			if i > 0 {
+				fmt.Println()
 			}
-			fmt.Printf("Conditions: %s\n", strings.Join(condStrs, ", "))
+			printCryptoLastTrade(result)
 		}
 
 		return nil
 	},
 }
 
+// cryptoPairArgs normalizes last-trade arguments to upper-cased FROM/TO
+// pairs. Two arguments without a slash are the legacy single-pair form
+// (BTC USD); otherwise every argument must be a FROM/TO pair.
+func cryptoPairArgs(args []string) ([]string, error) {
+	if len(args) == 2 && !strings.Contains(args[0], "/") && !strings.Contains(args[1], "/") {
+		return []string{strings.ToUpper(args[0]) + "/" + strings.ToUpper(args[1])}, nil
+	}
+
+	pairs := make([]string, 0, len(args))
+	for _, arg := range args {
+		from, to, ok := strings.Cut(arg, "/")
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid pair %q: expected FROM TO, or FROM/TO pairs such as BTC/USD", arg)
+		}
+		pairs = append(pairs, strings.ToUpper(from)+"/"+strings.ToUpper(to))
+	}
+
+	return pairs, nil
+}
+
+// printCryptoLastTrade prints one pair's last trade as label/value lines,
+// including its condition codes when present.
+func printCryptoLastTrade(result *api.CryptoLastTradeResponse) {
+	last := result.Last
+
+	fmt.Printf("Symbol:    %s\n", result.Symbol)
+	fmt.Printf("Price:     %s\n", priceCell("%.4f", last.Price))
+	fmt.Printf("Size:      %.4f\n", last.Size)
+	fmt.Printf("Exchange:  %d\n", last.Exchange)
+	fmt.Printf("Timestamp: %s\n", timestampCell(last.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04:05.000"))
+
+	if len(last.Conditions) > 0 {
+		condStrs := make([]string, len(last.Conditions))
+		for i, c := range last.Conditions {
+			condStrs[i] = fmt.Sprintf("%d", c)
+		}
+		fmt.Printf("Conditions: %s\n", strings.Join(condStrs, ", "))
+	}
+}
+
 // init registers the crypto parent command and all subcommands with
 // their respective flags under the root command.
 func init() {
@@ -1032,13 +2691,29 @@ func init() {
 	cryptoBarsCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
 	cryptoBarsCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 	cryptoBarsCmd.Flags().String("sort", "asc", "Sort order (asc/desc)")
-	cryptoBarsCmd.Flags().String("limit", "5000", "Max number of results (max 50000)")
+	addLimitFlag(cryptoBarsCmd, api.LimitAggs, "Max number of results")
 	cryptoBarsCmd.MarkFlagRequired("from")
 	cryptoBarsCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cryptoBarsCmd, "from", "to")
+	useTickerIndex(cryptoBarsCmd, "crypto")
+	supportsChartJSON(cryptoBarsCmd)
+	supportsParquet(cryptoBarsCmd)
+	supportsExplain(cryptoBarsCmd)
 	cryptoCmd.AddCommand(cryptoBarsCmd)
 
+	// Intraday command flags
+	cryptoIntradayCmd.Flags().Int("minutes", 5, "Minutes per bar")
+	cryptoIntradayCmd.Flags().Int("bars", 100, "Number of most recent bars to show")
+	useTickerIndex(cryptoIntradayCmd, "crypto")
+	supportsChartJSON(cryptoIntradayCmd)
+	supportsParquet(cryptoIntradayCmd)
+	cryptoCmd.AddCommand(cryptoIntradayCmd)
+
 	// Daily market summary command flags
 	cryptoDailyMarketSummaryCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
+	cryptoDailyMarketSummaryCmd.Flags().String("locale", "", "Market locale (crypto is grouped under global)")
+	addSummaryScreenFlags(cryptoDailyMarketSummaryCmd)
+	cryptoDailyMarketSummaryCmd.Flags().String("group-by", "", "Set to market to total volume, dollar volume, and trades per quote currency (USD, EUR, USDT, ...) instead of listing pairs")
 	cryptoCmd.AddCommand(cryptoDailyMarketSummaryCmd)
 
 	// Daily ticker summary command flags
@@ -1056,14 +2731,35 @@ func init() {
 	cryptoCmd.AddCommand(cryptoMarketStatusCmd)
 
 	// Snapshot commands
+	useTickerIndex(cryptoSnapshotCmd, "crypto")
+	addMaxAgeFlags(cryptoSnapshotCmd)
+	cryptoSnapshotCmd.Flags().Bool("classify", false, "Label the last trade BUY, SELL, or MID with the Lee-Ready rule (quote midpoint, then tick test)")
+	addTickersFileFlag(cryptoSnapshotCmd)
 	cryptoCmd.AddCommand(cryptoSnapshotCmd)
 
 	cryptoSnapshotMarketCmd.Flags().String("tickers", "", "Comma-separated list of ticker symbols (default: all)")
+	cryptoSnapshotMarketCmd.Flags().Bool("enrich", false, "Add a NAME column from each ticker's overview (cached on disk for a week)")
+	addMaxAgeFlags(cryptoSnapshotMarketCmd)
 	cryptoCmd.AddCommand(cryptoSnapshotMarketCmd)
 
+	cryptoBookCmd.Flags().Int("depth", 10, "Number of price levels to show on each side of the book")
+	cryptoCmd.AddCommand(cryptoBookCmd)
+
+	addMaxAgeFlags(cryptoGainersCmd)
 	cryptoCmd.AddCommand(cryptoGainersCmd)
+	addMaxAgeFlags(cryptoLosersCmd)
 	cryptoCmd.AddCommand(cryptoLosersCmd)
 
+	cryptoMoversCmd.Flags().Int("count", 0, "Maximum tickers to show in each list (0 for all)")
+	addMaxAgeFlags(cryptoMoversCmd)
+	cryptoCmd.AddCommand(cryptoMoversCmd)
+
+	// Gaps command flags
+	cryptoGapsCmd.Flags().String("tickers", "", "Comma-separated list of ticker symbols to scan (default: all)")
+	cryptoGapsCmd.Flags().Float64("min-gap", 0, "Only show gaps of at least this percent, up or down")
+	addMaxAgeFlags(cryptoGapsCmd)
+	cryptoCmd.AddCommand(cryptoGapsCmd)
+
 	// Technical indicator commands
 	addCryptoIndicatorFlags(cryptoSMACmd, "10")
 	cryptoCmd.AddCommand(cryptoSMACmd)
@@ -1084,33 +2780,118 @@ func init() {
 	cryptoMACDCmd.Flags().String("signal-window", "9", "Signal line EMA period")
 	cryptoMACDCmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	cryptoMACDCmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	cryptoMACDCmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(cryptoMACDCmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(cryptoMACDCmd)
+	cryptoMACDCmd.Flags().Bool("local", false, "Compute MACD locally from aggregate bars instead of the indicator endpoint")
 	cryptoMACDCmd.MarkFlagRequired("from")
 	cryptoMACDCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cryptoMACDCmd, "from", "to")
 	cryptoCmd.AddCommand(cryptoMACDCmd)
 
+	// Combined indicators flags
+	cryptoIndicatorsCmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cryptoIndicatorsCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cryptoIndicatorsCmd.Flags().String("timespan", "day", "Aggregate time window (minute, hour, day, week, month, quarter, year)")
+	cryptoIndicatorsCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
+	cryptoIndicatorsCmd.Flags().String("sma-window", "10", "Number of periods for the SMA calculation")
+	cryptoIndicatorsCmd.Flags().String("ema-window", "10", "Number of periods for the EMA calculation")
+	cryptoIndicatorsCmd.Flags().String("rsi-window", "14", "Number of periods for the RSI calculation")
+	cryptoIndicatorsCmd.Flags().String("short-window", "12", "Short EMA period for MACD line")
+	cryptoIndicatorsCmd.Flags().String("long-window", "26", "Long EMA period for MACD line")
+	cryptoIndicatorsCmd.Flags().String("signal-window", "9", "Signal line EMA period")
+	cryptoIndicatorsCmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
+	cryptoIndicatorsCmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
+	addLimitFlag(cryptoIndicatorsCmd, api.LimitIndicators, "Max number of results per indicator")
+	cryptoIndicatorsCmd.MarkFlagRequired("from")
+	cryptoIndicatorsCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cryptoIndicatorsCmd, "from", "to")
+	cryptoCmd.AddCommand(cryptoIndicatorsCmd)
+
+	// Crossover flags
+	cryptoCrossoverCmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cryptoCrossoverCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cryptoCrossoverCmd.Flags().String("type", "sma", "Moving average type (sma, ema)")
+	cryptoCrossoverCmd.Flags().String("fast", "50", "Number of periods for the fast moving average")
+	cryptoCrossoverCmd.Flags().String("slow", "200", "Number of periods for the slow moving average")
+	cryptoCrossoverCmd.Flags().String("timespan", "day", "Aggregate time window (minute, hour, day, week, month, quarter, year)")
+	cryptoCrossoverCmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
+	cryptoCrossoverCmd.Flags().String("limit", "5000", "Max number of values per moving average (max 5000)")
+	cryptoCrossoverCmd.MarkFlagRequired("from")
+	cryptoCrossoverCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cryptoCrossoverCmd, "from", "to")
+	cryptoCmd.AddCommand(cryptoCrossoverCmd)
+
+	// Williams %R flags
+	cryptoWilliamsRCmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cryptoWilliamsRCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cryptoWilliamsRCmd.Flags().String("multiplier", "1", "Size of the timespan multiplier")
+	cryptoWilliamsRCmd.Flags().String("timespan", "day", "Timespan (minute, hour, day, week, month, quarter, year)")
+	cryptoWilliamsRCmd.Flags().Int("window", 14, "Number of bars in the lookback window")
+	cryptoWilliamsRCmd.MarkFlagRequired("from")
+	cryptoWilliamsRCmd.MarkFlagRequired("to")
+	cryptoCmd.AddCommand(cryptoWilliamsRCmd)
+
+	// Rate-of-Change and momentum flags
+	addCryptoChangeFlags(cryptoROCCmd)
+	cryptoCmd.AddCommand(cryptoROCCmd)
+	addCryptoChangeFlags(cryptoMomentumCmd)
+	cryptoCmd.AddCommand(cryptoMomentumCmd)
+
+	// Keltner and Donchian channel flags
+	addCryptoChannelFlags(cryptoKeltnerCmd)
+	cryptoKeltnerCmd.Flags().Int("atr-window", 10, "Number of bars in the Average True Range window")
+	cryptoKeltnerCmd.Flags().Float64("atr-multiplier", 2, "Band distance from the EMA in Average True Ranges")
+	cryptoCmd.AddCommand(cryptoKeltnerCmd)
+	addCryptoChannelFlags(cryptoDonchianCmd)
+	cryptoCmd.AddCommand(cryptoDonchianCmd)
+
+	// Return distribution flags
+	cryptoReturnDistributionCmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
+	cryptoReturnDistributionCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
+	cryptoReturnDistributionCmd.Flags().String("multiplier", "1", "Size of the timespan multiplier")
+	cryptoReturnDistributionCmd.Flags().String("timespan", "day", "Timespan (minute, hour, day, week, month, quarter, year)")
+	cryptoReturnDistributionCmd.Flags().Int("buckets", 20, "Number of histogram buckets")
+	cryptoReturnDistributionCmd.MarkFlagRequired("from")
+	cryptoReturnDistributionCmd.MarkFlagRequired("to")
+	cryptoCmd.AddCommand(cryptoReturnDistributionCmd)
+
 	// Tickers command flags
 	cryptoTickersCmd.Flags().String("search", "", "Search by name or symbol")
-	cryptoTickersCmd.Flags().String("active", "", "Filter by active status (true/false)")
+	cryptoTickersCmd.Flags().String("active", "", "Filter by active status (true, false, or all for both)")
+	cryptoTickersCmd.Flags().String("date", "", "List tickers as of a past date (YYYY-MM-DD)")
 	cryptoTickersCmd.Flags().String("sort", "ticker", "Sort field (ticker, name)")
 	cryptoTickersCmd.Flags().String("order", "asc", "Sort order (asc/desc)")
-	cryptoTickersCmd.Flags().String("limit", "20", "Number of results to return (max 1000)")
+	addLimitFlag(cryptoTickersCmd, api.LimitTickers, "Number of results to return")
+	cryptoTickersCmd.Flags().Bool("enrich", false, "Add BASE and QUOTE currency name columns from each ticker's overview (cached on disk for a week)")
 	cryptoCmd.AddCommand(cryptoTickersCmd)
 
 	// Ticker overview command
+	addTickersFileFlag(cryptoTickerOverviewCmd)
 	cryptoCmd.AddCommand(cryptoTickerOverviewCmd)
 
 	// Trades command flags
-	cryptoTradesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD) or nanosecond timestamp")
+	cryptoTradesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp")
 	cryptoTradesCmd.Flags().String("timestamp-gte", "", "Timestamp greater than or equal to")
 	cryptoTradesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
 	cryptoTradesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
 	cryptoTradesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(cryptoTradesCmd, "timestamp-gte", "timestamp-lte")
 	cryptoTradesCmd.Flags().String("order", "", "Sort order (asc/desc)")
-	cryptoTradesCmd.Flags().String("limit", "1000", "Max number of results (max 50000)")
+	addLimitFlag(cryptoTradesCmd, api.LimitTrades, "Max number of results")
 	cryptoTradesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	cryptoTradesCmd.Flags().String("exchange", "", "Only show trades from this exchange (numeric ID or name)")
+	cryptoTradesCmd.Flags().String("since-file", "", "Fetch trades oldest first from the position saved in this file, then update it")
+	cryptoTradesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+	addLegendFlag(cryptoTradesCmd)
 	cryptoCmd.AddCommand(cryptoTradesCmd)
 
+	// Trade stats command flags
+	cryptoTradeStatsCmd.Flags().String("from", "", "Window start: date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp [required]")
+	cryptoTradeStatsCmd.Flags().String("to", "", "Window end: date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp [required]")
+	cryptoTradeStatsCmd.MarkFlagRequired("from")
+	cryptoTradeStatsCmd.MarkFlagRequired("to")
+	cryptoCmd.AddCommand(cryptoTradeStatsCmd)
+
 	// Last trade command
 	cryptoCmd.AddCommand(cryptoLastTradeCmd)
 }
@@ -0,0 +1,211 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/expr"
+	"github.com/spf13/cobra"
+)
+
+// errAlertNotFired is returned when an alert rule evaluated to false. It
+// exits with status 1 without printing an error, so cron jobs and scripts
+// can branch on whether the alert fired.
+var errAlertNotFired = errors.New("alert did not fire")
+
+// cryptoAlertFields lists the snapshot fields an alert rule may reference,
+// with a short description of each for the help text.
+var cryptoAlertFields = [][2]string{
+	{"price", "last trade price, or the day close without a trade"},
+	{"change", "today's change from the previous close"},
+	{"change_pct", "today's change in percent"},
+	{"open", "day open"},
+	{"high", "day high"},
+	{"low", "day low"},
+	{"close", "day close"},
+	{"volume", "day volume"},
+	{"vwap", "day volume-weighted average price"},
+	{"prev_close", "previous day close"},
+	{"prev_high", "previous day high"},
+	{"prev_low", "previous day low"},
+	{"prev_volume", "previous day volume"},
+	{"bid", "last quote bid"},
+	{"ask", "last quote ask"},
+	{"spread", "ask minus bid"},
+	{"trade_size", "last trade size"},
+	{"fmv", "fair market value (Business plans)"},
+}
+
+// cryptoAlertResult is the JSON form of an alert evaluation. Values holds
+// the snapshot value of each field the rule references.
+type cryptoAlertResult struct {
+	Ticker string             `json:"ticker"`
+	Rule   string             `json:"rule"`
+	Fired  bool               `json:"fired"`
+	Values map[string]float64 `json:"values"`
+}
+
+// cryptoAlertCmd evaluates an alert rule against a crypto ticker's
+// snapshot and exits 0 when it fires and 1 when it does not, for
+// cron-driven alerting.
+// Usage: massive crypto alert X:BTCUSD --rule 'change_pct > 5 || price < 40000'
+var cryptoAlertCmd = &cobra.Command{
+	Use:   "alert [ticker]",
+	Short: "Check an alert rule against a crypto ticker's snapshot",
+	Long: "Fetch a crypto ticker's snapshot and evaluate --rule against its fields. Prints whether the rule fired and exits 0 when it did, 1 when it did not, or another non-zero code on error.\n\n" +
+		"Rules compare fields with > >= < <= == !=, combine them with && || !, and may use + - * / and parentheses, e.g. 'price > prev_close * 1.05'. Fields:\n" +
+		cryptoAlertFieldHelp(),
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ruleFlag, _ := cmd.Flags().GetString("rule")
+		rule, err := expr.Parse(ruleFlag)
+		if err != nil {
+			return fmt.Errorf("--rule: %w", err)
+		}
+		for _, f := range rule.Fields() {
+			if !isCryptoAlertField(f) {
+				return fmt.Errorf("--rule: unknown field %q; see massive crypto alert --help for the list", f)
+			}
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		result, err := client.GetCryptoSnapshotSingleTicker(ticker)
+		if err != nil {
+			return err
+		}
+
+		if err := checkSnapshotAges(cmd, cryptoSnapshotAges(result.Ticker)); err != nil {
+			return err
+		}
+
+		values := cryptoAlertValues(result.Ticker)
+		for _, f := range rule.Fields() {
+			if _, ok := values[f]; !ok {
+				return fmt.Errorf("%s snapshot has no %s value to evaluate the rule against", ticker, f)
+			}
+		}
+
+		fired, err := rule.Eval(values)
+		if err != nil {
+			return fmt.Errorf("--rule: %w", err)
+		}
+
+		out := cryptoAlertResult{Ticker: ticker, Rule: rule.String(), Fired: fired, Values: make(map[string]float64)}
+		for _, f := range rule.Fields() {
+			out.Values[f] = values[f]
+		}
+
+		if outputFormat == "json" {
+			if err := printJSON(out); err != nil {
+				return err
+			}
+		} else {
+			printCryptoAlert(out)
+		}
+
+		if !fired {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return errAlertNotFired
+		}
+
+		return nil
+	},
+}
+
+// cryptoAlertValues maps a snapshot onto the alert rule fields. Fields the
+// snapshot carries no data for, such as the quote on a ticker without one,
+// are left out rather than read as zero.
+func cryptoAlertValues(t api.CryptoSnapshotTicker) map[string]float64 {
+	values := map[string]float64{
+		"change":     t.TodaysChange,
+		"change_pct": t.TodaysChangePct,
+	}
+
+	if !t.Day.IsZero() {
+		values["open"] = t.Day.Open
+		values["high"] = t.Day.High
+		values["low"] = t.Day.Low
+		values["close"] = t.Day.Close
+		values["volume"] = t.Day.Volume
+		values["vwap"] = t.Day.VWAP
+	}
+	if !t.PrevDay.IsZero() {
+		values["prev_close"] = t.PrevDay.Close
+		values["prev_high"] = t.PrevDay.High
+		values["prev_low"] = t.PrevDay.Low
+		values["prev_volume"] = t.PrevDay.Volume
+	}
+	if !t.LastQuote.IsZero() {
+		values["bid"] = t.LastQuote.Bid
+		values["ask"] = t.LastQuote.Ask
+		values["spread"] = t.LastQuote.Ask - t.LastQuote.Bid
+	}
+	if !t.LastTrade.IsZero() {
+		values["price"] = t.LastTrade.Price
+		values["trade_size"] = t.LastTrade.Size
+	} else if !t.Day.IsZero() {
+		values["price"] = t.Day.Close
+	}
+	if t.FMV != 0 {
+		values["fmv"] = t.FMV
+	}
+
+	return values
+}
+
+// isCryptoAlertField reports whether name is a field alert rules accept.
+func isCryptoAlertField(name string) bool {
+	return slices.ContainsFunc(cryptoAlertFields, func(f [2]string) bool { return f[0] == name })
+}
+
+// cryptoAlertFieldHelp renders the rule fields as an indented list for
+// the command's long help.
+func cryptoAlertFieldHelp() string {
+	var b strings.Builder
+	for _, f := range cryptoAlertFields {
+		fmt.Fprintf(&b, "  %-12s %s\n", f[0], f[1])
+	}
+	return b.String()
+}
+
+// printCryptoAlert prints whether the rule fired followed by the value of
+// each field it references.
+func printCryptoAlert(out cryptoAlertResult) {
+	status := "NOT FIRED"
+	if out.Fired {
+		status = "FIRED"
+	}
+	fmt.Printf("%s: %s (%s)\n", status, out.Ticker, out.Rule)
+
+	names := make([]string, 0, len(out.Values))
+	for name := range out.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("  %-12s %s\n", name+":", priceCell("%.4f", out.Values[name]))
+	}
+}
+
+// init registers the alert command and its flags under the crypto parent command.
+func init() {
+	cryptoAlertCmd.Flags().String("rule", "", "Condition to test, e.g. 'change_pct > 5 || price < 40000'")
+	cryptoAlertCmd.MarkFlagRequired("rule")
+	addMaxAgeFlags(cryptoAlertCmd)
+	cryptoCmd.AddCommand(cryptoAlertCmd)
+}
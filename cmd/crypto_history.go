@@ -0,0 +1,165 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// historyConcurrency is the most history chunks fetched in parallel
+// before the adaptive fetcher backs off.
+const historyConcurrency = 4
+
+// historyChunkDays is how many days each history request covers per
+// granularity, sized so a 24/7 crypto market stays under the 50000 bar
+// limit (1440 minute bars a day, 24 hour bars).
+var historyChunkDays = map[string]int{
+	"minute": 30,
+	"hour":   2000,
+	"day":    40000,
+	"week":   40000,
+}
+
+// historyLayouts formats bar timestamps per granularity in tables.
+var historyLayouts = map[string]string{
+	"minute": "2006-01-02 15:04",
+	"hour":   "2006-01-02 15:04",
+	"day":    "2006-01-02",
+	"week":   "2006-01-02",
+}
+
+// cryptoHistoryCmd pulls a crypto ticker's complete bar history since its
+// first trade: the earliest daily bar is located with a single ascending
+// probe (or a binary search when the plan limits how far back data goes),
+// then the span up to --to is fetched in concurrent chunks and merged.
+// Usage: massive crypto history X:BTCUSD --granularity day
+var cryptoHistoryCmd = &cobra.Command{
+	Use:   "history [ticker]",
+	Short: "Get a crypto ticker's full bar history since inception",
+	Long:  "Find the earliest available bar for a crypto ticker and fetch every bar from then until --to (default today) in chunked requests, producing one complete OHLCV series for historical backfills. When the plan's lookback does not reach the first trade, history starts at the earliest date the plan allows and a warning says so.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		granularity, _ := cmd.Flags().GetString("granularity")
+		chunkDays, ok := historyChunkDays[granularity]
+		if !ok {
+			return fmt.Errorf("--granularity must be minute, hour, day, or week")
+		}
+
+		now := time.Now().UTC()
+		to := now
+		if toFlag, _ := cmd.Flags().GetString("to"); toFlag != "" {
+			parsed, err := time.Parse("2006-01-02", toFlag)
+			if err != nil {
+				return fmt.Errorf("--to must be a YYYY-MM-DD date")
+			}
+			to = parsed
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		first, available, err := client.EarliestBar(ticker, api.CryptoHistoryStart, to)
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			return fmt.Errorf("no bars found for %s up to %s", ticker, to.Format("2006-01-02"))
+		}
+		if !available.IsZero() {
+			warnf("data before %s is not available on this plan; history starts there", available.Format("2006-01-02"))
+		}
+
+		start := api.EpochTime(first.Timestamp).UTC()
+		result, err := fetchCryptoHistory(client, ticker, granularity, api.SplitDateRange(start, to, chunkDays))
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == chartJSONFormat {
+			return printChartJSON(chartBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		printCryptoBarsTable(result, historyLayouts[granularity])
+
+		return nil
+	},
+}
+
+// fetchCryptoHistory fetches each date range of a ticker's history
+// concurrently and merges them, in order, into one response. Bars that
+// appear in two chunks are kept once. A chunk that hits the bar limit is
+// reported, since its span was too long to fetch in full.
+func fetchCryptoHistory(client *api.Client, ticker, granularity string, ranges []api.DateRange) (*api.BarsResponse, error) {
+	limit := strconv.Itoa(aggsMaxLimit())
+	infof("Fetching %s bars for %s from %s to %s in %d request(s)", granularity, ticker, ranges[0].From, ranges[len(ranges)-1].To, len(ranges))
+
+	chunks := make([]*api.BarsResponse, len(ranges))
+	fetcher := api.NewAdaptiveFetcher(client, historyConcurrency)
+	err := fetcher.Run(len(ranges), func(i int) error {
+		result, err := client.GetCryptoBars(ticker, api.BarsParams{
+			Multiplier: "1",
+			Timespan:   granularity,
+			From:       ranges[i].From,
+			To:         ranges[i].To,
+			Sort:       "asc",
+			Limit:      limit,
+		})
+		if err != nil {
+			return fmt.Errorf("%s to %s: %w", ranges[i].From, ranges[i].To, err)
+		}
+		chunks[i] = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &api.BarsResponse{Status: "OK", Ticker: ticker, Adjusted: true}
+	var last int64
+	for i, chunk := range chunks {
+		if counts := api.NewBarCounts(chunk.QueryCount, chunk.ResultsCount, limit); counts.Truncated() {
+			warnf("%s to %s returned the maximum of %s bars; some bars in that span are missing", ranges[i].From, ranges[i].To, limit)
+		}
+
+		merged.QueryCount += chunk.QueryCount
+		for _, bar := range chunk.Results {
+			if len(merged.Results) > 0 && bar.Timestamp <= last {
+				continue
+			}
+			merged.Results = append(merged.Results, bar)
+			last = bar.Timestamp
+		}
+	}
+	merged.ResultsCount = len(merged.Results)
+
+	return merged, nil
+}
+
+// init registers the history command and its flags under the crypto parent command.
+func init() {
+	cryptoHistoryCmd.Flags().String("granularity", "day", "Bar size: minute, hour, day, or week")
+	cryptoHistoryCmd.Flags().String("to", "", "Last date of the history as YYYY-MM-DD (default today)")
+	supportsChartJSON(cryptoHistoryCmd)
+	supportsParquet(cryptoHistoryCmd)
+	cryptoCmd.AddCommand(cryptoHistoryCmd)
+}
@@ -0,0 +1,136 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// perfLookbackDays is how far before the reference date the daily bar
+// search reaches, so a gap in the data still finds the nearest earlier
+// close instead of failing.
+const perfLookbackDays = 7
+
+// cryptoPerfResult is the JSON form of a performance lookup. ReferenceDate
+// is the date of the daily bar whose close the change is measured from,
+// which can be earlier than the requested period when that day has no bar.
+type cryptoPerfResult struct {
+	Ticker         string  `json:"ticker"`
+	Over           string  `json:"over"`
+	ReferenceDate  string  `json:"reference_date"`
+	ReferencePrice float64 `json:"reference_price"`
+	Price          float64 `json:"price"`
+	Change         float64 `json:"change"`
+	ChangePct      float64 `json:"change_pct"`
+}
+
+// cryptoPerfCmd reports how far a crypto ticker has moved over a period:
+// the current snapshot price against the close of the daily bar at the
+// start of the period.
+// Usage: massive crypto perf X:BTCUSD --over 7d
+var cryptoPerfCmd = &cobra.Command{
+	Use:   "perf [ticker]",
+	Short: "Show a crypto ticker's change over a period",
+	Long:  "Compare a crypto ticker's current price (last trade, or the day close without one) with the close of the daily bar --over ago (7d, 4w, 6m, 1y), printing the absolute and percent change and the reference date used. When that day has no bar, the nearest earlier close within a week is used.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		over, _ := cmd.Flags().GetString("over")
+		now := time.Now().UTC()
+		refDate, err := relativeDate(over, now)
+		if err != nil {
+			return fmt.Errorf("--over: %w", err)
+		}
+		if refDate == over || refDate >= now.Format("2006-01-02") {
+			return fmt.Errorf("--over must be a period before today such as 7d, 4w, 6m, or 1y, got %q", over)
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		snapshot, err := client.GetCryptoSnapshotSingleTicker(ticker)
+		if err != nil {
+			return err
+		}
+		price, ok := cryptoAlertValues(snapshot.Ticker)["price"]
+		if !ok {
+			return fmt.Errorf("%s snapshot has no current price", ticker)
+		}
+
+		ref, err := cryptoReferenceBar(client, ticker, refDate)
+		if err != nil {
+			return err
+		}
+
+		out := cryptoPerfResult{
+			Ticker:         ticker,
+			Over:           over,
+			ReferenceDate:  time.UnixMilli(ref.Timestamp).UTC().Format("2006-01-02"),
+			ReferencePrice: ref.Close,
+			Price:          price,
+			Change:         price - ref.Close,
+		}
+		if ref.Close != 0 {
+			out.ChangePct = out.Change / ref.Close * 100
+		}
+
+		if outputFormat == "json" {
+			return printJSON(out)
+		}
+
+		printCryptoPerf(out)
+		return nil
+	},
+}
+
+// cryptoReferenceBar returns the last daily bar on or before date
+// (YYYY-MM-DD), searching back perfLookbackDays.
+func cryptoReferenceBar(client *api.Client, ticker, date string) (*api.Bar, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.GetCryptoBars(ticker, api.BarsParams{
+		Multiplier: "1",
+		Timespan:   "day",
+		From:       day.AddDate(0, 0, -perfLookbackDays).Format("2006-01-02"),
+		To:         date,
+		Sort:       "desc",
+		Limit:      "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, fmt.Errorf("no daily bar for %s in the %d days up to %s", ticker, perfLookbackDays, date)
+	}
+
+	return &result.Results[0], nil
+}
+
+// printCryptoPerf prints the reference close, the current price, and the
+// change between them.
+func printCryptoPerf(out cryptoPerfResult) {
+	fmt.Printf("Ticker:     %s\n", out.Ticker)
+	fmt.Printf("Period:     %s (reference %s)\n", out.Over, out.ReferenceDate)
+	fmt.Printf("Reference:  %s\n", priceCell("%.4f", out.ReferencePrice))
+	fmt.Printf("Current:    %s\n", priceCell("%.4f", out.Price))
+	fmt.Printf("Change:     %s (%+.2f%%)\n", priceCell("%.4f", out.Change), out.ChangePct)
+}
+
+// init registers the perf command and its flags under the crypto parent command.
+func init() {
+	cryptoPerfCmd.Flags().String("over", "7d", "Period to measure over, counted back from today (e.g. 7d, 4w, 6m, 1y)")
+	cryptoCmd.AddCommand(cryptoPerfCmd)
+}
@@ -0,0 +1,95 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestValidateCryptoIndicatorWindows verifies the windows are checked in
+// flag order, so the same flag is reported every run when several are
+// invalid.
+func TestValidateCryptoIndicatorWindows(t *testing.T) {
+	tests := []struct {
+		windows [6]string
+		wantErr string
+	}{
+		{[6]string{"50", "50", "14", "12", "26", "9"}, ""},
+		{[6]string{"x", "0", "-1", "12", "26", "9"}, "--sma-window"},
+		{[6]string{"50", "0", "-1", "12", "26", "9"}, "--ema-window"},
+		{[6]string{"50", "50", "-1", "0", "26", "9"}, "--rsi-window"},
+		{[6]string{"50", "50", "14", "0", "-26", "9"}, "--short-window"},
+		{[6]string{"50", "50", "14", "26", "12", "9"}, "--short-window (26) must be less than --long-window (12)"},
+	}
+
+	for _, tt := range tests {
+		w := tt.windows
+		for range 20 {
+			err := validateCryptoIndicatorWindows(w[0], w[1], w[2], w[3], w[4], w[5])
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("windows %v: unexpected error %v", w, err)
+				}
+				break
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Fatalf("windows %v: got %v, want an error starting %q", w, err, tt.wantErr)
+			}
+		}
+	}
+}
+
+// TestMergeCryptoIndicatorsMismatchedTimestamps verifies series that
+// cover different timestamps merge into one row per timestamp, with
+// missing values left nil, in the requested order.
+func TestMergeCryptoIndicatorsMismatchedTimestamps(t *testing.T) {
+	sma := &api.IndicatorResponse{}
+	sma.Results.Values = []api.IndicatorValue{{Timestamp: 300, Value: 3}, {Timestamp: 200, Value: 2}, {Timestamp: 100, Value: 1}}
+	ema := &api.IndicatorResponse{}
+	ema.Results.Values = []api.IndicatorValue{{Timestamp: 300, Value: 30}, {Timestamp: 400, Value: 40}}
+	rsi := &api.IndicatorResponse{}
+	rsi.Results.Values = []api.IndicatorValue{{Timestamp: 200, Value: 55}}
+	macd := &api.MACDResponse{}
+	macd.Results.Values = []api.MACDValue{{Timestamp: 100, Value: 0.5, Signal: 0.4, Histogram: 0.1}, {Timestamp: 400, Value: 0.7, Signal: 0.6, Histogram: 0.1}}
+
+	cell := func(v *float64) string {
+		if v == nil {
+			return "-"
+		}
+		return fmt.Sprint(*v)
+	}
+	render := func(rows []cryptoIndicatorRow) []string {
+		var out []string
+		for _, r := range rows {
+			out = append(out, fmt.Sprintf("%d %s %s %s %s %s %s", r.Timestamp, cell(r.SMA), cell(r.EMA), cell(r.RSI), cell(r.MACD), cell(r.Signal), cell(r.Histogram)))
+		}
+		return out
+	}
+
+	asc := []string{
+		"100 1 - - 0.5 0.4 0.1",
+		"200 2 - 55 - - -",
+		"300 3 30 - - - -",
+		"400 - 40 - 0.7 0.6 0.1",
+	}
+	if got := render(mergeCryptoIndicators(sma, ema, rsi, macd, "asc")); fmt.Sprint(got) != fmt.Sprint(asc) {
+		t.Errorf("asc rows =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(asc, "\n"))
+	}
+
+	desc := []string{asc[3], asc[2], asc[1], asc[0]}
+	if got := render(mergeCryptoIndicators(sma, ema, rsi, macd, "desc")); fmt.Sprint(got) != fmt.Sprint(desc) {
+		t.Errorf("desc rows =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(desc, "\n"))
+	}
+
+	empty := &api.IndicatorResponse{}
+	if rows := mergeCryptoIndicators(empty, empty, empty, &api.MACDResponse{}, "asc"); len(rows) != 0 {
+		t.Errorf("empty series merged into %d rows, want 0", len(rows))
+	}
+}
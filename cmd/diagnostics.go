@@ -0,0 +1,45 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// diagnostics prints the counts behind a bars response to stderr and
+// enables the sparse-data warning. Set via the global --diagnostics flag.
+var diagnostics bool
+
+// checkBarCounts warns on stderr when a bars response looks incomplete.
+// Hitting the requested limit is always reported since it silently drops
+// bars; with --diagnostics the raw counts are printed too, along with a
+// warning when queryCount dwarfs resultsCount.
+func checkBarCounts(queryCount, resultsCount int, limit string) {
+	counts := api.NewBarCounts(queryCount, resultsCount, limit)
+
+	if diagnostics {
+		fmt.Fprintf(os.Stderr, "Diagnostics: queryCount=%d resultsCount=%d limit=%d\n", counts.QueryCount, counts.ResultsCount, counts.Limit)
+	}
+
+	if counts.Truncated() {
+		warnf("returned %d bars, the --limit of %d; results are likely truncated. Narrow the range or raise --limit (max %d).",
+			counts.ResultsCount, counts.Limit, aggsMaxLimit())
+	}
+
+	if diagnostics && counts.Sparse() {
+		warnf("queryCount %d is over %dx resultsCount %d; the data may be sparse or capped by the aggregate window.",
+			counts.QueryCount, api.SparseBarsRatio, counts.ResultsCount)
+	}
+}
+
+// aggsMaxLimit returns the largest --limit the aggregate endpoints accept.
+func aggsMaxLimit() int {
+	spec, _ := api.EndpointLimit(api.LimitAggs)
+	return spec.Max
+}
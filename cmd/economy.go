@@ -68,11 +68,10 @@ var economyInflationCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Inflation Data | Results: %d\n\n", len(result.Results))
+		printSummary("Inflation Data | Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tCPI\tCPI CORE\tPCE\tPCE CORE\tPCE SPENDING")
-		fmt.Fprintln(w, "----\t---\t--------\t---\t--------\t------------")
+		writeHeader(w, "DATE\tCPI\tCPI CORE\tPCE\tPCE CORE\tPCE SPENDING", "----\t---\t--------\t---\t--------\t------------")
 
 		for _, r := range result.Results {
 			fmt.Fprintf(w, "%s\t%.3f\t%.3f\t%.3f\t%.3f\t%.1f\n",
@@ -130,11 +129,10 @@ var economyLaborMarketCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Labor Market Data | Results: %d\n\n", len(result.Results))
+		printSummary("Labor Market Data | Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tUNEMPLOYMENT\tPARTICIPATION\tHOURLY EARNINGS\tJOB OPENINGS")
-		fmt.Fprintln(w, "----\t------------\t-------------\t---------------\t------------")
+		writeHeader(w, "DATE\tUNEMPLOYMENT\tPARTICIPATION\tHOURLY EARNINGS\tJOB OPENINGS", "----\t------------\t-------------\t---------------\t------------")
 
 		for _, r := range result.Results {
 			jobOpenings := "-"
@@ -196,11 +194,10 @@ var economyTreasuryYieldsCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Treasury Yields | Results: %d\n\n", len(result.Results))
+		printSummary("Treasury Yields | Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\t1M\t3M\t6M\t1Y\t2Y\t3Y\t5Y\t7Y\t10Y\t20Y\t30Y")
-		fmt.Fprintln(w, "----\t--\t--\t--\t--\t--\t--\t--\t--\t---\t---\t---")
+		writeHeader(w, "DATE\t1M\t3M\t6M\t1Y\t2Y\t3Y\t5Y\t7Y\t10Y\t20Y\t30Y", "----\t--\t--\t--\t--\t--\t--\t--\t--\t---\t---\t---")
 
 		for _, r := range result.Results {
 			fmt.Fprintf(w, "%s\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\n",
@@ -0,0 +1,87 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// prettyErrors renders API errors as a formatted block with the status,
+// message, request ID, URL, and a hint instead of a single raw line. Set
+// via the global --pretty-errors flag; on by default.
+var prettyErrors bool
+
+// printError writes a command's final error to w. With --pretty-errors an
+// API error is broken out into labeled lines; any other error, or every
+// error with --pretty-errors=false, is printed as before.
+func printError(w io.Writer, err error) {
+	if !prettyErrors {
+		fmt.Fprintln(w, err)
+		return
+	}
+
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		fmt.Fprintf(w, "Error: %s\n", err)
+		return
+	}
+
+	fmt.Fprintln(w, "Error: API request failed")
+	if context := errorContext(err, apiErr); context != "" {
+		fmt.Fprintf(w, "  Context:    %s\n", context)
+	}
+	fmt.Fprintf(w, "  Status:     %d %s\n", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+	if msg := apiErr.Message(); msg != "" {
+		fmt.Fprintf(w, "  Message:    %s\n", msg)
+	}
+	if id := apiErr.RequestID(); id != "" {
+		fmt.Fprintf(w, "  Request ID: %s\n", id)
+	}
+	if apiErr.URL != "" {
+		fmt.Fprintf(w, "  URL:        %s\n", apiErr.URL)
+	}
+	if hint := apiErrorHint(apiErr); hint != "" {
+		fmt.Fprintf(w, "  Hint:       %s\n", hint)
+	}
+}
+
+// errorContext returns whatever a command wrapped around an API error,
+// such as the ticker that failed or the cursor to resume from, with the
+// API error's own text removed.
+func errorContext(err error, apiErr *api.APIError) string {
+	before, after, _ := strings.Cut(err.Error(), apiErr.Error())
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(before), ":") + " " + strings.TrimSpace(after))
+}
+
+// apiErrorHint suggests what to check next for common API error statuses.
+func apiErrorHint(apiErr *api.APIError) string {
+	switch code := apiErr.StatusCode; {
+	case code >= 300 && code < 400:
+		return "the API redirected the request; allow it with --follow-redirects"
+	case code == http.StatusBadRequest:
+		return "check the command's flags and argument formats (dates are YYYY-MM-DD)"
+	case code == http.StatusUnauthorized:
+		return "check your API key with 'massive config init' or MASSIVE_API_KEY"
+	case code == http.StatusForbidden:
+		return "your plan may not include this endpoint or data; check your API key entitlements"
+	case code == http.StatusNotFound:
+		return "check the ticker, date, or ID in the request"
+	case code == http.StatusTooManyRequests:
+		if apiErr.RetryAfter > 0 {
+			return fmt.Sprintf("rate limited; retry after %s or lower --concurrency", apiErr.RetryAfter)
+		}
+		return "rate limited; wait a moment or lower --concurrency"
+	case code >= 500:
+		return "the API had a server error; retry shortly"
+	}
+	return ""
+}
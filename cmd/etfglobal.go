@@ -67,11 +67,10 @@ var etfGlobalAnalyticsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("ETF Global Analytics | Results: %d\n\n", result.Count)
+		printSummary("ETF Global Analytics | Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDATE\tGRADE\tQUANT\tREWARD\tRISK\tTECH\tSENT\tFUND\tQUAL\tGLOBAL\tBEHAV")
-		fmt.Fprintln(w, "------\t----\t-----\t-----\t------\t----\t----\t----\t----\t----\t------\t-----")
+		writeHeader(w, "TICKER\tDATE\tGRADE\tQUANT\tREWARD\tRISK\tTECH\tSENT\tFUND\tQUAL\tGLOBAL\tBEHAV", "------\t----\t-----\t-----\t------\t----\t----\t----\t----\t----\t------\t-----")
 
 		for _, a := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\n",
@@ -143,11 +142,10 @@ var etfGlobalConstituentsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("ETF Global Constituents | Results: %d\n\n", result.Count)
+		printSummary("ETF Global Constituents | Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "RANK\tETF\tTICKER\tNAME\tWEIGHT\tSHARES\tMKT VALUE\tASSET CLASS\tEXCHANGE")
-		fmt.Fprintln(w, "----\t---\t------\t----\t------\t------\t---------\t-----------\t--------")
+		writeHeader(w, "RANK\tETF\tTICKER\tNAME\tWEIGHT\tSHARES\tMKT VALUE\tASSET CLASS\tEXCHANGE", "----\t---\t------\t----\t------\t------\t---------\t-----------\t--------")
 
 		for _, c := range result.Results {
 			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%.2f%%\t%.0f\t%.2f\t%s\t%s\n",
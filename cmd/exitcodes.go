@@ -0,0 +1,71 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// Exit codes returned by the CLI. Scripts can rely on these values to
+// tell failure modes apart without parsing error text.
+const (
+	exitGeneric     = 1 // any other error
+	exitAuth        = 2 // API rejected the key (401/403)
+	exitNotFound    = 3 // resource does not exist (404)
+	exitRateLimited = 4 // rate limit exceeded (429)
+	exitNoResults   = 5 // --fail-on-empty and every list came back empty
+	exitStale       = 6 // --max-age --strict and a snapshot was too old
+)
+
+// failOnEmpty makes the CLI exit with exitNoResults when every list
+// response of the run had zero results. Set via the global
+// --fail-on-empty flag.
+var failOnEmpty bool
+
+// clients holds every API client created during the run so the result
+// tallies can be checked for --fail-on-empty once the command finishes.
+var clients []*api.Client
+
+// exitCode maps a command error to its exit code. Stale --strict snapshots
+// and API errors (by HTTP status) have their own codes; everything else is
+// a generic failure.
+func exitCode(err error) int {
+	if errors.Is(err, errStaleSnapshot) {
+		return exitStale
+	}
+
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		return exitGeneric
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return exitAuth
+	case http.StatusNotFound:
+		return exitNotFound
+	case http.StatusTooManyRequests:
+		return exitRateLimited
+	}
+
+	return exitGeneric
+}
+
+// resultsEmpty reports whether the run fetched at least one list and all
+// of them were empty. Pages and fan-out requests are summed across every
+// client, so a single non-empty page means the run had results.
+func resultsEmpty() bool {
+	var total api.ResultCounts
+	for _, c := range clients {
+		counts := c.ResultCounts()
+		total.Lists += counts.Lists
+		total.Items += counts.Items
+	}
+	return total.Empty()
+}
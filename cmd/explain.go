@@ -0,0 +1,228 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// explainAnnotation marks commands that can describe their request with
+// --explain. Other commands reject the flag instead of ignoring it.
+const explainAnnotation = "explain"
+
+// explain prints a sentence describing what the command will fetch and
+// asks for confirmation before sending anything. assumeYes skips the
+// prompt. Set via the global --explain and --yes flags.
+var explain bool
+var assumeYes bool
+
+// errExplainDeclined is returned when the --explain confirmation is not
+// answered with yes. Execute treats it as a clean exit.
+var errExplainDeclined = errors.New("request not confirmed")
+
+// explainer is implemented by the request descriptions of commands that
+// support --explain. Explain returns one human-readable sentence built
+// from the command's resolved parameters.
+type explainer interface {
+	Explain() string
+}
+
+// supportsExplain marks cmd as able to describe its request with --explain.
+func supportsExplain(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[explainAnnotation] = "true"
+}
+
+// confirmExplain is called by supporting commands just before their first
+// request. Without --explain it does nothing. Otherwise it prints the
+// explanation to stderr, so JSON on stdout stays clean, and unless --yes
+// or --dry-run is set asks for confirmation on stdin, returning errExplainDeclined for
+// anything other than y or yes.
+func confirmExplain(e explainer) error {
+	if !explain {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stderr, e.Explain())
+	if assumeYes || dryRun {
+		return nil
+	}
+
+	fmt.Fprint(os.Stderr, "Proceed? [y/N]: ")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+
+	fmt.Fprintln(os.Stderr, "Aborted.")
+	return errExplainDeclined
+}
+
+// barsExplanation describes an aggregate bars request. It covers every
+// asset class that takes multiplier/timespan windows; Adjusted is left
+// empty where the endpoint has no adjustment option.
+type barsExplanation struct {
+	Ticker     string
+	Multiplier string
+	Timespan   string
+	From       string
+	To         string
+	Adjusted   string
+	Sort       string
+	Limit      string
+}
+
+// Explain implements explainer, e.g. "Will fetch daily adjusted OHLC bars
+// for X:BTCUSD from 2025-01-06 to 2025-01-08, sorted ascending, up to 5000
+// results".
+func (e barsExplanation) Explain() string {
+	words := []string{barSizeWords(e.Multiplier, e.Timespan)}
+	switch e.Adjusted {
+	case "true":
+		words = append(words, "adjusted")
+	case "false":
+		words = append(words, "unadjusted")
+	}
+
+	s := fmt.Sprintf("Will fetch %s OHLC bars for %s from %s to %s", strings.Join(words, " "), e.Ticker, e.From, e.To)
+	return s + sortAndLimitWords("", e.Sort, e.Limit, false)
+}
+
+// futuresBarsExplanation describes a futures aggregates request, which
+// uses a resolution and window_start filters instead of from/to dates.
+type futuresBarsExplanation struct {
+	Ticker string
+	Params api.FuturesAggParams
+}
+
+// Explain implements explainer, e.g. "Will fetch 1day OHLC bars for ESM5
+// from 2025-03-01 through 2025-03-31, sorted ascending, up to 5000
+// results".
+func (e futuresBarsExplanation) Explain() string {
+	p := e.Params
+	s := fmt.Sprintf("Will fetch %s OHLC bars for %s", p.Resolution, e.Ticker)
+	s += timeRangeWords(p.WindowStart, p.WindowStartGte, p.WindowStartGt, p.WindowStartLte, p.WindowStartLt)
+	return s + sortAndLimitWords("", p.Sort, p.Limit, false)
+}
+
+// tickExplanation describes a trades or quotes request. Kind is the plural
+// noun for the records, and All reports whether every page is followed.
+type tickExplanation struct {
+	Kind         string
+	Ticker       string
+	Timestamp    string
+	TimestampGte string
+	TimestampGt  string
+	TimestampLte string
+	TimestampLt  string
+	Sort         string
+	Order        string
+	Limit        string
+	All          bool
+}
+
+// Explain implements explainer, e.g. "Will fetch trades for AAPL on
+// 2025-01-06, sorted by timestamp descending, up to 1000 results per page,
+// following every page".
+func (e tickExplanation) Explain() string {
+	s := fmt.Sprintf("Will fetch %s for %s", e.Kind, e.Ticker)
+	s += timeRangeWords(e.Timestamp, e.TimestampGte, e.TimestampGt, e.TimestampLte, e.TimestampLt)
+	return s + sortAndLimitWords(e.Sort, e.Order, e.Limit, e.All)
+}
+
+// barSizeWords turns a multiplier and timespan into words such as "daily"
+// or "5-minute".
+func barSizeWords(multiplier, timespan string) string {
+	if multiplier == "" || multiplier == "1" {
+		switch timespan {
+		case "day":
+			return "daily"
+		case "hour":
+			return "hourly"
+		case "week":
+			return "weekly"
+		case "month":
+			return "monthly"
+		case "quarter":
+			return "quarterly"
+		case "year":
+			return "yearly"
+		}
+		multiplier = "1"
+	}
+	return multiplier + "-" + timespan
+}
+
+// timeRangeWords renders exact and range time filters as a phrase such as
+// " on 2025-01-06" or " from 2025-01-01 through 2025-01-31". It returns an
+// empty string when no filter is set.
+func timeRangeWords(eq, gte, gt, lte, lt string) string {
+	var parts []string
+	if eq != "" {
+		parts = append(parts, "on "+eq)
+	}
+	if gte != "" {
+		parts = append(parts, "from "+gte)
+	}
+	if gt != "" {
+		parts = append(parts, "after "+gt)
+	}
+	if lte != "" {
+		parts = append(parts, "through "+lte)
+	}
+	if lt != "" {
+		parts = append(parts, "before "+lt)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " " + strings.Join(parts, " ")
+}
+
+// sortAndLimitWords renders the sort order and result cap, e.g.
+// ", sorted by timestamp ascending, up to 5000 results". field is the sort
+// field when the endpoint takes one, and all adds the per-page wording
+// for commands that follow pagination.
+func sortAndLimitWords(field, order, limit string, all bool) string {
+	var s string
+
+	direction := order
+	switch order {
+	case "asc":
+		direction = "ascending"
+	case "desc":
+		direction = "descending"
+	}
+	switch {
+	case field != "" && direction != "":
+		s += fmt.Sprintf(", sorted by %s %s", field, direction)
+	case field != "":
+		s += ", sorted by " + field
+	case direction != "":
+		s += ", sorted " + direction
+	}
+
+	switch {
+	case limit != "" && all:
+		s += fmt.Sprintf(", up to %s results per page, following every page", limit)
+	case limit != "":
+		s += fmt.Sprintf(", up to %s results", limit)
+	case all:
+		s += ", following every page"
+	}
+
+	return s
+}
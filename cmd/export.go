@@ -0,0 +1,269 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// exportConcurrency is the most bars requests export runs in parallel
+// before the adaptive fetcher backs off.
+const exportConcurrency = 4
+
+// exportCmd is the parent command for bulk exports that write one file
+// per ticker.
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data for many tickers to local files",
+	Long:  "Bulk export commands that fetch data for a list of tickers and write one file per ticker, for bootstrapping a local historical dataset.",
+}
+
+// exportResult records the outcome of exporting one ticker. Err is set
+// when the fetch or the file write failed.
+type exportResult struct {
+	Ticker string
+	Path   string
+	Bars   int
+	Err    error
+}
+
+// exportBarsCmd fetches aggregate bars for each ticker concurrently and
+// writes them to <dir>/<ticker>.csv (or .json with --output json). Any
+// asset class works since tickers keep their X:, C:, I:, or O: prefix.
+// Usage: massive export bars --tickers-file syms.txt --from 2024-01-01 --to 2024-12-31 --dir out/
+var exportBarsCmd = &cobra.Command{
+	Use:   "bars [ticker...]",
+	Short: "Export OHLC bars for many tickers, one file per ticker",
+	Long:  "Fetch aggregate bars for each ticker concurrently and write them to <dir>/<ticker>.csv, or .json with --output json. Characters other than letters, digits, dot, dash, and underscore in a ticker become underscores in its file name (X:BTCUSD writes X_BTCUSD.csv). A failed ticker does not stop the others; a summary lists every ticker and the command exits non-zero if any failed.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, ext, err := exportFormat()
+		if err != nil {
+			return err
+		}
+
+		tickers, err := batchTickers(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		fromFlag, _ := cmd.Flags().GetString("from")
+		from, err := relativeDate(fromFlag, now)
+		if err != nil {
+			return fmt.Errorf("--from: %w", err)
+		}
+		toFlag, _ := cmd.Flags().GetString("to")
+		to, err := relativeDate(toFlag, now)
+		if err != nil {
+			return fmt.Errorf("--to: %w", err)
+		}
+		if to == "" {
+			to = now.Format("2006-01-02")
+		}
+
+		multiplier, _ := cmd.Flags().GetString("multiplier")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		adjusted, _ := cmd.Flags().GetString("adjusted")
+		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitAggs, limit)
+		dir, _ := cmd.Flags().GetString("dir")
+
+		params := api.BarsParams{
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   adjusted,
+			Sort:       "asc",
+			Limit:      limit,
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create export directory: %w", err)
+		}
+
+		results := make([]exportResult, len(tickers))
+		fetcher := api.NewAdaptiveFetcher(client, exportConcurrency)
+		// Every ticker's outcome is kept in results and reported by the
+		// summary. Only fetch errors are returned, so the fetcher retries
+		// them; a failed file write is not worth another request.
+		_ = fetcher.Run(len(tickers), func(i int) error {
+			ticker := tickers[i]
+			results[i] = exportResult{Ticker: ticker, Path: filepath.Join(dir, exportFileName(ticker)+ext)}
+
+			bars, err := client.GetBars(ticker, params)
+			if err != nil {
+				results[i].Err = err
+				return err
+			}
+
+			if counts := api.NewBarCounts(bars.QueryCount, bars.ResultsCount, limit); counts.Truncated() {
+				warnf("%s returned %d bars, the --limit of %d; its file is likely truncated", ticker, counts.ResultsCount, counts.Limit)
+			}
+
+			results[i].Bars = len(bars.Results)
+			results[i].Err = writeExportFile(results[i].Path, format, bars.Results)
+			return nil
+		})
+
+		return printExportSummary(results, dir)
+	},
+}
+
+// exportFormat maps --output to the export file format and extension.
+// The default table output writes CSV.
+func exportFormat() (format, ext string, err error) {
+	switch outputFormat {
+	case "table", "csv":
+		return "csv", ".csv", nil
+	case "json":
+		return "json", ".json", nil
+	}
+	return "", "", fmt.Errorf("--output %s is not supported by export; use csv or json", outputFormat)
+}
+
+// exportFileName turns a ticker into a safe file name, replacing anything
+// other than letters, digits, dot, dash, and underscore with an underscore.
+func exportFileName(ticker string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, ticker)
+}
+
+// writeExportFile writes bars to path in the given format, replacing any
+// existing file.
+func writeExportFile(path, format string, bars []api.Bar) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+
+	if format == "json" {
+		err = writeBarsJSON(f, bars)
+	} else {
+		err = writeBarsCSV(f, bars)
+	}
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+
+	return nil
+}
+
+// writeBarsCSV writes bars as CSV with a header row, using the
+// --csv-delimiter separator and --csv-decimal mark. timestamp is the raw
+// epoch milliseconds and date the same instant in UTC as RFC3339.
+func writeBarsCSV(w io.Writer, bars []api.Bar) error {
+	cw := newCSVWriter(w)
+	if err := cw.Write([]string{"timestamp", "date", "open", "high", "low", "close", "volume", "vwap", "trades"}); err != nil {
+		return err
+	}
+
+	for _, b := range bars {
+		record := []string{
+			strconv.FormatInt(b.Timestamp, 10),
+			time.UnixMilli(b.Timestamp).UTC().Format(time.RFC3339),
+			csvFloat(b.Open), csvFloat(b.High), csvFloat(b.Low), csvFloat(b.Close),
+			csvFloat(b.Volume), csvFloat(b.VWAP),
+			strconv.Itoa(b.NumTrades),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeBarsJSON writes bars as a JSON array using the API's field names,
+// indented unless --compact is set.
+func writeBarsJSON(w io.Writer, bars []api.Bar) error {
+	if bars == nil {
+		bars = []api.Bar{}
+	}
+
+	enc := json.NewEncoder(w)
+	if !compactJSON {
+		enc.SetIndent("", "  ")
+	}
+	return enc.Encode(bars)
+}
+
+// printExportSummary prints one row per ticker with its bar count and
+// file, or the error that stopped it. When any ticker failed it returns
+// an error wrapping the first failure, so the exit code reflects it.
+func printExportSummary(results []exportResult, dir string) error {
+	var failed []exportResult
+	for _, r := range results {
+		if r.Err != nil {
+			failed = append(failed, r)
+		}
+	}
+
+	printSummary("Exported: %d of %d tickers | Directory: %s", len(results)-len(failed), len(results), dir)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "TICKER\tSTATUS\tBARS\tFILE", "------\t------\t----\t----")
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(w, "%s\tFAILED\t-\t%v\n", r.Ticker, r.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\tOK\t%d\t%s\n", r.Ticker, r.Bars, r.Path)
+	}
+	w.Flush()
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d tickers failed to export; first: %s: %w", len(failed), len(results), failed[0].Ticker, failed[0].Err)
+	}
+	return nil
+}
+
+// init registers the export command and its subcommands on the root command.
+func init() {
+	exportBarsCmd.Flags().String("from", "", "Start of the range as YYYY-MM-DD or relative to today (30d, 12w, 6m, 1y)")
+	exportBarsCmd.Flags().String("to", "", "End of the range as YYYY-MM-DD or relative to today (default today)")
+	exportBarsCmd.Flags().String("multiplier", "1", "Size of the timespan multiplier")
+	exportBarsCmd.Flags().String("timespan", "day", "Timespan (minute, hour, day, week, month, quarter, year)")
+	exportBarsCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
+	exportBarsCmd.Flags().String("limit", "50000", "Max number of bars per ticker (max 50000)")
+	exportBarsCmd.Flags().String("dir", "", "Directory to write one file per ticker into (created if missing)")
+	exportBarsCmd.MarkFlagRequired("from")
+	exportBarsCmd.MarkFlagRequired("dir")
+	addTickersFileFlag(exportBarsCmd)
+	supportsCSV(exportBarsCmd)
+	exportCmd.AddCommand(exportBarsCmd)
+
+	rootCmd.AddCommand(exportCmd)
+}
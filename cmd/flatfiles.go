@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"text/tabwriter"
 
+	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/cloudmanic/massive-cli/internal/config"
 	"github.com/cloudmanic/massive-cli/internal/flatfiles"
 	"github.com/spf13/cobra"
@@ -74,6 +75,11 @@ var filesListCmd = &cobra.Command{
 		year, _ := cmd.Flags().GetString("year")
 		month, _ := cmd.Flags().GetString("month")
 
+		if dryRun {
+			fmt.Printf("OK LIST s3://%s/%s\n", s3Client.Bucket(), flatfiles.BuildPrefix(assetClass, dataType, year, month))
+			return api.ErrRequestPrinted
+		}
+
 		files, err := s3Client.ListFiles(assetClass, dataType, year, month)
 		if err != nil {
 			return fmt.Errorf("failed to list files: %w", err)
@@ -89,8 +95,7 @@ var filesListCmd = &cobra.Command{
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "FILE\tSIZE\tLAST MODIFIED")
-		fmt.Fprintln(w, "----\t----\t-------------")
+		writeHeader(w, "FILE\tSIZE\tLAST MODIFIED", "----\t----\t-------------")
 
 		for _, f := range files {
 			fmt.Fprintf(w, "%s\t%s\t%s\n",
@@ -142,6 +147,11 @@ var filesDownloadCmd = &cobra.Command{
 		filename := filepath.Base(key)
 		destPath := filepath.Join(outputDir, filename)
 
+		if dryRun {
+			fmt.Printf("OK GET s3://%s/%s -> %s\n", s3Client.Bucket(), key, destPath)
+			return api.ErrRequestPrinted
+		}
+
 		fmt.Printf("Downloading %s ...\n", key)
 
 		if err := s3Client.DownloadFile(key, destPath); err != nil {
@@ -179,8 +189,7 @@ var filesAssetsCmd = &cobra.Command{
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "NAME\tS3 PREFIX")
-		fmt.Fprintln(w, "----\t---------")
+		writeHeader(w, "NAME\tS3 PREFIX", "----\t---------")
 
 		for _, e := range entries {
 			fmt.Fprintf(w, "%s\t%s\n", e.Name, e.Prefix)
@@ -216,8 +225,7 @@ var filesTypesCmd = &cobra.Command{
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "NAME\tS3 PREFIX")
-		fmt.Fprintln(w, "----\t---------")
+		writeHeader(w, "NAME\tS3 PREFIX", "----\t---------")
 
 		for _, e := range entries {
 			fmt.Fprintf(w, "%s\t%s\n", e.Name, e.Prefix)
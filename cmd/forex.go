@@ -12,6 +12,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/cloudmanic/massive-cli/internal/analytics"
 	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/spf13/cobra"
 )
@@ -42,12 +43,22 @@ var forexBarsCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		multiplier, _ := cmd.Flags().GetString("multiplier")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
 		adjusted, _ := cmd.Flags().GetString("adjusted")
 		sort, _ := cmd.Flags().GetString("sort")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitAggs, limit)
 
 		params := api.ForexBarsParams{
 			Multiplier: multiplier,
@@ -59,28 +70,54 @@ var forexBarsCmd = &cobra.Command{
 			Limit:      limit,
 		}
 
+		if err := confirmExplain(barsExplanation{
+			Ticker:     ticker,
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   adjusted,
+			Sort:       sort,
+			Limit:      limit,
+		}); err != nil {
+			return err
+		}
+
 		result, err := client.GetForexBars(ticker, params)
 		if err != nil {
 			return err
 		}
+		checkBarCounts(result.QueryCount, result.ResultsCount, limit)
+
+		if outputFormat == chartJSONFormat {
+			return printChartJSON(chartBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromAggs(result.Results))
+		}
 
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Bars: %d | Adjusted: %v\n\n", result.Ticker, result.ResultsCount, result.Adjusted)
+		printSummary("Ticker: %s | Bars: %d | Adjusted: %v", result.Ticker, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----\t------\t----\t------")
-
-		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%.6f\t%.6f\t%.0f\t%.6f\t%d\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+		writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tCHG%\tRANGE\tVOLUME\tVWAP\tTRADES", "----\t----\t----\t---\t-----\t----\t-----\t------\t----\t------")
+
+		var stats barStats
+		stats.setAdjusted(result.Adjusted)
+		changes := barChangePercents(result.Results, func(b api.Bar) (int64, float64) { return b.Timestamp, b.Close })
+		for i, bar := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.6f", bar.Open), priceCell("%.6f", bar.High), priceCell("%.6f", bar.Low),
+				priceCell("%.6f", bar.Close), changes[i], priceCell("%.6f", bar.High-bar.Low),
+				bar.Volume, priceCell("%.6f", bar.VWAP), bar.NumTrades)
+			stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, bar.Volume)
 		}
+		stats.writeFooter(w, 10, "%.6f", 2, 3, 4, 7)
 		w.Flush()
 
 		return nil
@@ -104,9 +141,11 @@ var forexDailyMarketSummaryCmd = &cobra.Command{
 
 		date := args[0]
 		adjusted, _ := cmd.Flags().GetString("adjusted")
+		locale, _ := cmd.Flags().GetString("locale")
 
 		params := api.ForexMarketSummaryParams{
 			Adjusted: adjusted,
+			Locale:   locale,
 		}
 
 		result, err := client.GetForexDailyMarketSummary(date, params)
@@ -118,16 +157,15 @@ var forexDailyMarketSummaryCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Date: %s | Tickers: %d | Adjusted: %v\n\n", date, result.ResultsCount, result.Adjusted)
+		printSummary("Date: %s | Tickers: %d | Adjusted: %v", date, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "------\t----\t----\t---\t-----\t------\t----\t------")
+		writeHeader(w, "TICKER\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES", "------\t----\t----\t---\t-----\t------\t----\t------")
 
 		for _, s := range result.Results {
-			fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%.6f\t%.6f\t%.0f\t%.6f\t%d\n",
-				s.Ticker, s.Open, s.High, s.Low, s.Close,
-				s.Volume, s.VWAP, s.NumTrades)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				s.Ticker, priceCell("%.6f", s.Open), priceCell("%.6f", s.High), priceCell("%.6f", s.Low),
+				priceCell("%.6f", s.Close), s.Volume, priceCell("%.6f", s.VWAP), s.NumTrades)
 		}
 		w.Flush()
 
@@ -162,19 +200,21 @@ var forexPreviousDayBarCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Adjusted: %v\n\n", result.Ticker, result.Adjusted)
+		printSummary("Ticker: %s | Adjusted: %v", result.Ticker, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----\t------\t----\t------")
+		writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES", "----\t----\t----\t---\t-----\t------\t----\t------")
 
+		var stats barStats
+		stats.setAdjusted(result.Adjusted)
 		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%.6f\t%.6f\t%.0f\t%.6f\t%d\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.6f", bar.Open), priceCell("%.6f", bar.High), priceCell("%.6f", bar.Low),
+				priceCell("%.6f", bar.Close), bar.Volume, priceCell("%.6f", bar.VWAP), bar.NumTrades)
+			stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, bar.Volume)
 		}
+		stats.writeFooter(w, 8, "%.6f", 2, 3, 4, 5)
 		w.Flush()
 
 		return nil
@@ -221,7 +261,7 @@ var forexConvertCmd = &cobra.Command{
 		fmt.Printf("Symbol: %s\n", result.Symbol)
 		fmt.Printf("Initial Amount: %.2f\n", result.InitialAmount)
 		fmt.Printf("Converted: %.6f\n", result.Converted)
-		fmt.Printf("Ask: %.6f | Bid: %.6f\n", result.Last.Ask, result.Last.Bid)
+		fmt.Printf("Ask: %s | Bid: %s\n", priceCell("%.6f", result.Last.Ask), priceCell("%.6f", result.Last.Bid))
 		fmt.Printf("Exchange: %d\n", result.Last.Exchange)
 
 		return nil
@@ -245,14 +285,34 @@ var forexQuotesCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
+		timestamp, _ := cmd.Flags().GetString("timestamp")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitQuotes, limit)
 		sort, _ := cmd.Flags().GetString("sort")
 		order, _ := cmd.Flags().GetString("order")
+		exchange, _ := cmd.Flags().GetString("exchange")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.ForexQuotesParams{
-			Limit: limit,
-			Sort:  sort,
-			Order: order,
+			Timestamp:    timestamp,
+			TimestampGte: timestampGte,
+			TimestampGt:  timestampGt,
+			TimestampLte: timestampLte,
+			TimestampLt:  timestampLt,
+			Limit:        limit,
+			Sort:         sort,
+			Order:        order,
+			Cursor:       cursor,
 		}
 
 		result, err := client.GetForexQuotes(ticker, params)
@@ -260,21 +320,143 @@ var forexQuotesCmd = &cobra.Command{
 			return err
 		}
 
+		// The API has no server-side exchange filter for forex quotes, so
+		// keep only quotes where either side came from the chosen venue.
+		if exchange != "" {
+			id, err := resolveExchangeID(client, "fx", exchange)
+			if err != nil {
+				return err
+			}
+			filtered := result.Results[:0]
+			for _, q := range result.Results {
+				if q.AskExchange == id || q.BidExchange == id {
+					filtered = append(filtered, q)
+				}
+			}
+			result.Results = filtered
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Quotes: %d\n\n", ticker, len(result.Results))
+		printSummary("Ticker: %s | Quotes: %d", ticker, len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tASK PRICE\tBID PRICE\tASK EXCHANGE\tBID EXCHANGE")
-		fmt.Fprintln(w, "---------\t---------\t---------\t------------\t------------")
+		writeHeader(w, "TIMESTAMP\tASK PRICE\tBID PRICE\tASK EXCHANGE\tBID EXCHANGE", "---------\t---------\t---------\t------------\t------------")
 
 		for _, q := range result.Results {
-			t := time.UnixMilli(q.ParticipantTimestamp)
-			fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%d\t%d\n",
-				t.Format("2006-01-02 15:04:05"),
-				q.AskPrice, q.BidPrice, q.AskExchange, q.BidExchange)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\n",
+				timestampCell(q.ParticipantTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05"),
+				priceCell("%.6f", q.AskPrice), priceCell("%.6f", q.BidPrice), q.AskExchange, q.BidExchange)
+		}
+		w.Flush()
+		printNextCursor(result.NextURL)
+
+		return nil
+	},
+}
+
+// forexSpreadStatsCmd summarizes the bid-ask spread of a forex ticker over
+// a quote window: mean, median, and max spread, mean mid price, and the
+// time-weighted average spread, in price units and pips. Quotes are
+// requested oldest first and streamed into the aggregator page by page.
+// Usage: massive forex spread-stats C:EURUSD --from 2025-01-15 --to 2025-01-16
+var forexSpreadStatsCmd = &cobra.Command{
+	Use:   "spread-stats [ticker]",
+	Short: "Summarize bid-ask spread statistics over a quote window",
+	Long:  "Page through every quote for a forex ticker between --from and --to and report the mean, median, and max bid-ask spread, the mean mid price, and the time-weighted average spread, to assess execution costs. Quotes are aggregated as pages arrive.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
+
+		params := api.ForexQuotesParams{
+			TimestampGte: from,
+			TimestampLte: to,
+			Sort:         "timestamp",
+			Order:        "asc",
+			Limit:        "50000",
+		}
+
+		fetcher := api.NewAdaptiveFetcher(client, 1)
+		pages := 0
+
+		// Yield quotes page by page, following next_url. A fetch error stops
+		// the iteration and is reported once the summary returns.
+		var fetchErr error
+		quotes := func(yield func(api.ForexQuote) bool) {
+			var page *api.ForexQuotesResponse
+			fetchErr = fetcher.Do(func() error {
+				var err error
+				page, err = client.GetForexQuotes(ticker, params)
+				return err
+			})
+
+			for fetchErr == nil {
+				pages++
+				for _, quote := range page.Results {
+					if !yield(quote) {
+						return
+					}
+				}
+
+				if page.NextURL == "" {
+					return
+				}
+
+				nextURL := page.NextURL
+				fetchErr = fetcher.Do(func() error {
+					var err error
+					page, err = client.GetForexQuotesNext(nextURL)
+					return err
+				})
+			}
+		}
+
+		stats := analytics.SummarizeSpreads(quotes)
+		if fetchErr != nil {
+			return fetchErr
+		}
+
+		if outputFormat == "json" {
+			return printJSON(stats)
+		}
+
+		printSummary("Ticker: %s | Window: %s to %s | Pages: %d", ticker, from, to, pages)
+
+		// Pips only make sense for six-letter currency pairs; other tickers
+		// show "-" in that column.
+		pips := func(v float64) string {
+			size, err := analytics.PipSize(ticker)
+			if err != nil {
+				return "-"
+			}
+			return fmt.Sprintf("%.2f", v/size)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "METRIC\tVALUE\tPIPS", "------\t-----\t----")
+		fmt.Fprintf(w, "Quotes\t%d\t\n", stats.Quotes)
+		if stats.Skipped > 0 {
+			fmt.Fprintf(w, "Skipped (one-sided)\t%d\t\n", stats.Skipped)
+		}
+		fmt.Fprintf(w, "Mean Spread\t%s\t%s\n", priceCell("%.6f", stats.MeanSpread), pips(stats.MeanSpread))
+		fmt.Fprintf(w, "Median Spread\t%s\t%s\n", priceCell("%.6f", stats.MedianSpread), pips(stats.MedianSpread))
+		fmt.Fprintf(w, "Min Spread\t%s\t%s\n", priceCell("%.6f", stats.MinSpread), pips(stats.MinSpread))
+		fmt.Fprintf(w, "Max Spread\t%s\t%s\n", priceCell("%.6f", stats.MaxSpread), pips(stats.MaxSpread))
+		fmt.Fprintf(w, "Time-Weighted Spread\t%s\t%s\n", priceCell("%.6f", stats.TimeWeightedSpread),
+			pips(stats.TimeWeightedSpread))
+		fmt.Fprintf(w, "Mean Mid\t%s\t\n", priceCell("%.6f", stats.MeanMid))
+		if stats.Quotes > 0 {
+			fmt.Fprintf(w, "First Quote\t%s\t\n", timestampCell(stats.FirstTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
+			fmt.Fprintf(w, "Last Quote\t%s\t\n", timestampCell(stats.LastTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
 		}
 		w.Flush()
 
@@ -309,11 +491,198 @@ var forexLastQuoteCmd = &cobra.Command{
 		}
 
 		fmt.Printf("Symbol: %s\n", result.Symbol)
-		fmt.Printf("Ask: %.6f\n", result.Last.Ask)
-		fmt.Printf("Bid: %.6f\n", result.Last.Bid)
+		fmt.Printf("Ask: %s\n", priceCell("%.6f", result.Last.Ask))
+		fmt.Printf("Bid: %s\n", priceCell("%.6f", result.Last.Bid))
 		fmt.Printf("Exchange: %d\n", result.Last.Exchange)
-		ts := time.UnixMilli(result.Last.Timestamp)
-		fmt.Printf("Timestamp: %s\n", ts.Format("2006-01-02 15:04:05"))
+		fmt.Printf("Timestamp: %s\n", timestampCell(result.Last.Timestamp, api.UnitMilliseconds, "2006-01-02 15:04:05"))
+
+		return nil
+	},
+}
+
+// forexPipValueResult holds the computed pip value for a position along
+// with the quote it was derived from, used for both table and JSON output.
+type forexPipValueResult struct {
+	Pair            string  `json:"pair"`
+	Lot             float64 `json:"lot"`
+	Units           float64 `json:"units"`
+	Bid             float64 `json:"bid"`
+	Ask             float64 `json:"ask"`
+	Mid             float64 `json:"mid"`
+	PipSize         float64 `json:"pip_size"`
+	AccountCurrency string  `json:"account_currency"`
+	ConversionRate  float64 `json:"conversion_rate"`
+	PipValue        float64 `json:"pip_value"`
+}
+
+// forexPipValueCmd computes the monetary value of one pip for a position
+// in a currency pair using the pair's latest quote. When the account
+// currency is neither side of the pair, a second quote is fetched to
+// convert the value from the pair's base currency.
+// Usage: massive forex pip-value EURUSD --lot 1.0 --account-currency USD
+var forexPipValueCmd = &cobra.Command{
+	Use:   "pip-value [pair]",
+	Short: "Compute the value of one pip for a position",
+	Long:  "Compute the monetary value of one pip for a position of the given lot size (1.0 = 100,000 units) using the latest quote. JPY-quoted pairs use a 0.01 pip; all others use 0.0001.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		lot, _ := cmd.Flags().GetFloat64("lot")
+		account, _ := cmd.Flags().GetString("account-currency")
+		account = strings.ToUpper(account)
+
+		if lot <= 0 {
+			return fmt.Errorf("--lot must be greater than zero")
+		}
+
+		base, quote, err := analytics.SplitForexPair(args[0])
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		last, err := client.GetForexLastQuote(base, quote)
+		if err != nil {
+			return err
+		}
+
+		mid := (last.Last.Ask + last.Last.Bid) / 2
+		pair := base + quote
+
+		value, err := analytics.PipValue(pair, lot, mid)
+		if err != nil {
+			return err
+		}
+
+		// PipValue is in the base currency; convert it to the account currency.
+		rate := 1.0
+		switch account {
+		case base:
+		case quote:
+			rate = mid
+		default:
+			conv, err := client.GetForexLastQuote(base, account)
+			if err != nil {
+				return fmt.Errorf("failed to get %s/%s conversion quote: %w", base, account, err)
+			}
+			rate = (conv.Last.Ask + conv.Last.Bid) / 2
+		}
+
+		pipSize, _ := analytics.PipSize(pair)
+
+		result := forexPipValueResult{
+			Pair:            pair,
+			Lot:             lot,
+			Units:           lot * analytics.StandardLotUnits,
+			Bid:             last.Last.Bid,
+			Ask:             last.Last.Ask,
+			Mid:             mid,
+			PipSize:         pipSize,
+			AccountCurrency: account,
+			ConversionRate:  rate,
+			PipValue:        value * rate,
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		fmt.Printf("Pair: %s | Bid: %s | Ask: %s | Mid: %s\n", result.Pair, priceCell("%.6f", result.Bid),
+			priceCell("%.6f", result.Ask), priceCell("%.6f", result.Mid))
+		fmt.Printf("Lot: %.2f (%.0f units) | Pip Size: %g\n", result.Lot, result.Units, result.PipSize)
+		fmt.Printf("Pip Value: %.4f %s\n", result.PipValue, result.AccountCurrency)
+
+		return nil
+	},
+}
+
+// forexBasketResult is the output of the basket command: the weighted
+// index of the base currency against the basket and the rate and factor
+// behind each component.
+type forexBasketResult struct {
+	Base       string                      `json:"base"`
+	Index      float64                     `json:"index"`
+	Components []analytics.BasketComponent `json:"components"`
+}
+
+// forexBasketCmd values a base currency against a weighted basket of
+// currencies. Each component's rate is the mid of its latest base/currency
+// quote, fetched concurrently, and the index is the weighted geometric mean
+// of those rates (see analytics.BasketIndex).
+// Usage: massive forex basket --base USD --weights EUR:0.4,GBP:0.3,JPY:0.3
+var forexBasketCmd = &cobra.Command{
+	Use:   "basket",
+	Short: "Compute a weighted index of a currency against a basket",
+	Long:  "Compute a weighted index of the base currency against a basket of currencies from their latest quotes. Weights are CURRENCY:WEIGHT pairs that must sum to 1.0; the index is the weighted geometric mean of the base/currency mid rates, so a rising index means the base is strengthening against the basket.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		base, _ := cmd.Flags().GetString("base")
+		weightsFlag, _ := cmd.Flags().GetString("weights")
+
+		base = strings.ToUpper(strings.TrimSpace(base))
+		if len(base) != 3 {
+			return fmt.Errorf("invalid --base %q: expected a three-letter code like USD", base)
+		}
+
+		weights, err := analytics.ParseBasketWeights(weightsFlag)
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		// The base currency trades at 1.0 against itself, so it needs no quote.
+		mids := make([]float64, len(weights))
+		fetcher := api.NewAdaptiveFetcher(client, len(weights))
+		err = fetcher.Run(len(weights), func(i int) error {
+			currency := weights[i].Currency
+			if currency == base {
+				mids[i] = 1
+				return nil
+			}
+
+			last, err := client.GetForexLastQuote(base, currency)
+			if err != nil {
+				return fmt.Errorf("failed to get %s/%s quote: %w", base, currency, err)
+			}
+			mids[i] = (last.Last.Ask + last.Last.Bid) / 2
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		rates := make(map[string]float64, len(weights))
+		for i, w := range weights {
+			rates[w.Currency] = mids[i]
+		}
+
+		components, index, err := analytics.BasketIndex(weights, rates)
+		if err != nil {
+			return err
+		}
+
+		result := forexBasketResult{Base: base, Index: index, Components: components}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		fmt.Printf("Base: %s | Basket Index: %.6f\n\n", result.Base, result.Index)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "CURRENCY\tWEIGHT\tRATE\tFACTOR", "--------\t------\t----\t------")
+
+		for _, c := range result.Components {
+			fmt.Fprintf(w, "%s\t%.4f\t%s\t%s\n", c.Currency, c.Weight, priceCell("%.6f", c.Rate), priceCell("%.6f", c.Factor))
+		}
+		w.Flush()
 
 		return nil
 	},
@@ -343,27 +712,34 @@ var forexSnapshotCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, forexSnapshotAges(result.Ticker)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
 		t := result.Ticker
-		fmt.Printf("Ticker: %s | Change: %.6f (%.2f%%)\n\n", t.Ticker, t.TodaysChange, t.TodaysChangePct)
+		fmt.Printf("Ticker: %s | Change: %s (%.2f%%)\n\n", t.Ticker, priceCell("%.6f", t.TodaysChange), t.TodaysChangePct)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "PERIOD\tOPEN\tHIGH\tLOW\tCLOSE")
-		fmt.Fprintln(w, "------\t----\t----\t---\t-----")
+		writeHeader(w, "PERIOD\tOPEN\tHIGH\tLOW\tCLOSE", "------\t----\t----\t---\t-----")
 
-		fmt.Fprintf(w, "Day\t%.6f\t%.6f\t%.6f\t%.6f\n",
-			t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close)
+		fmt.Fprintf(w, "Day\t%s\n",
+			priceCells(!t.Day.IsZero(), "%.6f", t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close))
 
-		fmt.Fprintf(w, "Prev Day\t%.6f\t%.6f\t%.6f\t%.6f\n",
-			t.PrevDay.Open, t.PrevDay.High, t.PrevDay.Low, t.PrevDay.Close)
+		fmt.Fprintf(w, "Prev Day\t%s\n",
+			priceCells(!t.PrevDay.IsZero(), "%.6f", t.PrevDay.Open, t.PrevDay.High, t.PrevDay.Low, t.PrevDay.Close))
 
 		w.Flush()
 
-		fmt.Printf("\nLast Quote: Ask: %.6f | Bid: %.6f | Exchange: %d\n",
-			t.LastQuote.Ask, t.LastQuote.Bid, t.LastQuote.Exchange)
+		if t.LastQuote.IsZero() {
+			fmt.Println("\nLast Quote: -")
+		} else {
+			fmt.Printf("\nLast Quote: Ask: %s | Bid: %s | Exchange: %d\n",
+				priceCell("%.6f", t.LastQuote.Ask), priceCell("%.6f", t.LastQuote.Bid), t.LastQuote.Exchange)
+		}
 
 		return nil
 	},
@@ -394,20 +770,23 @@ var forexSnapshotMarketCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, forexSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Tickers: %d\n\n", result.Count)
+		printSummary("Tickers: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tCHANGE\tCHANGE %")
-		fmt.Fprintln(w, "------\t--------\t--------\t-------\t---------\t------\t--------")
+		writeHeader(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tCHANGE\tCHANGE %", "------\t--------\t--------\t-------\t---------\t------\t--------")
 
 		for _, t := range result.Tickers {
-			fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%.6f\t%.6f\t%.6f\t%.2f%%\n",
-				t.Ticker, t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-				t.TodaysChange, t.TodaysChangePct)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.2f%%\n",
+				t.Ticker, priceCells(!t.Day.IsZero(), "%.6f", t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close),
+				priceCell("%.6f", t.TodaysChange), t.TodaysChangePct)
 		}
 		w.Flush()
 
@@ -435,6 +814,10 @@ var forexGainersCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, forexSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -463,6 +846,10 @@ var forexLosersCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, forexSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -475,16 +862,15 @@ var forexLosersCmd = &cobra.Command{
 // or losers snapshot data to stdout. The title parameter labels the output
 // as either "Gainers" or "Losers" for display clarity.
 func printForexGainersLosersTable(title string, result *api.ForexSnapshotGainersLosersResponse) error {
-	fmt.Printf("Top %s: %d tickers\n\n", title, len(result.Tickers))
+	printSummary("Top %s: %d tickers", title, len(result.Tickers))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tCHANGE\tCHANGE %")
-	fmt.Fprintln(w, "------\t--------\t--------\t-------\t---------\t------\t--------")
+	writeHeader(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tCHANGE\tCHANGE %", "------\t--------\t--------\t-------\t---------\t------\t--------")
 
 	for _, t := range result.Tickers {
-		fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%.6f\t%.6f\t%.6f\t%.2f%%\n",
-			t.Ticker, t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-			t.TodaysChange, t.TodaysChangePct)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.2f%%\n",
+			t.Ticker, priceCells(!t.Day.IsZero(), "%.6f", t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close),
+			priceCell("%.6f", t.TodaysChange), t.TodaysChangePct)
 	}
 	w.Flush()
 
@@ -509,7 +895,10 @@ var forexSMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildForexIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetForexSMA(ticker, params)
 		if err != nil {
@@ -517,11 +906,11 @@ var forexSMACmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printForexIndicatorTable(ticker, "SMA", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -541,7 +930,10 @@ var forexEMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildForexIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetForexEMA(ticker, params)
 		if err != nil {
@@ -549,11 +941,11 @@ var forexEMACmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printForexIndicatorTable(ticker, "EMA", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -573,7 +965,10 @@ var forexRSICmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildForexIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetForexRSI(ticker, params)
 		if err != nil {
@@ -581,11 +976,11 @@ var forexRSICmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printForexIndicatorTable(ticker, "RSI", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -605,28 +1000,9 @@ var forexMACDCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		adjusted, _ := cmd.Flags().GetString("adjusted")
-		shortWindow, _ := cmd.Flags().GetString("short-window")
-		longWindow, _ := cmd.Flags().GetString("long-window")
-		signalWindow, _ := cmd.Flags().GetString("signal-window")
-		seriesType, _ := cmd.Flags().GetString("series-type")
-		order, _ := cmd.Flags().GetString("order")
-		limit, _ := cmd.Flags().GetString("limit")
-
-		params := api.MACDParams{
-			TimestampGTE: from,
-			TimestampLTE: to,
-			Timespan:     timespan,
-			Adjusted:     adjusted,
-			ShortWindow:  shortWindow,
-			LongWindow:   longWindow,
-			SignalWindow: signalWindow,
-			SeriesType:   seriesType,
-			Order:        order,
-			Limit:        limit,
+		params, err := buildMACDParams(cmd)
+		if err != nil {
+			return err
 		}
 
 		result, err := client.GetForexMACD(ticker, params)
@@ -635,51 +1011,24 @@ var forexMACDCmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printForexMACDTable(ticker, result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
-// buildForexIndicatorParams extracts the common indicator flags from the cobra
-// command and returns a populated IndicatorParams struct. This is shared
-// by the forex SMA, EMA, and RSI commands which all use the same parameters.
-func buildForexIndicatorParams(cmd *cobra.Command) api.IndicatorParams {
-	from, _ := cmd.Flags().GetString("from")
-	to, _ := cmd.Flags().GetString("to")
-	timespan, _ := cmd.Flags().GetString("timespan")
-	adjusted, _ := cmd.Flags().GetString("adjusted")
-	window, _ := cmd.Flags().GetString("window")
-	seriesType, _ := cmd.Flags().GetString("series-type")
-	order, _ := cmd.Flags().GetString("order")
-	limit, _ := cmd.Flags().GetString("limit")
-
-	return api.IndicatorParams{
-		TimestampGTE: from,
-		TimestampLTE: to,
-		Timespan:     timespan,
-		Adjusted:     adjusted,
-		Window:       window,
-		SeriesType:   seriesType,
-		Order:        order,
-		Limit:        limit,
-	}
-}
-
 // printForexIndicatorTable renders a formatted table of indicator values for
 // the forex SMA, EMA, or RSI commands. Each row displays the date and value.
 func printForexIndicatorTable(ticker, indicator string, result *api.IndicatorResponse) {
-	fmt.Printf("Ticker: %s | Indicator: %s | Values: %d\n\n", ticker, indicator, len(result.Results.Values))
+	printSummary("Ticker: %s | Indicator: %s | Values: %d", ticker, indicator, len(result.Results.Values))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "DATE\tVALUE")
-	fmt.Fprintln(w, "----\t-----")
+	writeHeader(w, "DATE\tVALUE", "----\t-----")
 
 	for _, v := range result.Results.Values {
-		t := time.UnixMilli(v.Timestamp)
-		fmt.Fprintf(w, "%s\t%.6f\n", t.Format("2006-01-02"), v.Value)
+		fmt.Fprintf(w, "%s\t%.6f\n", timestampCell(v.Timestamp, api.UnitMilliseconds, "2006-01-02"), v.Value)
 	}
 	w.Flush()
 }
@@ -687,16 +1036,14 @@ func printForexIndicatorTable(ticker, indicator string, result *api.IndicatorRes
 // printForexMACDTable renders a formatted table of MACD indicator values
 // including the MACD line, signal line, and histogram for each data point.
 func printForexMACDTable(ticker string, result *api.MACDResponse) {
-	fmt.Printf("Ticker: %s | Indicator: MACD | Values: %d\n\n", ticker, len(result.Results.Values))
+	printSummary("Ticker: %s | Indicator: MACD | Values: %d", ticker, len(result.Results.Values))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "DATE\tMACD\tSIGNAL\tHISTOGRAM")
-	fmt.Fprintln(w, "----\t----\t------\t---------")
+	writeHeader(w, "DATE\tMACD\tSIGNAL\tHISTOGRAM", "----\t----\t------\t---------")
 
 	for _, v := range result.Results.Values {
-		t := time.UnixMilli(v.Timestamp)
 		fmt.Fprintf(w, "%s\t%.6f\t%.6f\t%.6f\n",
-			t.Format("2006-01-02"), v.Value, v.Signal, v.Histogram)
+			timestampCell(v.Timestamp, api.UnitMilliseconds, "2006-01-02"), v.Value, v.Signal, v.Histogram)
 	}
 	w.Flush()
 }
@@ -712,10 +1059,12 @@ func addForexIndicatorFlags(cmd *cobra.Command, defaultWindow string) {
 	cmd.Flags().String("window", defaultWindow, "Number of periods for the indicator calculation")
 	cmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	cmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	cmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(cmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(cmd)
 
 	cmd.MarkFlagRequired("from")
 	cmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cmd, "from", "to")
 }
 
 // --- Tickers ---
@@ -739,6 +1088,7 @@ var forexTickersCmd = &cobra.Command{
 		sort, _ := cmd.Flags().GetString("sort")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTickers, limit)
 
 		params := api.ForexTickerParams{
 			Search: search,
@@ -757,11 +1107,10 @@ var forexTickersCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", result.Count)
+		printSummary("Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tNAME\tMARKET\tACTIVE")
-		fmt.Fprintln(w, "------\t----\t------\t------")
+		writeHeader(w, "TICKER\tNAME\tMARKET\tACTIVE", "------\t----\t------\t------")
 
 		for _, t := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%v\n",
@@ -821,12 +1170,14 @@ func init() {
 	forexBarsCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
 	forexBarsCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 	forexBarsCmd.Flags().String("sort", "asc", "Sort order (asc/desc)")
-	forexBarsCmd.Flags().String("limit", "5000", "Max number of results (max 50000)")
+	addLimitFlag(forexBarsCmd, api.LimitAggs, "Max number of results")
 	forexBarsCmd.MarkFlagRequired("from")
 	forexBarsCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(forexBarsCmd, "from", "to")
 
 	// Daily market summary flags
 	forexDailyMarketSummaryCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
+	forexDailyMarketSummaryCmd.Flags().String("locale", "", "Market locale (forex is grouped under global)")
 
 	// Previous day bar flags
 	forexPreviousDayBarCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
@@ -836,9 +1187,23 @@ func init() {
 	forexConvertCmd.Flags().String("precision", "2", "Decimal precision for the converted amount")
 
 	// Quotes flags
-	forexQuotesCmd.Flags().String("limit", "10", "Max number of results")
+	forexQuotesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp")
+	forexQuotesCmd.Flags().String("timestamp-gte", "", "Timestamp greater than or equal to")
+	forexQuotesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
+	forexQuotesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
+	forexQuotesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(forexQuotesCmd, "timestamp-gte", "timestamp-lte")
+	forexQuotesCmd.Flags().String("limit", "10", "Max number of results (max 50000)")
 	forexQuotesCmd.Flags().String("sort", "timestamp", "Sort field")
 	forexQuotesCmd.Flags().String("order", "desc", "Sort order (asc/desc)")
+	forexQuotesCmd.Flags().String("exchange", "", "Only show quotes where the ask or bid came from this exchange (numeric ID or name)")
+	forexQuotesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+
+	// Spread stats flags
+	forexSpreadStatsCmd.Flags().String("from", "", "Window start: date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp [required]")
+	forexSpreadStatsCmd.Flags().String("to", "", "Window end: date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp [required]")
+	forexSpreadStatsCmd.MarkFlagRequired("from")
+	forexSpreadStatsCmd.MarkFlagRequired("to")
 
 	// Snapshot market flags
 	forexSnapshotMarketCmd.Flags().String("tickers", "", "Comma-separated list of ticker symbols (default: all)")
@@ -862,27 +1227,51 @@ func init() {
 	forexMACDCmd.Flags().String("signal-window", "9", "Signal line EMA period")
 	forexMACDCmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	forexMACDCmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	forexMACDCmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(forexMACDCmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(forexMACDCmd)
 	forexMACDCmd.MarkFlagRequired("from")
 	forexMACDCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(forexMACDCmd, "from", "to")
+
+	// Pip value flags
+	forexPipValueCmd.Flags().Float64("lot", 1.0, "Position size in standard lots (1.0 = 100,000 units)")
+	forexPipValueCmd.Flags().String("account-currency", "USD", "Currency to express the pip value in")
+
+	// Basket flags
+	forexBasketCmd.Flags().String("base", "USD", "Currency the basket index is expressed against")
+	forexBasketCmd.Flags().String("weights", "", "Comma-separated CURRENCY:WEIGHT pairs summing to 1.0, e.g. EUR:0.4,GBP:0.3,JPY:0.3 [required]")
+	forexBasketCmd.MarkFlagRequired("weights")
 
 	// Tickers flags
 	forexTickersCmd.Flags().String("search", "", "Search by currency pair name or symbol")
 	forexTickersCmd.Flags().String("active", "", "Filter by active status (true/false)")
 	forexTickersCmd.Flags().String("sort", "ticker", "Sort field (ticker, name)")
 	forexTickersCmd.Flags().String("order", "asc", "Sort order (asc/desc)")
-	forexTickersCmd.Flags().String("limit", "20", "Number of results to return (max 1000)")
+	addLimitFlag(forexTickersCmd, api.LimitTickers, "Number of results to return")
 
 	// Register all subcommands under forex
+	useTickerIndex(forexBarsCmd, "fx")
+	supportsChartJSON(forexBarsCmd)
+	supportsParquet(forexBarsCmd)
+	supportsExplain(forexBarsCmd)
 	forexCmd.AddCommand(forexBarsCmd)
 	forexCmd.AddCommand(forexDailyMarketSummaryCmd)
 	forexCmd.AddCommand(forexPreviousDayBarCmd)
 	forexCmd.AddCommand(forexConvertCmd)
 	forexCmd.AddCommand(forexQuotesCmd)
+	useTickerIndex(forexSpreadStatsCmd, "fx")
+	forexCmd.AddCommand(forexSpreadStatsCmd)
 	forexCmd.AddCommand(forexLastQuoteCmd)
+	forexCmd.AddCommand(forexPipValueCmd)
+	forexCmd.AddCommand(forexBasketCmd)
+	useTickerIndex(forexSnapshotCmd, "fx")
+	addMaxAgeFlags(forexSnapshotCmd)
 	forexCmd.AddCommand(forexSnapshotCmd)
+	addMaxAgeFlags(forexSnapshotMarketCmd)
 	forexCmd.AddCommand(forexSnapshotMarketCmd)
+	addMaxAgeFlags(forexGainersCmd)
 	forexCmd.AddCommand(forexGainersCmd)
+	addMaxAgeFlags(forexLosersCmd)
 	forexCmd.AddCommand(forexLosersCmd)
 	forexCmd.AddCommand(forexSMACmd)
 	forexCmd.AddCommand(forexEMACmd)
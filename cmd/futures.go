@@ -7,11 +7,14 @@ package cmd
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
 
+	"github.com/cloudmanic/massive-cli/internal/analytics"
 	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/spf13/cobra"
 )
@@ -41,9 +44,15 @@ var futuresBarsCmd = &cobra.Command{
 		ticker := strings.ToUpper(args[0])
 		resolution, _ := cmd.Flags().GetString("resolution")
 		windowStart, _ := cmd.Flags().GetString("window-start")
-		windowStartGte, _ := cmd.Flags().GetString("window-start-gte")
+		windowStartGte, err := rangeBound(cmd, "window-start-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		windowStartGt, _ := cmd.Flags().GetString("window-start-gt")
-		windowStartLte, _ := cmd.Flags().GetString("window-start-lte")
+		windowStartLte, err := rangeBound(cmd, "window-start-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		windowStartLt, _ := cmd.Flags().GetString("window-start-lt")
 		limit, _ := cmd.Flags().GetString("limit")
 		sort, _ := cmd.Flags().GetString("sort")
@@ -59,28 +68,44 @@ var futuresBarsCmd = &cobra.Command{
 			Sort:           sort,
 		}
 
+		if err := confirmExplain(futuresBarsExplanation{Ticker: ticker, Params: params}); err != nil {
+			return err
+		}
+
 		result, err := client.GetFuturesAggs(ticker, params)
 		if err != nil {
 			return err
 		}
 
+		if outputFormat == chartJSONFormat {
+			return printChartJSON(chartBarsFromFutures(result.Results))
+		}
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromFutures(result.Results))
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Bars: %d\n\n", ticker, len(result.Results))
+		applyFuturesTick(client, ticker)
+		printSummary("Ticker: %s | Bars: %d", ticker, len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "WINDOW START\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tSETTLEMENT\tTRANSACTIONS")
-		fmt.Fprintln(w, "------------\t----\t----\t---\t-----\t------\t----------\t------------")
-
-		for _, bar := range result.Results {
-			t := time.Unix(0, bar.WindowStart)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				t.Format("2006-01-02 15:04:05"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.SettlementPrice, bar.Transactions)
+		writeHeader(w, "WINDOW START\tOPEN\tHIGH\tLOW\tCLOSE\tCHG%\tRANGE\tVOLUME\tSETTLEMENT\tTRANSACTIONS", "------------\t----\t----\t---\t-----\t----\t-----\t------\t----------\t------------")
+
+		var stats barStats
+		changes := barChangePercents(result.Results, func(b api.FuturesBar) (int64, float64) { return b.WindowStart, b.Close })
+		for i, bar := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(bar.WindowStart, api.UnitNanoseconds, "2006-01-02 15:04:05"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close), changes[i], priceCell("%.4f", bar.High-bar.Low),
+				bar.Volume, priceCell("%.4f", bar.SettlementPrice), bar.Transactions)
+			stats.add(bar.WindowStart, bar.High, bar.Low, bar.Close, bar.Volume)
 		}
+		stats.writeFooter(w, 10, "%.4f", 2, 3, 4, 7)
 		w.Flush()
 
 		return nil
@@ -126,11 +151,10 @@ var futuresContractsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Contracts: %d\n\n", len(result.Results))
+		printSummary("Contracts: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tNAME\tPRODUCT\tVENUE\tTYPE\tACTIVE\tDAYS TO MAT\tSETTLEMENT DATE")
-		fmt.Fprintln(w, "------\t----\t-------\t-----\t----\t------\t-----------\t---------------")
+		writeHeader(w, "TICKER\tNAME\tPRODUCT\tVENUE\tTYPE\tACTIVE\tDAYS TO MAT\tSETTLEMENT DATE", "------\t----\t-------\t-----\t----\t------\t-----------\t---------------")
 
 		for _, c := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\t%d\t%s\n",
@@ -182,11 +206,10 @@ var futuresProductsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Products: %d\n\n", len(result.Results))
+		printSummary("Products: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "CODE\tNAME\tSECTOR\tASSET CLASS\tVENUE\tTYPE\tSETTLEMENT")
-		fmt.Fprintln(w, "----\t----\t------\t-----------\t-----\t----\t----------")
+		writeHeader(w, "CODE\tNAME\tSECTOR\tASSET CLASS\tVENUE\tTYPE\tSETTLEMENT", "----\t----\t------\t-----------\t-----\t----\t----------")
 
 		for _, p := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
@@ -199,6 +222,82 @@ var futuresProductsCmd = &cobra.Command{
 	},
 }
 
+// futuresProductsTreeCmd fetches every futures product, following
+// pagination, and prints them as an indented tree grouped by asset class
+// and then sector, so the product universe can be browsed at a glance.
+// Usage: massive futures products-tree --asset-class commodity
+var futuresProductsTreeCmd = &cobra.Command{
+	Use:   "products-tree",
+	Short: "Show futures products as a tree by asset class and sector",
+	Long:  "Retrieve every futures product (following pagination) and display them grouped hierarchically: asset class, then sector, then each product with its name and trading venue.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		sector, _ := cmd.Flags().GetString("sector")
+		assetClass, _ := cmd.Flags().GetString("asset-class")
+		venue, _ := cmd.Flags().GetString("venue")
+
+		page, err := client.GetFuturesProducts(api.FuturesProductsParams{
+			Sector:       sector,
+			AssetClass:   assetClass,
+			TradingVenue: venue,
+			Limit:        "1000",
+		})
+		if err != nil {
+			return err
+		}
+
+		var products []api.FuturesProduct
+		for {
+			products = append(products, page.Results...)
+
+			if page.NextURL == "" {
+				break
+			}
+
+			page, err = client.GetFuturesProductsNext(page.NextURL)
+			if err != nil {
+				return err
+			}
+		}
+
+		groups := api.GroupFuturesProducts(products)
+
+		if outputFormat == "json" {
+			return printJSON(groups)
+		}
+
+		printSummary("Products: %d | Asset Classes: %d", len(products), len(groups))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, group := range groups {
+			fmt.Fprintf(w, "%s (%d)\n", treeLabel(group.AssetClass), group.Products)
+			for _, s := range group.Sectors {
+				fmt.Fprintf(w, "  %s (%d)\n", treeLabel(s.Sector), len(s.Products))
+				for _, p := range s.Products {
+					fmt.Fprintf(w, "    %s\t%s\t%s\n", p.ProductCode, p.Name, p.TradingVenue)
+				}
+			}
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// treeLabel returns name for a products-tree branch, or "(unclassified)"
+// when the API left the field empty.
+func treeLabel(name string) string {
+	if name == "" {
+		return "(unclassified)"
+	}
+	return name
+}
+
 // futuresSchedulesCmd retrieves a list of futures schedule events matching
 // the provided filters. Supports filtering by product code, session end
 // date, and trading venue.
@@ -234,11 +333,10 @@ var futuresSchedulesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Schedules: %d\n\n", len(result.Results))
+		printSummary("Schedules: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "EVENT\tPRODUCT CODE\tPRODUCT NAME\tSESSION END\tTIMESTAMP\tVENUE")
-		fmt.Fprintln(w, "-----\t------------\t------------\t-----------\t---------\t-----")
+		writeHeader(w, "EVENT\tPRODUCT CODE\tPRODUCT NAME\tSESSION END\tTIMESTAMP\tVENUE", "-----\t------------\t------------\t-----------\t---------\t-----")
 
 		for _, s := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
@@ -280,11 +378,10 @@ var futuresExchangesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Exchanges: %d\n\n", result.Count)
+		printSummary("Exchanges: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "ID\tNAME\tACRONYM\tMIC\tTYPE\tLOCALE\tURL")
-		fmt.Fprintln(w, "--\t----\t-------\t---\t----\t------\t---")
+		writeHeader(w, "ID\tNAME\tACRONYM\tMIC\tTYPE\tLOCALE\tURL", "--\t----\t-------\t---\t----\t------\t---")
 
 		for _, e := range result.Results {
 			acronym := e.Acronym
@@ -339,19 +436,27 @@ var futuresSnapshotCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Snapshots: %d\n\n", result.Count)
+		printSummary("Snapshots: %d", result.Count)
+
+		tickers := make([]string, len(result.Results))
+		for i, snap := range result.Results {
+			tickers[i] = snap.Ticker
+		}
+		ticks := futuresTickSizes(client, tickers)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tPRODUCT\tLAST PRICE\tBID\tASK\tSESS OPEN\tSESS HIGH\tSESS LOW\tSESS CLOSE\tCHANGE\tVOLUME")
-		fmt.Fprintln(w, "------\t-------\t----------\t---\t---\t---------\t---------\t--------\t----------\t------\t------")
+		writeHeader(w, "TICKER\tPRODUCT\tLAST PRICE\tBID\tASK\tSESS OPEN\tSESS HIGH\tSESS LOW\tSESS CLOSE\tCHANGE\tVOLUME", "------\t-------\t----------\t---\t---\t---------\t---------\t--------\t----------\t------\t------")
 
 		for _, snap := range result.Results {
-			fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\n",
+			priceTick = ticks[snap.Ticker]
+			session := !snap.Session.IsZero()
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
 				snap.Ticker, snap.ProductCode,
-				snap.LastTrade.Price,
-				snap.LastQuote.BidPrice, snap.LastQuote.AskPrice,
-				snap.Session.Open, snap.Session.High, snap.Session.Low,
-				snap.Session.Close, snap.Session.Change, snap.Session.Volume)
+				priceCells(!snap.LastTrade.IsZero(), "%.4f", snap.LastTrade.Price),
+				priceCells(!snap.LastQuote.IsZero(), "%.4f", snap.LastQuote.BidPrice, snap.LastQuote.AskPrice),
+				priceCells(session, "%.4f", snap.Session.Open, snap.Session.High, snap.Session.Low,
+					snap.Session.Close, snap.Session.Change),
+				formatCells(session, "%.0f", snap.Session.Volume))
 		}
 		w.Flush()
 
@@ -374,14 +479,53 @@ var futuresTradesCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
+		timestamp, _ := cmd.Flags().GetString("timestamp")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		sessionEndDate, _ := cmd.Flags().GetString("session-end-date")
 		limit, _ := cmd.Flags().GetString("limit")
 		sort, _ := cmd.Flags().GetString("sort")
+		sinceFile, _ := cmd.Flags().GetString("since-file")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.FuturesTradesParams{
+			Timestamp:      timestamp,
+			TimestampGte:   timestampGte,
+			TimestampGt:    timestampGt,
+			TimestampLte:   timestampLte,
+			TimestampLt:    timestampLt,
 			SessionEndDate: sessionEndDate,
 			Limit:          limit,
 			Sort:           sort,
+			Cursor:         cursor,
+		}
+
+		// In incremental mode fetch oldest first from the saved position:
+		// the next page's cursor when the last run stopped mid-pull,
+		// otherwise trades after the saved high-watermark.
+		var since sinceState
+		if sinceFile != "" {
+			if err := checkSinceFileFlags(cmd); err != nil {
+				return err
+			}
+			since, err = readSinceFile(sinceFile)
+			if err != nil {
+				return err
+			}
+			params.Sort = "timestamp.asc"
+			if since.Cursor != "" {
+				params.Cursor = since.Cursor
+			} else if since.Timestamp > 0 {
+				params.TimestampGt = strconv.FormatInt(since.Timestamp, 10)
+			}
 		}
 
 		result, err := client.GetFuturesTrades(ticker, params)
@@ -389,21 +533,318 @@ var futuresTradesCmd = &cobra.Command{
 			return err
 		}
 
+		latest := since.Timestamp
+		for _, trade := range result.Results {
+			latest = max(latest, trade.Timestamp)
+		}
+
 		if outputFormat == "json" {
-			return printJSON(result)
+			if err := printJSON(result); err != nil {
+				return err
+			}
+			return saveSince(sinceFile, since, latest, result.NextURL)
 		}
 
-		fmt.Printf("Ticker: %s | Trades: %d\n\n", ticker, len(result.Results))
+		applyFuturesTick(client, ticker)
+		printSummary("Ticker: %s | Trades: %d", ticker, len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tPRICE\tSIZE\tSESSION END\tSEQUENCE")
-		fmt.Fprintln(w, "---------\t-----\t----\t-----------\t--------")
+		writeHeader(w, "TIMESTAMP\tPRICE\tSIZE\tSESSION END\tSEQUENCE", "---------\t-----\t----\t-----------\t--------")
 
 		for _, trade := range result.Results {
-			t := time.Unix(0, trade.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.0f\t%s\t%d\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				trade.Price, trade.Size, trade.SessionEndDate, trade.SequenceNumber)
+			fmt.Fprintf(w, "%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(trade.Timestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", trade.Price), trade.Size, trade.SessionEndDate, trade.SequenceNumber)
+		}
+		w.Flush()
+		printNextCursor(result.NextURL)
+
+		return saveSince(sinceFile, since, latest, result.NextURL)
+	},
+}
+
+// futuresOITrendRow is one daily session in the oi-trend output.
+// OpenInterest is the bar's end-of-session open interest when the API
+// reports it. OIChange is the change from the previous session and needs
+// open interest on both. VolumeToOI divides volume by the bar's open
+// interest, or by the latest snapshot open interest when the bar has none.
+type futuresOITrendRow struct {
+	SessionEndDate  string   `json:"session_end_date"`
+	WindowStart     int64    `json:"window_start"`
+	Close           float64  `json:"close"`
+	SettlementPrice float64  `json:"settlement_price"`
+	Volume          float64  `json:"volume"`
+	OpenInterest    *int64   `json:"open_interest"`
+	OIChange        *int64   `json:"oi_change"`
+	VolumeToOI      *float64 `json:"volume_to_oi"`
+}
+
+// futuresOITrend is the JSON output of the oi-trend command. Correlation
+// is the Pearson correlation of daily volume with the daily change in open
+// interest and is nil when fewer than three sessions carry both.
+type futuresOITrend struct {
+	Ticker             string              `json:"ticker"`
+	LatestOpenInterest *int64              `json:"latest_open_interest"`
+	Correlation        *float64            `json:"volume_oi_change_correlation"`
+	Results            []futuresOITrendRow `json:"results"`
+}
+
+// futuresOITrendCmd shows how open interest developed alongside volume for
+// a futures contract. The snapshot only carries the current open interest,
+// so daily bars supply the history: per-bar open interest is used when the
+// aggregates endpoint returns it, and volume is related to the latest
+// snapshot open interest otherwise. Open interest is always an
+// end-of-session figure.
+// Usage: massive futures oi-trend ESM5 --from 2025-03-01 --to 2025-03-31
+var futuresOITrendCmd = &cobra.Command{
+	Use:   "oi-trend [ticker]",
+	Short: "Show daily open interest and volume for a futures contract",
+	Long:  "Fetch daily bars for a futures contract and show volume alongside end-of-session open interest, the session-to-session change in open interest, and volume as a fraction of open interest. The current open interest comes from the contract snapshot; when bars do not report open interest, volume is compared with that latest value.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
+		limit, _ := cmd.Flags().GetString("limit")
+
+		bars, err := client.GetFuturesAggs(ticker, api.FuturesAggParams{
+			Resolution:     "1day",
+			WindowStartGte: from,
+			WindowStartLte: to,
+			Limit:          limit,
+			Sort:           "asc",
+		})
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := client.GetFuturesSnapshot(api.FuturesSnapshotParams{Ticker: ticker})
+		if err != nil {
+			return err
+		}
+
+		trend := buildFuturesOITrend(ticker, bars.Results, snapshot.Results)
+
+		if outputFormat == "json" {
+			return printJSON(trend)
+		}
+
+		printSummary("Ticker: %s | Sessions: %d", ticker, len(trend.Results))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "SESSION END\tCLOSE\tSETTLEMENT\tVOLUME\tOPEN INTEREST\tOI CHG\tVOL/OI", "-----------\t-----\t----------\t------\t-------------\t------\t------")
+
+		perBarOI := false
+		for _, row := range trend.Results {
+			oi, change, ratio := "-", "-", "-"
+			if row.OpenInterest != nil {
+				oi = strconv.FormatInt(*row.OpenInterest, 10)
+				perBarOI = true
+			}
+			if row.OIChange != nil {
+				change = fmt.Sprintf("%+d", *row.OIChange)
+			}
+			if row.VolumeToOI != nil {
+				ratio = fmt.Sprintf("%.3f", *row.VolumeToOI)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\t%s\t%s\t%s\n",
+				row.SessionEndDate, priceCell("%.4f", row.Close), priceCell("%.4f", row.SettlementPrice),
+				row.Volume, oi, change, ratio)
+		}
+		w.Flush()
+
+		fmt.Println()
+		if trend.LatestOpenInterest != nil {
+			fmt.Printf("Latest open interest (snapshot): %d\n", *trend.LatestOpenInterest)
+		}
+		if trend.Correlation != nil {
+			fmt.Printf("Volume vs OI change correlation: %.3f\n", *trend.Correlation)
+		}
+		if !perBarOI {
+			fmt.Println("Bars did not report open interest; VOL/OI uses the latest snapshot value.")
+		}
+		fmt.Println("Open interest is reported at end of session, not intraday.")
+
+		return nil
+	},
+}
+
+// buildFuturesOITrend pairs each daily bar with its open interest, the
+// change from the prior session, and its volume-to-open-interest ratio.
+// The latest open interest is taken from the snapshot entry for ticker,
+// if present, and stands in for bars that carry no open interest.
+func buildFuturesOITrend(ticker string, bars []api.FuturesBar, snapshots []api.FuturesSnapshotContract) futuresOITrend {
+	trend := futuresOITrend{Ticker: ticker, Results: make([]futuresOITrendRow, 0, len(bars))}
+
+	for _, snap := range snapshots {
+		if snap.Ticker == ticker {
+			oi := snap.Details.OpenInterest
+			trend.LatestOpenInterest = &oi
+			break
+		}
+	}
+
+	var volumes, changes []float64
+	var prevOI *int64
+	for _, bar := range bars {
+		row := futuresOITrendRow{
+			SessionEndDate:  bar.SessionEndDate,
+			WindowStart:     bar.WindowStart,
+			Close:           bar.Close,
+			SettlementPrice: bar.SettlementPrice,
+			Volume:          bar.Volume,
+			OpenInterest:    bar.OpenInterest,
+		}
+
+		if bar.OpenInterest != nil && prevOI != nil {
+			change := *bar.OpenInterest - *prevOI
+			row.OIChange = &change
+			volumes = append(volumes, bar.Volume)
+			changes = append(changes, float64(change))
+		}
+		prevOI = bar.OpenInterest
+
+		denom := bar.OpenInterest
+		if denom == nil {
+			denom = trend.LatestOpenInterest
+		}
+		if denom != nil && *denom > 0 {
+			ratio := bar.Volume / float64(*denom)
+			row.VolumeToOI = &ratio
+		}
+
+		trend.Results = append(trend.Results, row)
+	}
+
+	if len(volumes) >= 3 {
+		if c := analytics.Correlation(volumes, changes); !math.IsNaN(c) {
+			trend.Correlation = &c
+		}
+	}
+
+	return trend
+}
+
+// futuresProductTrades is the JSON output of the product-trades command:
+// the contracts that were queried and their trades merged into one tape.
+type futuresProductTrades struct {
+	ProductCode string             `json:"product_code"`
+	Contracts   []string           `json:"contracts"`
+	Results     []api.FuturesTrade `json:"results"`
+}
+
+// futuresProductTradesCmd builds a consolidated trade tape for a futures
+// product. It lists the product's active contracts, fetches trades for
+// each contract concurrently, and merges them into a single timestamp
+// sorted tape annotated with the contract ticker.
+// Usage: massive futures product-trades ES --session-end-date 2025-03-15
+var futuresProductTradesCmd = &cobra.Command{
+	Use:   "product-trades [product-code]",
+	Short: "Get a merged trade tape across all active contracts of a futures product",
+	Long:  "List the active contracts for a futures product, fetch tick-level trades for each contract concurrently, and merge them into one timestamp-sorted tape showing which contract every trade belongs to.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		product := strings.ToUpper(args[0])
+		timestamp, _ := cmd.Flags().GetString("timestamp")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
+		sessionEndDate, _ := cmd.Flags().GetString("session-end-date")
+		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTrades, limit)
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		// List the contracts trading on the session date, following
+		// pagination so products with long curves are fully covered.
+		contracts, err := client.GetFuturesContracts(api.FuturesContractsParams{
+			ProductCode: product,
+			Active:      "true",
+			Date:        sessionEndDate,
+			Limit:       "1000",
+		})
+		if err != nil {
+			return err
+		}
+
+		var tickers []string
+		ticks := make(map[string]float64)
+		for {
+			for _, c := range contracts.Results {
+				tickers = append(tickers, c.Ticker)
+				ticks[c.Ticker] = c.TradeTickSize
+			}
+
+			if contracts.NextURL == "" {
+				break
+			}
+
+			contracts, err = client.GetFuturesContractsNext(contracts.NextURL)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(tickers) == 0 {
+			return fmt.Errorf("no active contracts found for product %s", product)
+		}
+
+		params := api.FuturesTradesParams{
+			Timestamp:      timestamp,
+			TimestampGte:   timestampGte,
+			TimestampGt:    timestampGt,
+			TimestampLte:   timestampLte,
+			TimestampLt:    timestampLt,
+			SessionEndDate: sessionEndDate,
+			Limit:          limit,
+		}
+
+		trades := make([][]api.FuturesTrade, len(tickers))
+		fetcher := api.NewAdaptiveFetcher(client, concurrency)
+		err = fetcher.Run(len(tickers), func(i int) error {
+			resp, err := client.GetFuturesTrades(tickers[i], params)
+			if err != nil {
+				return fmt.Errorf("%s: %w", tickers[i], err)
+			}
+			trades[i] = resp.Results
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		tape := api.MergeFuturesTrades(tickers, trades)
+
+		if outputFormat == "json" {
+			return printJSON(futuresProductTrades{ProductCode: product, Contracts: tickers, Results: tape})
+		}
+
+		printSummary("Product: %s | Contracts: %d | Trades: %d", product, len(tickers), len(tape))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "TIMESTAMP\tCONTRACT\tPRICE\tSIZE\tSESSION END\tSEQUENCE", "---------\t--------\t-----\t----\t-----------\t--------")
+
+		for _, trade := range tape {
+			priceTick = ticks[trade.Ticker]
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(trade.Timestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"), trade.Ticker,
+				priceCell("%.4f", trade.Price), trade.Size, trade.SessionEndDate, trade.SequenceNumber)
 		}
 		w.Flush()
 
@@ -427,14 +868,32 @@ var futuresQuotesCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
+		timestamp, _ := cmd.Flags().GetString("timestamp")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
+		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		sessionEndDate, _ := cmd.Flags().GetString("session-end-date")
 		limit, _ := cmd.Flags().GetString("limit")
 		sort, _ := cmd.Flags().GetString("sort")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.FuturesQuotesParams{
+			Timestamp:      timestamp,
+			TimestampGte:   timestampGte,
+			TimestampGt:    timestampGt,
+			TimestampLte:   timestampLte,
+			TimestampLt:    timestampLt,
 			SessionEndDate: sessionEndDate,
 			Limit:          limit,
 			Sort:           sort,
+			Cursor:         cursor,
 		}
 
 		result, err := client.GetFuturesQuotes(ticker, params)
@@ -446,21 +905,21 @@ var futuresQuotesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Quotes: %d\n\n", ticker, len(result.Results))
+		applyFuturesTick(client, ticker)
+		printSummary("Ticker: %s | Quotes: %d", ticker, len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tBID PRICE\tBID SIZE\tASK PRICE\tASK SIZE\tSESSION END")
-		fmt.Fprintln(w, "---------\t---------\t--------\t---------\t--------\t-----------")
+		writeHeader(w, "TIMESTAMP\tBID PRICE\tBID SIZE\tASK PRICE\tASK SIZE\tSESSION END", "---------\t---------\t--------\t---------\t--------\t-----------")
 
 		for _, quote := range result.Results {
-			t := time.Unix(0, quote.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.0f\t%.4f\t%.0f\t%s\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				quote.BidPrice, quote.BidSize,
-				quote.AskPrice, quote.AskSize,
+			fmt.Fprintf(w, "%s\t%s\t%.0f\t%s\t%.0f\t%s\n",
+				timestampCell(quote.Timestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", quote.BidPrice), quote.BidSize,
+				priceCell("%.4f", quote.AskPrice), quote.AskSize,
 				quote.SessionEndDate)
 		}
 		w.Flush()
+		printNextCursor(result.NextURL)
 
 		return nil
 	},
@@ -469,6 +928,8 @@ var futuresQuotesCmd = &cobra.Command{
 // init registers the futures parent command and all subcommands with their
 // respective flags under the root command.
 func init() {
+	futuresCmd.PersistentFlags().BoolVar(&tickRound, "tick-round", false, "Round table prices to each contract's trade tick size (same as --precision tick)")
+
 	// Bars command flags
 	futuresBarsCmd.Flags().String("resolution", "1day", "Bar resolution (1min, 15mins, 1hr, 1day)")
 	futuresBarsCmd.Flags().String("window-start", "", "Filter by window start date or timestamp")
@@ -476,6 +937,7 @@ func init() {
 	futuresBarsCmd.Flags().String("window-start-gt", "", "Window start greater than")
 	futuresBarsCmd.Flags().String("window-start-lte", "", "Window start less than or equal to")
 	futuresBarsCmd.Flags().String("window-start-lt", "", "Window start less than")
+	addTimestampRangeFlags(futuresBarsCmd, "window-start-gte", "window-start-lte")
 	futuresBarsCmd.Flags().String("limit", "5000", "Max number of results")
 	futuresBarsCmd.Flags().String("sort", "asc", "Sort order (asc/desc)")
 
@@ -501,6 +963,11 @@ func init() {
 	futuresSchedulesCmd.Flags().String("limit", "20", "Max number of results")
 	futuresSchedulesCmd.Flags().String("sort", "", "Sort field")
 
+	// Products tree command flags
+	futuresProductsTreeCmd.Flags().String("sector", "", "Filter by sector")
+	futuresProductsTreeCmd.Flags().String("asset-class", "", "Filter by asset class")
+	futuresProductsTreeCmd.Flags().String("venue", "", "Filter by trading venue (e.g., XCME)")
+
 	// Exchanges command flags
 	futuresExchangesCmd.Flags().String("limit", "20", "Max number of results")
 
@@ -511,23 +978,61 @@ func init() {
 	futuresSnapshotCmd.Flags().String("sort", "", "Sort field")
 
 	// Trades command flags
+	futuresTradesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp")
+	futuresTradesCmd.Flags().String("timestamp-gte", "", "Timestamp greater than or equal to")
+	futuresTradesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
+	futuresTradesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
+	futuresTradesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(futuresTradesCmd, "timestamp-gte", "timestamp-lte")
 	futuresTradesCmd.Flags().String("session-end-date", "", "Filter by session end date (YYYY-MM-DD)")
 	futuresTradesCmd.Flags().String("limit", "1000", "Max number of results")
 	futuresTradesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	futuresTradesCmd.Flags().String("since-file", "", "Fetch trades oldest first from the position saved in this file, then update it")
+	futuresTradesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+
+	// Product trades command flags
+	futuresProductTradesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp")
+	futuresProductTradesCmd.Flags().String("timestamp-gte", "", "Timestamp greater than or equal to")
+	futuresProductTradesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
+	futuresProductTradesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
+	futuresProductTradesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(futuresProductTradesCmd, "timestamp-gte", "timestamp-lte")
+	futuresProductTradesCmd.Flags().String("session-end-date", "", "Session end date (YYYY-MM-DD); also selects the contracts active on that date")
+	addLimitFlag(futuresProductTradesCmd, api.LimitTrades, "Max number of trades per contract")
+	futuresProductTradesCmd.Flags().Int("concurrency", 4, "Maximum parallel requests across contracts")
 
 	// Quotes command flags
+	futuresQuotesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD), RFC3339 time, or nanosecond timestamp")
+	futuresQuotesCmd.Flags().String("timestamp-gte", "", "Timestamp greater than or equal to")
+	futuresQuotesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
+	futuresQuotesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
+	futuresQuotesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(futuresQuotesCmd, "timestamp-gte", "timestamp-lte")
 	futuresQuotesCmd.Flags().String("session-end-date", "", "Filter by session end date (YYYY-MM-DD)")
 	futuresQuotesCmd.Flags().String("limit", "1000", "Max number of results")
 	futuresQuotesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	futuresQuotesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+
+	// OI trend command flags
+	futuresOITrendCmd.Flags().String("from", "", "Start date (YYYY-MM-DD)")
+	futuresOITrendCmd.Flags().String("to", "", "End date (YYYY-MM-DD)")
+	futuresOITrendCmd.Flags().String("limit", "5000", "Max number of daily bars")
+	futuresOITrendCmd.MarkFlagRequired("from")
 
 	// Register all subcommands under the futures parent
+	supportsChartJSON(futuresBarsCmd)
+	supportsParquet(futuresBarsCmd)
+	supportsExplain(futuresBarsCmd)
 	futuresCmd.AddCommand(futuresBarsCmd)
 	futuresCmd.AddCommand(futuresContractsCmd)
 	futuresCmd.AddCommand(futuresProductsCmd)
+	futuresCmd.AddCommand(futuresProductsTreeCmd)
 	futuresCmd.AddCommand(futuresSchedulesCmd)
 	futuresCmd.AddCommand(futuresExchangesCmd)
 	futuresCmd.AddCommand(futuresSnapshotCmd)
+	futuresCmd.AddCommand(futuresOITrendCmd)
 	futuresCmd.AddCommand(futuresTradesCmd)
+	futuresCmd.AddCommand(futuresProductTradesCmd)
 	futuresCmd.AddCommand(futuresQuotesCmd)
 
 	// Register the futures parent under root
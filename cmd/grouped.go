@@ -0,0 +1,102 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cloudmanic/massive-cli/internal/analytics"
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// addSummaryScreenFlags registers --sort-by and --top on a grouped daily
+// summary command so the firehose of tickers can be narrowed to the most
+// active names client-side.
+func addSummaryScreenFlags(cmd *cobra.Command) {
+	cmd.Flags().String("sort-by", "", "Sort rows by "+strings.Join(analytics.SummarySortKeys, ", ")+" (largest first except ticker; default API order)")
+	cmd.Flags().Int("top", 0, "Keep only the first N rows after sorting (0 for all)")
+}
+
+// summaryScreen is the resolved --sort-by, --top, and (for crypto)
+// --group-by settings of a grouped daily summary command.
+type summaryScreen struct {
+	SortBy  string
+	Top     int
+	GroupBy string
+}
+
+// summaryScreenFlags reads and validates the screening flags before any
+// request is sent. groupBy is read only when the command registers it.
+func summaryScreenFlags(cmd *cobra.Command) (summaryScreen, error) {
+	var s summaryScreen
+	s.SortBy, _ = cmd.Flags().GetString("sort-by")
+	s.Top, _ = cmd.Flags().GetInt("top")
+	if cmd.Flags().Lookup("group-by") != nil {
+		s.GroupBy, _ = cmd.Flags().GetString("group-by")
+	}
+
+	if s.SortBy != "" && !slices.Contains(analytics.SummarySortKeys, s.SortBy) {
+		return s, fmt.Errorf("invalid --sort-by %q: must be one of %s", s.SortBy, strings.Join(analytics.SummarySortKeys, ", "))
+	}
+	if s.Top < 0 {
+		return s, fmt.Errorf("--top must not be negative")
+	}
+	if s.GroupBy != "" && s.GroupBy != "market" {
+		return s, fmt.Errorf("invalid --group-by %q: must be market", s.GroupBy)
+	}
+
+	return s, nil
+}
+
+// apply sorts and trims the summary rows in place. ResultsCount is left as
+// the API reported it so the summary line shows how many were screened.
+func (s summaryScreen) apply(result *api.MarketSummaryResponse) {
+	if s.SortBy != "" {
+		_ = analytics.SortSummaries(result.Results, s.SortBy)
+	}
+	if s.Top > 0 && len(result.Results) > s.Top {
+		result.Results = result.Results[:s.Top]
+	}
+}
+
+// printSummaryGroups prints --group-by totals: tickers, volume, dollar
+// volume, and trades per group, ordered by --sort-by (volume by default)
+// and trimmed to --top.
+func printSummaryGroups(s summaryScreen, date string, rows []api.MarketSummary, keyOf func(string) string) error {
+	sortBy := s.SortBy
+	if sortBy == "" {
+		sortBy = "volume"
+	}
+
+	groups, err := analytics.GroupSummaries(rows, keyOf, sortBy)
+	if err != nil {
+		return err
+	}
+	if s.Top > 0 && len(groups) > s.Top {
+		groups = groups[:s.Top]
+	}
+
+	if outputFormat == "json" {
+		return printJSON(groups)
+	}
+
+	printSummary("Date: %s | Tickers: %d | Groups: %d", date, len(rows), len(groups))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "MARKET\tTICKERS\tVOLUME\tDOLLAR VOLUME\tTRADES", "------\t-------\t------\t-------------\t------")
+
+	for _, g := range groups {
+		fmt.Fprintf(w, "%s\t%d\t%.0f\t%.0f\t%d\n", g.Key, g.Tickers, g.Volume, g.DollarVolume, g.Trades)
+	}
+	w.Flush()
+
+	return nil
+}
@@ -7,20 +7,183 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
 )
 
-// newClient creates a new Massive API client by loading the API key from
-// the environment or config file. Returns an error if no API key is found.
+// newClient creates a new Massive API client using the API key from the
+// environment or config file, configured by the config file and global
+// flags and recorded in clients for the end-of-run checks. Returns an
+// error if no API key is found or the configuration is invalid.
 func newClient() (*api.Client, error) {
 	apiKey, err := config.GetAPIKey()
 	if err != nil {
 		return nil, err
 	}
-	return api.NewClient(apiKey), nil
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	mode, err := api.ParseAuthMode(authMode)
+	if err != nil {
+		return nil, err
+	}
+	client := api.NewClient(apiKey)
+	if cfg.BaseURL != "" {
+		client.SetBaseURL(strings.TrimRight(cfg.BaseURL, "/"))
+	}
+	client.SetAuthMode(mode)
+	client.SetAuthHeader(authHeader)
+	client.SetUserAgent(userAgent)
+	client.SetCompression(!noCompression)
+	client.SetRedirectPolicy(followRedirects, maxRedirects)
+	client.SetRedirectHosts(redirectHosts)
+	client.SetArchiveDir(archiveDir)
+	if printRequest {
+		client.SetPrintRequest(os.Stdout)
+	}
+	if dryRun {
+		client.SetDryRun(os.Stdout)
+	}
+	if dir, err := config.CacheDir(); err == nil {
+		client.SetCacheDir(dir)
+	}
+	clients = append(clients, client)
+	return client, nil
+}
+
+// completeCachedTickers returns a shell completion function that suggests
+// tickers for market from the local ticker index written by
+// "massive reference sync-tickers". It never touches the network, so
+// completion stays instant; with no index it simply offers no suggestions.
+func completeCachedTickers(market string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		dir, err := config.CacheDir()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		ix, err := api.LoadTickerIndex(dir, market)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var suggestions []string
+		for _, e := range ix.Match(toComplete) {
+			suggestions = append(suggestions, e.Ticker+"\t"+e.Name)
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// useTickerIndex hooks a ticker command up to the local ticker index for
+// market: tab completion from the index, and a warning for any ticker
+// argument the index does not list.
+func useTickerIndex(cmd *cobra.Command, market string) {
+	cmd.ValidArgsFunction = completeCachedTickers(market)
+	cmd.Args = checkCachedTickers(market, cmd.Args)
+}
+
+// checkCachedTickers wraps a command's argument validator so that each
+// ticker argument is looked up in market's local ticker index. Unknown
+// tickers only produce a warning, since the index lists active tickers
+// and delisted ones still have history. Without an index, or with one
+// older than api.TickerCacheTTL, no check is made.
+func checkCachedTickers(market string, validate cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if validate != nil {
+			if err := validate(cmd, args); err != nil {
+				return err
+			}
+		}
+
+		dir, err := config.CacheDir()
+		if err != nil {
+			return nil
+		}
+		ix, err := api.LoadTickerIndex(dir, market)
+		if err != nil || !ix.Fresh(api.TickerCacheTTL) {
+			return nil
+		}
+
+		for _, ticker := range tickerArgs(args) {
+			if !ix.Contains(ticker) {
+				warnf("%s is not an active %s ticker in the local index (run \"massive reference sync-tickers\" if it is new)", ticker, market)
+			}
+		}
+		return nil
+	}
+}
+
+// printSummary prints the informational line shown above table output,
+// such as "Ticker: AAPL | Bars: 20", followed by a blank line. Nothing is
+// printed with --quiet or --no-header, leaving only the table rows on
+// stdout so output can be appended to an existing file.
+func printSummary(format string, a ...any) {
+	if quiet || noHeader {
+		return
+	}
+	fmt.Printf(format+"\n\n", a...)
+}
+
+// warnf prints a "Warning: " line to stderr unless --quiet is set.
+func warnf(format string, a ...any) {
+	if quiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", a...)
+}
+
+// infof prints a status line to stderr, such as a file written or a
+// stream connected, unless --quiet is set.
+func infof(format string, a ...any) {
+	if quiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", a...)
+}
+
+// addLimitFlag registers --limit on cmd with the endpoint family's default
+// page size from api.EndpointLimit, appending its maximum to usage.
+func addLimitFlag(cmd *cobra.Command, endpoint, usage string) {
+	spec, _ := api.EndpointLimit(endpoint)
+	cmd.Flags().String("limit", strconv.Itoa(spec.Default), fmt.Sprintf("%s (max %d)", usage, spec.Max))
+}
+
+// clampLimit caps a --limit value at the endpoint family's documented
+// maximum, printing a warning to stderr when it had to be lowered, so an
+// oversized limit returns a full page instead of an API error.
+func clampLimit(endpoint, requested string) string {
+	limit, clamped := api.ClampLimit(endpoint, requested)
+	if clamped {
+		warnf("--limit %s exceeds the maximum for this endpoint; using %s", requested, limit)
+	}
+	return limit
+}
+
+// intradayWindow returns the from/to range, as millisecond timestamps,
+// covering the latest bars bars of minutes minutes each and ending at now.
+// The window is doubled so gaps from minutes without trades still leave
+// enough bars; callers sort newest first and limit to bars.
+func intradayWindow(now time.Time, minutes, bars int) (from, to string) {
+	span := 2 * time.Duration(minutes*bars) * time.Minute
+	return strconv.FormatInt(now.Add(-span).UnixMilli(), 10), strconv.FormatInt(now.UnixMilli(), 10)
 }
 
 // maskString partially masks a sensitive string for display, showing only
@@ -36,12 +199,424 @@ func maskString(s string) string {
 }
 
 // printJSON formats the given value as indented JSON and prints it to stdout.
-// Used when the --output json flag is specified.
+// Used when the --output json flag is specified. With --compact the value is
+// written on a single line instead, which suits logs and jq streaming. With
+// --results-only an API response is unwrapped to its payload first (see
+// resultsPayload), and with --with-meta the result is wrapped with the
+// latest response's metadata. With --post-to the printed JSON is also
+// POSTed to that URL.
 func printJSON(v interface{}) error {
-	data, err := json.MarshalIndent(v, "", "  ")
+	if resultsOnly {
+		v = resultsPayload(v)
+	}
+	if withMeta {
+		v = jsonWithMeta{Meta: latestResponseMeta(), Data: v}
+	}
+
+	var data []byte
+	var err error
+	if compactJSON {
+		data, err = json.Marshal(v)
+	} else {
+		data, err = json.MarshalIndent(v, "", "  ")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to format JSON: %w", err)
 	}
 	fmt.Println(string(data))
+
+	if postTo != "" {
+		return postOutput(data)
+	}
+	return nil
+}
+
+// jsonWithMeta is the --with-meta wrapper around JSON output. Meta is
+// null when the command made no API request.
+type jsonWithMeta struct {
+	Meta *api.ResponseMeta `json:"meta"`
+	Data interface{}       `json:"data"`
+}
+
+// latestResponseMeta returns the metadata of the most recently received
+// response across every client of the run, or nil if none was received.
+func latestResponseMeta() *api.ResponseMeta {
+	var latest *api.ResponseMeta
+	for _, c := range clients {
+		meta := c.LastResponse()
+		if meta.FetchedAt.IsZero() {
+			continue
+		}
+		if latest == nil || meta.FetchedAt.After(latest.FetchedAt) {
+			latest = &meta
+		}
+	}
+	return latest
+}
+
+// envelopeFields are the JSON keys that mark a struct as an API response
+// envelope rather than a single record.
+var envelopeFields = []string{"status", "request_id"}
+
+// payloadFields are the JSON keys holding an envelope's payload, in order
+// of preference. List endpoints use "results" (snapshot endpoints use
+// "tickers") and single-object endpoints use a "results" object.
+var payloadFields = []string{"results", "tickers"}
+
+// resultsPayload returns the payload of an API response envelope: the
+// value of its results (or tickers) field, dropping status, request_id,
+// next_url, and other metadata. It finds the fields by JSON tag using
+// reflection so it works for every response type. Values that are not
+// envelopes, such as CLI-built output structs or single records, are
+// returned unchanged. A nil results list unwraps to an empty one so the
+// output is [] rather than null.
+func resultsPayload(v interface{}) interface{} {
+	if doc, ok := v.(api.RawDocument); ok {
+		return rawResultsPayload(doc)
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return v
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+
+	fields := make(map[string]reflect.Value)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = rv.Field(i)
+	}
+
+	envelope := false
+	for _, name := range envelopeFields {
+		if _, ok := fields[name]; ok {
+			envelope = true
+		}
+	}
+	if !envelope {
+		return v
+	}
+
+	for _, name := range payloadFields {
+		if field, ok := fields[name]; ok {
+			if field.Kind() == reflect.Slice && field.IsNil() {
+				return reflect.MakeSlice(field.Type(), 0, 0).Interface()
+			}
+			return field.Interface()
+		}
+	}
+
+	return v
+}
+
+// rawResultsPayload is resultsPayload for an api.RawDocument, unwrapping
+// the same envelope keys from the decoded map.
+func rawResultsPayload(doc api.RawDocument) interface{} {
+	envelope := false
+	for _, name := range envelopeFields {
+		if _, ok := doc[name]; ok {
+			envelope = true
+		}
+	}
+	if !envelope {
+		return doc
+	}
+
+	for _, name := range payloadFields {
+		if payload, ok := doc[name]; ok {
+			return payload
+		}
+	}
+	return doc
+}
+
+// printNextCursor tells the user how to resume when a table response has
+// more pages. The cursor is pulled from next_url so it can be passed back
+// through --cursor. Nothing is printed on the last page or with --quiet,
+// and with --no-header the hint goes to stderr so appended rows stay clean.
+func printNextCursor(nextURL string) {
+	if quiet {
+		return
+	}
+	cursor := api.NextCursor(nextURL)
+	if cursor == "" {
+		return
+	}
+	if noHeader {
+		infof("More results available. Resume with --cursor %s", cursor)
+		return
+	}
+	fmt.Printf("\nMore results available. Resume with --cursor %s\n", cursor)
+}
+
+// withResumeCursor annotates an error from following next_url with the
+// cursor of the page that failed, so a long --all run can be picked up
+// again with --cursor instead of starting over.
+func withResumeCursor(err error, nextURL string) error {
+	if cursor := api.NextCursor(nextURL); cursor != "" && !errors.Is(err, api.ErrRequestPrinted) {
+		return fmt.Errorf("%w (resume with --cursor %s)", err, cursor)
+	}
+	return err
+}
+
+// writeHeader writes a table's column header row followed by its dashed
+// separator row. Both rows are skipped when the --no-header flag is set so
+// table output can be appended to files that already have a header.
+func writeHeader(w io.Writer, header, separator string) {
+	if noHeader {
+		return
+	}
+	fmt.Fprintln(w, header)
+	fmt.Fprintln(w, separator)
+}
+
+// writeSection writes a label and dashed underline that title one section
+// of a multi-section view, such as EXCHANGES in market status. Unlike
+// writeHeader it is kept with --no-header, since without it the sections
+// run together.
+func writeSection(w io.Writer, label string) {
+	fmt.Fprintln(w, label)
+	fmt.Fprintln(w, strings.Repeat("-", len(label)))
+}
+
+// formatCells formats each value with format and joins them with tabs for
+// a table row. When present is false every cell renders as "-" instead, so
+// nested snapshot objects missing from a response don't show up as zeros.
+func formatCells(present bool, format string, values ...float64) string {
+	cells := make([]string, len(values))
+	for i, v := range values {
+		if present {
+			cells[i] = fmt.Sprintf(format, v)
+		} else {
+			cells[i] = "-"
+		}
+	}
+	return strings.Join(cells, "\t")
+}
+
+// priceCells is formatCells for price columns: each value is rendered by
+// priceCell, so the row follows the resolved price precision.
+func priceCells(present bool, format string, values ...float64) string {
+	cells := make([]string, len(values))
+	for i, v := range values {
+		if present {
+			cells[i] = priceCell(format, v)
+		} else {
+			cells[i] = "-"
+		}
+	}
+	return strings.Join(cells, "\t")
+}
+
+// priceCell formats a price with the precision resolved for the command
+// (--precision, --smart-precision, or the asset class default), or with
+// the renderer's fixed format when none applies.
+func priceCell(format string, f float64) string {
+	if cell, ok := formatWithPrecision(f); ok {
+		return cell
+	}
+	return fmt.Sprintf(format, f)
+}
+
+// timestampCell renders an integer timestamp in unit with layout, or as
+// the raw epoch value when --raw-timestamps is set so downstream parsers
+// get it with no timezone or layout applied. Zero stays "-" either way,
+// marking a missing field.
+func timestampCell(value int64, unit api.TimeUnit, layout string) string {
+	if rawTimestamps && value != 0 {
+		return strconv.FormatInt(value, 10)
+	}
+	return api.FormatTimestamp(value, unit, layout)
+}
+
+// formatPrice formats a price with decimals chosen by magnitude and
+// trailing zeros trimmed: 2 decimals from 1000 up, 4 from 1, and at least
+// 6 below 1, adding more for tiny prices so about four significant digits
+// survive (0.00001234 stays 0.00001234 rather than 0.000012).
+func formatPrice(f float64) string {
+	abs := math.Abs(f)
+	decimals := 6
+	switch {
+	case abs >= 1000:
+		decimals = 2
+	case abs >= 1:
+		decimals = 4
+	case abs > 0:
+		decimals = min(max(decimals, 3-int(math.Floor(math.Log10(abs)))), 12)
+	}
+
+	s := strconv.FormatFloat(f, 'f', decimals, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// humanizeUnits are the humanizeNumber suffixes, smallest first.
+var humanizeUnits = []struct {
+	size   float64
+	suffix string
+}{
+	{1e3, "K"},
+	{1e6, "M"},
+	{1e9, "B"},
+	{1e12, "T"},
+}
+
+// humanizeNumber abbreviates a large number using K, M, B, and T suffixes
+// with two decimal places (e.g. 391035000000 -> "391.04B"). Values that
+// round to under one thousand are returned as whole numbers without a
+// suffix. The unit is chosen after rounding, so 999999999 is "1.00B"
+// rather than "1000.00M".
+func humanizeNumber(f float64) string {
+	abs := math.Abs(f)
+	if rounded, _ := strconv.ParseFloat(strconv.FormatFloat(abs, 'f', 0, 64), 64); rounded < 1e3 {
+		if rounded == 0 {
+			return "0"
+		}
+		return fmt.Sprintf("%.0f", f)
+	}
+
+	for i, u := range humanizeUnits {
+		s := strconv.FormatFloat(abs/u.size, 'f', 2, 64)
+		if v, _ := strconv.ParseFloat(s, 64); v < 1e3 || i == len(humanizeUnits)-1 {
+			if f < 0 {
+				s = "-" + s
+			}
+			return s + u.suffix
+		}
+	}
+	return ""
+}
+
+// resolveExchangeID turns an --exchange flag value into a numeric exchange
+// ID. Numeric values are used as-is; anything else is looked up by name,
+// acronym, or MIC in the reference exchanges for the given asset class.
+func resolveExchangeID(client *api.Client, assetClass, value string) (int, error) {
+	if id, err := strconv.Atoi(value); err == nil {
+		return id, nil
+	}
+
+	exchanges, err := client.GetExchanges(api.ExchangesParams{AssetClass: assetClass})
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve exchange %q: %w", value, err)
+	}
+
+	e, ok := exchanges.Lookup(value)
+	if !ok {
+		return 0, fmt.Errorf("unknown %s exchange %q", assetClass, value)
+	}
+
+	return e.ID, nil
+}
+
+// sinceState is what a --since-file holds: the nanosecond timestamp of
+// the newest record seen and, when the last run stopped with more pages
+// available, the cursor of the next page. Resuming from the cursor instead
+// of timestamp.gt keeps records that share the last timestamp but spilled
+// onto the next page.
+type sinceState struct {
+	Timestamp int64
+	Cursor    string
+}
+
+// checkSinceFileFlags rejects flags that conflict with --since-file, which
+// always reads oldest first from the position saved in the file.
+func checkSinceFileFlags(cmd *cobra.Command) error {
+	for _, name := range []string{"timestamp-gt", "sort", "order", "cursor"} {
+		if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+			return fmt.Errorf("--%s cannot be combined with --since-file, which reads oldest first from the saved position", name)
+		}
+	}
+	return nil
+}
+
+// readSinceFile reads the position stored by a previous --since-file run:
+// a timestamp line, followed by a cursor line when that run stopped with
+// more pages available. A missing file means no records have been seen
+// yet and returns a zero state so the first run fetches from the beginning.
+func readSinceFile(path string) (sinceState, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return sinceState{}, nil
+	}
+	if err != nil {
+		return sinceState{}, fmt.Errorf("failed to read since file: %w", err)
+	}
+
+	lines := strings.Fields(string(data))
+	if len(lines) == 0 {
+		return sinceState{}, nil
+	}
+
+	since, err := strconv.ParseInt(lines[0], 10, 64)
+	if err != nil {
+		return sinceState{}, fmt.Errorf("invalid timestamp in since file %s: %w", path, err)
+	}
+
+	state := sinceState{Timestamp: since}
+	if len(lines) > 1 {
+		state.Cursor = lines[1]
+	}
+	return state, nil
+}
+
+// writeSinceFile stores the position for the next --since-file run. The
+// value is written to a temporary file and renamed into place so an
+// interrupted run never leaves a partial cursor.
+func writeSinceFile(path string, state sinceState) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to write since file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = fmt.Fprintln(tmp, state.Timestamp)
+	if err == nil && state.Cursor != "" {
+		_, err = fmt.Fprintln(tmp, state.Cursor)
+	}
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write since file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write since file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write since file: %w", err)
+	}
+
 	return nil
 }
+
+// saveSince advances the --since-file position after a successful run to
+// the newest timestamp seen, plus the cursor from nextURL when more pages
+// remain. Nothing is written when incremental mode is off or the position
+// did not change.
+func saveSince(path string, prev sinceState, latest int64, nextURL string) error {
+	if path == "" {
+		return nil
+	}
+	next := sinceState{Timestamp: max(prev.Timestamp, latest), Cursor: api.NextCursor(nextURL)}
+	if next == prev {
+		return nil
+	}
+	return writeSinceFile(path, next)
+}
@@ -0,0 +1,411 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// TestSinceFileRoundTrip verifies a --since-file position survives a save
+// and read, keeps the next page's cursor while more pages remain, and
+// drops it once the pull has caught up.
+func TestSinceFileRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trades.cursor")
+
+	state, err := readSinceFile(path)
+	if err != nil || state != (sinceState{}) {
+		t.Fatalf("missing file = %+v, %v; want zero state", state, err)
+	}
+
+	// A page ending mid-timestamp saves the cursor for the next page.
+	if err := saveSince(path, state, 200, "https://api.massive.com/v3/trades/X:BTCUSD?cursor=abc123"); err != nil {
+		t.Fatal(err)
+	}
+	state, err = readSinceFile(path)
+	if err != nil || state != (sinceState{Timestamp: 200, Cursor: "abc123"}) {
+		t.Fatalf("after partial pull = %+v, %v", state, err)
+	}
+
+	// The last page clears the cursor and keeps the newest timestamp.
+	if err := saveSince(path, state, 300, ""); err != nil {
+		t.Fatal(err)
+	}
+	state, err = readSinceFile(path)
+	if err != nil || state != (sinceState{Timestamp: 300}) {
+		t.Fatalf("after caught-up pull = %+v, %v", state, err)
+	}
+}
+
+// TestReadSinceFileTimestampOnly verifies a file holding just a timestamp,
+// as written before cursors were saved, still reads.
+func TestReadSinceFileTimestampOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trades.cursor")
+	if err := os.WriteFile(path, []byte("1700000000000000000\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := readSinceFile(path)
+	if err != nil || state != (sinceState{Timestamp: 1700000000000000000}) {
+		t.Errorf("readSinceFile = %+v, %v", state, err)
+	}
+}
+
+// TestCheckSinceFileFlags verifies --since-file rejects flags that would
+// change its oldest-first order or override its starting point.
+func TestCheckSinceFileFlags(t *testing.T) {
+	for _, name := range []string{"timestamp-gt", "order", "sort", "cursor"} {
+		t.Run(name, func(t *testing.T) {
+			cryptoTradesCmd.Flags().Set(name, "desc")
+			defer func() {
+				f := cryptoTradesCmd.Flags().Lookup(name)
+				f.Value.Set(f.DefValue)
+				f.Changed = false
+			}()
+
+			if err := checkSinceFileFlags(cryptoTradesCmd); err == nil {
+				t.Errorf("--%s with --since-file should be rejected", name)
+			}
+		})
+	}
+
+	if err := checkSinceFileFlags(cryptoTradesCmd); err != nil {
+		t.Errorf("no conflicting flags: %v", err)
+	}
+}
+
+// TestNewClientUsesConfigBaseURL verifies the base_url from the config
+// file is where the CLI's requests are sent.
+func TestNewClientUsesConfigBaseURL(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Write([]byte(`{"status":"OK","results":[]}`))
+	}))
+	defer server.Close()
+
+	config.SetConfigDir(t.TempDir())
+	defer config.SetConfigDir("")
+	t.Setenv("MASSIVE_API_KEY", "test-api-key")
+	if err := config.Save(&config.Config{BaseURL: server.URL + "/"}); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := newClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetConditions(api.ConditionsParams{AssetClass: "stocks"}); err != nil {
+		t.Fatalf("request to configured base URL failed: %v", err)
+	}
+	if hits != 1 {
+		t.Errorf("configured server got %d requests, want 1", hits)
+	}
+}
+
+// captureStdout runs fn and returns what it printed to stdout.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	return captureFile(t, &os.Stdout, fn)
+}
+
+// captureStderr runs fn and returns what it printed to stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	return captureFile(t, &os.Stderr, fn)
+}
+
+// captureFile runs fn with *f swapped for a pipe and returns what was
+// written to it.
+func captureFile(t *testing.T, f **os.File, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := *f
+	*f = w
+	defer func() { *f = saved }()
+
+	fn()
+	w.Close()
+	out, _ := io.ReadAll(r)
+	return string(out)
+}
+
+// TestNoHeader verifies --no-header leaves only data rows: the summary
+// line, column header, separator, and resume hint are dropped from
+// stdout, while section titles are kept so sections stay apart.
+func TestNoHeader(t *testing.T) {
+	render := func() string {
+		return captureStdout(t, func() {
+			printSummary("Ticker: %s | Bars: %d", "AAPL", 1)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			writeHeader(w, "DATE\tCLOSE", "----\t-----")
+			fmt.Fprintln(w, "2025-01-02\t243.85")
+			writeSection(w, "EXCHANGES")
+			fmt.Fprintln(w, "NYSE\topen")
+			w.Flush()
+			printNextCursor("https://api.massive.com/v2/aggs?cursor=abc")
+		})
+	}
+
+	saved := noHeader
+	defer func() { noHeader = saved }()
+
+	noHeader = false
+	with := render()
+	for _, want := range []string{"Ticker: AAPL | Bars: 1", "DATE", "-----", "EXCHANGES", "--cursor abc"} {
+		if !strings.Contains(with, want) {
+			t.Errorf("default output missing %q:\n%s", want, with)
+		}
+	}
+
+	noHeader = true
+	without := render()
+	want := "2025-01-02  243.85\nEXCHANGES\n---------\nNYSE  open\n"
+	if without != want {
+		t.Errorf("--no-header output =\n%q\nwant\n%q", without, want)
+	}
+}
+
+// TestCheckCachedTickers verifies ticker arguments are checked against a
+// fresh local index, that only unknown tickers are warned about, and that
+// a missing or stale index skips the check.
+func TestCheckCachedTickers(t *testing.T) {
+	dir := t.TempDir()
+	config.SetConfigDir(dir)
+	defer config.SetConfigDir("")
+
+	writeIndex := func(fetched time.Time) {
+		t.Helper()
+		ix := api.TickerIndex{
+			Market:    "stocks",
+			FetchedAt: fetched,
+			Tickers:   []api.TickerIndexEntry{{Ticker: "AAPL"}, {Ticker: "MSFT"}},
+		}
+		data, err := json.Marshal(ix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(dir, "cache"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "cache", "tickers-stocks.json"), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	check := checkCachedTickers("stocks", cobra.MinimumNArgs(1))
+	run := func(args ...string) (string, error) {
+		var err error
+		out := captureStderr(t, func() { err = check(&cobra.Command{}, args) })
+		return out, err
+	}
+
+	if out, err := run("ZZZZ"); err != nil || out != "" {
+		t.Errorf("no index: got %q, %v; want no warning", out, err)
+	}
+
+	writeIndex(time.Now().Add(-2 * api.TickerCacheTTL))
+	if out, err := run("ZZZZ"); err != nil || out != "" {
+		t.Errorf("stale index: got %q, %v; want no warning", out, err)
+	}
+
+	writeIndex(time.Now())
+	out, err := run("aapl,zzzz", "MSFT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "ZZZZ is not an active stocks ticker") || strings.Contains(out, "AAPL") || strings.Contains(out, "MSFT") {
+		t.Errorf("fresh index warnings = %q, want only ZZZZ", out)
+	}
+
+	if _, err := run(); err == nil {
+		t.Error("expected the wrapped validator's error for no arguments")
+	}
+}
+
+// TestHumanizeNumber verifies each suffix, the boundaries where rounding
+// carries into the next unit, and negative values.
+func TestHumanizeNumber(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{0, "0"},
+		{-0.4, "0"},
+		{999, "999"},
+		{999.4, "999"},
+		{999.5, "1.00K"},
+		{1000, "1.00K"},
+		{1234, "1.23K"},
+		{999_994, "999.99K"},
+		{999_995, "1.00M"},
+		{999_999, "1.00M"},
+		{1_000_000, "1.00M"},
+		{999_994_999, "999.99M"},
+		{999_999_999, "1.00B"},
+		{391_035_000_000, "391.04B"},
+		{999_999_999_999, "1.00T"},
+		{1.5e15, "1500.00T"},
+		{-999, "-999"},
+		{-999.5, "-1.00K"},
+		{-999_999, "-1.00M"},
+		{-999_999_999, "-1.00B"},
+		{-999_999_999_999, "-1.00T"},
+		{-2_500_000, "-2.50M"},
+	}
+	for _, tt := range tests {
+		if got := humanizeNumber(tt.in); got != tt.want {
+			t.Errorf("humanizeNumber(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestResultsPayload verifies envelopes unwrap to their results or
+// tickers field whatever its type, while records and non-struct values
+// pass through unchanged.
+func TestResultsPayload(t *testing.T) {
+	type overview struct {
+		Name string `json:"name"`
+	}
+	type pointerEnvelope struct {
+		Status  string    `json:"status"`
+		Results *overview `json:"results"`
+	}
+	type listEnvelope struct {
+		RequestID string     `json:"request_id"`
+		Results   []overview `json:"results"`
+	}
+	type record struct {
+		Ticker  string   `json:"ticker"`
+		Results []string `json:"results"`
+	}
+
+	tests := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{"pointer results", pointerEnvelope{Status: "OK", Results: &overview{Name: "Apple"}}, `{"name":"Apple"}`},
+		{"nil pointer results", pointerEnvelope{Status: "OK"}, `null`},
+		{"pointer to envelope", &listEnvelope{RequestID: "abc", Results: []overview{{Name: "A"}}}, `[{"name":"A"}]`},
+		{"nil slice results", listEnvelope{RequestID: "abc"}, `[]`},
+		{"nil snapshot tickers", api.CryptoSnapshotResponse{Status: "OK"}, `[]`},
+		{"record without envelope keys", record{Ticker: "AAPL", Results: []string{"x"}}, `{"ticker":"AAPL","results":["x"]}`},
+		{"nil pointer", (*listEnvelope)(nil), `null`},
+		{"slice", []int{1, 2}, `[1,2]`},
+		{"map", map[string]int{"status": 1}, `{"status":1}`},
+		{"string", "OK", `"OK"`},
+		{"raw document", api.RawDocument{"status": "OK", "results": []any{"a"}}, `["a"]`},
+	}
+
+	for _, tt := range tests {
+		got := resultsPayload(tt.in)
+		data, err := json.Marshal(got)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if string(data) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, data, tt.want)
+		}
+	}
+
+	if got, ok := resultsPayload(api.CryptoSnapshotResponse{Status: "OK", Tickers: []api.CryptoSnapshotTicker{{Ticker: "X:BTCUSD"}}}).([]api.CryptoSnapshotTicker); !ok || len(got) != 1 || got[0].Ticker != "X:BTCUSD" {
+		t.Errorf("snapshot tickers: got %#v, want the tickers slice", got)
+	}
+	if got, ok := resultsPayload(api.CryptoTickerOverviewResponse{Status: "OK", Results: api.CryptoTickerOverview{Ticker: "X:BTCUSD"}}).(api.CryptoTickerOverview); !ok || got.Ticker != "X:BTCUSD" {
+		t.Errorf("struct results: got %#v, want the overview", got)
+	}
+}
+
+// TestFormatPrice verifies magnitude-based decimals, including sub-penny
+// crypto prices that keep about four significant digits, zero, and
+// negative changes.
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{0, "0"},
+		{-0.0, "0"},
+		{43500, "43500"},
+		{43512.345, "43512.35"},
+		{1234.5, "1234.5"},
+		{185.64, "185.64"},
+		{1.23456789, "1.2346"},
+		{1, "1"},
+		{0.5, "0.5"},
+		{0.123456789, "0.123457"},
+		{0.00123456, "0.001235"},
+		{0.00001234, "0.00001234"},
+		{0.0000012346, "0.000001235"},
+		{0.000000001234, "0.000000001234"},
+		{1e-15, "0"},
+		{-1e-15, "0"},
+		{-2.5, "-2.5"},
+		{-1234.567, "-1234.57"},
+		{-0.00001234, "-0.00001234"},
+		{-0.0421, "-0.0421"},
+	}
+	for _, tt := range tests {
+		if got := formatPrice(tt.in); got != tt.want {
+			t.Errorf("formatPrice(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestPriceCell verifies price cells follow the resolved precision: the
+// renderer's own format when none is set, auto sizing, fixed decimals,
+// and tick rounding, which falls back to the format without a tick.
+func TestPriceCell(t *testing.T) {
+	savedPrecision, savedTick := pricePrecision, priceTick
+	defer func() { pricePrecision, priceTick = savedPrecision, savedTick }()
+
+	tests := []struct {
+		precision string
+		tick      float64
+		in        float64
+		want      string
+	}{
+		{"", 0, 185.6449, "185.6449"},
+		{"", 0, -2.5, "-2.5000"},
+		{"", 0, 0, "0.0000"},
+		{config.PrecisionAuto, 0, 0.00001234, "0.00001234"},
+		{config.PrecisionAuto, 0, -0.00001234, "-0.00001234"},
+		{config.PrecisionAuto, 0, 0, "0"},
+		{config.PrecisionAuto, 0, 43500, "43500"},
+		{"2", 0, 185.6449, "185.64"},
+		{"2", 0, -1.005, "-1.00"},
+		{"0", 0, 0, "0"},
+		{"8", 0, 0.00001234, "0.00001234"},
+		{config.PrecisionTick, 0.25, 5012.38, "5012.50"},
+		{config.PrecisionTick, 0.25, -0.13, "-0.25"},
+		{config.PrecisionTick, 0.03125, 110.17, "110.15625"},
+		{config.PrecisionTick, 0, 5012.38, "5012.3800"},
+	}
+	for _, tt := range tests {
+		pricePrecision, priceTick = tt.precision, tt.tick
+		if got := priceCell("%.4f", tt.in); got != tt.want {
+			t.Errorf("priceCell(%v) with precision %q tick %v = %q, want %q", tt.in, tt.precision, tt.tick, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// indicatorWithUnderlying is the JSON output of an indicator command run
+// with --fetch-underlying: the indicator response unchanged plus the
+// aggregate bars it was computed from.
+type indicatorWithUnderlying struct {
+	Indicator  interface{}       `json:"indicator"`
+	Underlying *api.BarsResponse `json:"underlying"`
+}
+
+// addUnderlyingFlags registers the --show-underlying and --fetch-underlying
+// flags shared by the SMA, EMA, RSI, and MACD commands of every asset class.
+func addUnderlyingFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("show-underlying", false, "Print the URL of the aggregates the indicator was computed from")
+	cmd.Flags().Bool("fetch-underlying", false, "Also fetch and print the aggregate bars the indicator was computed from")
+}
+
+// printIndicatorJSON prints an indicator response as JSON. With
+// --fetch-underlying the source bars are fetched and printed next to it.
+// The underlying URL is already part of the response, so --show-underlying
+// adds nothing here.
+func printIndicatorJSON(cmd *cobra.Command, client *api.Client, result interface{}, underlyingURL string) error {
+	if fetch, _ := cmd.Flags().GetBool("fetch-underlying"); !fetch {
+		return printJSON(result)
+	}
+
+	bars, err := client.GetIndicatorUnderlying(underlyingURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch underlying aggregates: %w", err)
+	}
+
+	return printJSON(indicatorWithUnderlying{Indicator: result, Underlying: bars})
+}
+
+// printIndicatorUnderlying runs after an indicator table has been printed.
+// --show-underlying prints the source aggregates URL, rewritten onto the
+// configured base URL, and --fetch-underlying additionally fetches those
+// bars and renders them below the indicator values so the inputs can be
+// checked against the output.
+func printIndicatorUnderlying(cmd *cobra.Command, client *api.Client, underlyingURL string) error {
+	show, _ := cmd.Flags().GetBool("show-underlying")
+	fetch, _ := cmd.Flags().GetBool("fetch-underlying")
+	if !show && !fetch {
+		return nil
+	}
+
+	if underlyingURL == "" {
+		return fmt.Errorf("the indicator response did not include an underlying URL")
+	}
+
+	resolved, err := client.ResolveURL(underlyingURL)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\nUnderlying: %s\n", resolved)
+
+	if !fetch {
+		return nil
+	}
+
+	bars, err := client.GetIndicatorUnderlying(underlyingURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch underlying aggregates: %w", err)
+	}
+
+	layout := "2006-01-02"
+	if timespan, _ := timespanFlag(cmd); timespan == "minute" || timespan == "hour" {
+		layout = "2006-01-02 15:04"
+	}
+
+	printSummary("Underlying bars: %d", len(bars.Results))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME", "----\t----\t----\t---\t-----\t------")
+
+	for _, bar := range bars.Results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\n",
+			timestampCell(bar.Timestamp, api.UnitMilliseconds, layout), priceCell("%.4f", bar.Open),
+			priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low), priceCell("%.4f", bar.Close), bar.Volume)
+	}
+	w.Flush()
+
+	return nil
+}
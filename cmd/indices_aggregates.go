@@ -33,11 +33,21 @@ var indicesBarsCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		multiplier, _ := cmd.Flags().GetString("multiplier")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
 		sort, _ := cmd.Flags().GetString("sort")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitAggs, limit)
 
 		params := api.IndicesBarsParams{
 			Multiplier: multiplier,
@@ -48,27 +58,43 @@ var indicesBarsCmd = &cobra.Command{
 			Limit:      limit,
 		}
 
+		if err := confirmExplain(barsExplanation{
+			Ticker:     ticker,
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Sort:       sort,
+			Limit:      limit,
+		}); err != nil {
+			return err
+		}
+
 		result, err := client.GetIndicesBars(ticker, params)
 		if err != nil {
 			return err
 		}
+		checkBarCounts(result.QueryCount, result.ResultsCount, limit)
 
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Bars: %d\n\n", result.Ticker, result.ResultsCount)
+		printSummary("Ticker: %s | Bars: %d", result.Ticker, result.ResultsCount)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----")
-
-		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close)
+		writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tCHG%\tRANGE", "----\t----\t----\t---\t-----\t----\t-----")
+
+		var stats barStats
+		changes := barChangePercents(result.Results, func(b api.IndicesBar) (int64, float64) { return b.Timestamp, b.Close })
+		for i, bar := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close), changes[i], priceCell("%.4f", bar.High-bar.Low))
+			stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, 0)
 		}
+		stats.writeFooter(w, 7, "%.4f", 2, 3, 4, -1)
 		w.Flush()
 
 		return nil
@@ -101,17 +127,16 @@ var indicesDailyTickerSummaryCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Index: %s | Date: %s\n\n", result.Symbol, result.From)
+		printSummary("Index: %s | Date: %s", result.Symbol, result.From)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "FIELD\tVALUE")
-		fmt.Fprintln(w, "-----\t-----")
-		fmt.Fprintf(w, "Open\t%.4f\n", result.Open)
-		fmt.Fprintf(w, "High\t%.4f\n", result.High)
-		fmt.Fprintf(w, "Low\t%.4f\n", result.Low)
-		fmt.Fprintf(w, "Close\t%.4f\n", result.Close)
-		fmt.Fprintf(w, "After Hours\t%.4f\n", result.AfterHours)
-		fmt.Fprintf(w, "Pre-Market\t%.4f\n", result.PreMarket)
+		writeHeader(w, "FIELD\tVALUE", "-----\t-----")
+		fmt.Fprintf(w, "Open\t%s\n", priceCell("%.4f", result.Open))
+		fmt.Fprintf(w, "High\t%s\n", priceCell("%.4f", result.High))
+		fmt.Fprintf(w, "Low\t%s\n", priceCell("%.4f", result.Low))
+		fmt.Fprintf(w, "Close\t%s\n", priceCell("%.4f", result.Close))
+		fmt.Fprintf(w, "After Hours\t%s\n", priceCell("%.4f", result.AfterHours))
+		fmt.Fprintf(w, "Pre-Market\t%s\n", priceCell("%.4f", result.PreMarket))
 		w.Flush()
 
 		return nil
@@ -144,18 +169,17 @@ var indicesPreviousDayBarCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Results: %d\n\n", result.Ticker, result.ResultsCount)
+		printSummary("Ticker: %s | Results: %d", result.Ticker, result.ResultsCount)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDATE\tOPEN\tHIGH\tLOW\tCLOSE")
-		fmt.Fprintln(w, "------\t----\t----\t----\t---\t-----")
+		writeHeader(w, "TICKER\tDATE\tOPEN\tHIGH\tLOW\tCLOSE", "------\t----\t----\t----\t---\t-----")
 
 		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.4f\t%.4f\n",
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
 				bar.Ticker,
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close)
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close))
 		}
 		w.Flush()
 
@@ -171,11 +195,14 @@ func init() {
 	indicesBarsCmd.Flags().String("from", "", "Start date (YYYY-MM-DD) [required]")
 	indicesBarsCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
 	indicesBarsCmd.Flags().String("sort", "asc", "Sort order (asc/desc)")
-	indicesBarsCmd.Flags().String("limit", "5000", "Max number of results (max 50000)")
+	addLimitFlag(indicesBarsCmd, api.LimitAggs, "Max number of results")
 
 	indicesBarsCmd.MarkFlagRequired("from")
 	indicesBarsCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(indicesBarsCmd, "from", "to")
 
+	useTickerIndex(indicesBarsCmd, "indices")
+	supportsExplain(indicesBarsCmd)
 	indicesCmd.AddCommand(indicesBarsCmd)
 	indicesCmd.AddCommand(indicesDailyTickerSummaryCmd)
 	indicesCmd.AddCommand(indicesPreviousDayBarCmd)
@@ -50,6 +50,10 @@ var indicesSnapshotsTickerCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, indicesSnapshotAges(result.Results)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -65,8 +69,7 @@ var indicesSnapshotsTickerCmd = &cobra.Command{
 		fmt.Printf("Market Status: %s | Timeframe: %s\n\n", idx.MarketStatus, idx.Timeframe)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "OPEN\tHIGH\tLOW\tCLOSE\tPREV CLOSE")
-		fmt.Fprintln(w, "----\t----\t---\t-----\t----------")
+		writeHeader(w, "OPEN\tHIGH\tLOW\tCLOSE\tPREV CLOSE", "----\t----\t---\t-----\t----------")
 		fmt.Fprintf(w, "%.2f\t%.2f\t%.2f\t%.2f\t%.2f\n",
 			idx.Session.Open, idx.Session.High, idx.Session.Low,
 			idx.Session.Close, idx.Session.PreviousClose)
@@ -92,6 +95,7 @@ var indicesSnapshotsAllCmd = &cobra.Command{
 
 		tickers, _ := cmd.Flags().GetString("tickers")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitSnapshots, limit)
 		order, _ := cmd.Flags().GetString("order")
 		sort, _ := cmd.Flags().GetString("sort")
 
@@ -107,15 +111,18 @@ var indicesSnapshotsAllCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, indicesSnapshotAges(result.Results)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Indices: %d\n\n", len(result.Results))
+		printSummary("Indices: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tNAME\tVALUE\tOPEN\tHIGH\tLOW\tCLOSE\tCHANGE\tCHANGE %\tSTATUS")
-		fmt.Fprintln(w, "------\t----\t-----\t----\t----\t---\t-----\t------\t--------\t------")
+		writeHeader(w, "TICKER\tNAME\tVALUE\tOPEN\tHIGH\tLOW\tCLOSE\tCHANGE\tCHANGE %\tSTATUS", "------\t----\t-----\t----\t----\t---\t-----\t------\t--------\t------")
 
 		for _, idx := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.4f%%\t%s\n",
@@ -134,11 +141,13 @@ var indicesSnapshotsAllCmd = &cobra.Command{
 // with their respective flags under the indices parent command.
 func init() {
 	indicesSnapshotsAllCmd.Flags().String("tickers", "", "Comma-separated list of index ticker symbols (e.g. I:SPX,I:DJI)")
-	indicesSnapshotsAllCmd.Flags().String("limit", "", "Maximum number of results (default: 10, max: 250)")
+	addLimitFlag(indicesSnapshotsAllCmd, api.LimitSnapshots, "Maximum number of results")
 	indicesSnapshotsAllCmd.Flags().String("order", "", "Order results (asc or desc)")
 	indicesSnapshotsAllCmd.Flags().String("sort", "", "Field to sort results by")
 
+	addMaxAgeFlags(indicesSnapshotsTickerCmd)
 	indicesSnapshotsCmd.AddCommand(indicesSnapshotsTickerCmd)
+	addMaxAgeFlags(indicesSnapshotsAllCmd)
 	indicesSnapshotsCmd.AddCommand(indicesSnapshotsAllCmd)
 
 	indicesCmd.AddCommand(indicesSnapshotsCmd)
@@ -35,6 +35,7 @@ var indicesTickersCmd = &cobra.Command{
 		sort, _ := cmd.Flags().GetString("sort")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTickers, limit)
 
 		params := api.IndicesTickerParams{
 			Ticker: ticker,
@@ -54,11 +55,10 @@ var indicesTickersCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", result.Count)
+		printSummary("Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tNAME\tSOURCE FEED\tACTIVE")
-		fmt.Fprintln(w, "------\t----\t-----------\t------")
+		writeHeader(w, "TICKER\tNAME\tSOURCE FEED\tACTIVE", "------\t----\t-----------\t------")
 
 		for _, t := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%v\n",
@@ -79,6 +79,6 @@ func init() {
 	indicesTickersCmd.Flags().String("active", "", "Filter by active status (true/false)")
 	indicesTickersCmd.Flags().String("sort", "ticker", "Sort field (ticker, name)")
 	indicesTickersCmd.Flags().String("order", "asc", "Sort order (asc/desc)")
-	indicesTickersCmd.Flags().String("limit", "20", "Number of results to return (max 1000)")
+	addLimitFlag(indicesTickersCmd, api.LimitTickers, "Number of results to return")
 	indicesCmd.AddCommand(indicesTickersCmd)
 }
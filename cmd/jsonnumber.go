@@ -0,0 +1,55 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// jsonNumber prints JSON output with every number exactly as the API sent
+// it, decoded through api.GetRaw instead of the typed structs. Set via the
+// global --json-number flag.
+var jsonNumber bool
+
+// jsonNumberAnnotation marks commands whose JSON output can come from
+// api.GetRaw. Other commands reject --json-number rather than silently
+// printing rounded numbers.
+const jsonNumberAnnotation = "json-number"
+
+// supportsJSONNumber marks cmd as able to print number-preserving JSON.
+func supportsJSONNumber(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[jsonNumberAnnotation] = "true"
+}
+
+// checkJSONNumberFlag validates --json-number before a command runs: it
+// needs a supporting command and JSON output.
+func checkJSONNumberFlag(cmd *cobra.Command) error {
+	if !jsonNumber {
+		return nil
+	}
+	if cmd.Annotations[jsonNumberAnnotation] == "" {
+		return fmt.Errorf("--json-number is not supported by %s", cmd.CommandPath())
+	}
+	if outputFormat != "json" {
+		return fmt.Errorf("--json-number requires --output json")
+	}
+	return nil
+}
+
+// printRawJSON fetches r with number-preserving decoding and prints it.
+func printRawJSON(client *api.Client, r api.RawRequest) error {
+	raw, err := client.GetRaw(r)
+	if err != nil {
+		return err
+	}
+	return printJSON(raw)
+}
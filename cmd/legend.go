@@ -0,0 +1,76 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// addLegendFlag registers --legend on a trades or quotes command.
+func addLegendFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("legend", false, "Add a CONDITIONS column and list each condition code's name after the table")
+}
+
+// legendColumns appends the CONDITIONS column to a table's header and
+// separator when --legend is set.
+func legendColumns(legend bool, header, sep string) (string, string) {
+	if !legend {
+		return header, sep
+	}
+	return header + "\tCONDITIONS", sep + "\t----------"
+}
+
+// conditionsCell renders condition codes as a comma-separated cell, or
+// "-" when there are none.
+func conditionsCell(codes []int) string {
+	if len(codes) == 0 {
+		return "-"
+	}
+
+	cells := make([]string, len(codes))
+	for i, code := range codes {
+		cells[i] = strconv.Itoa(code)
+	}
+	return strings.Join(cells, ",")
+}
+
+// printConditionLegend prints the name of every condition code seen in
+// the table, looked up in the conditions reference for the asset class
+// and data type (one of conditionDataTypes). The legend only explains the
+// table, so a failed lookup warns instead of failing the command, and
+// codes the reference does not list are shown as unknown.
+func printConditionLegend(client *api.Client, assetClass, dataType string, lists [][]int) {
+	codes := api.DistinctConditions(lists)
+	if len(codes) == 0 {
+		return
+	}
+
+	result, err := client.GetConditions(api.ConditionsParams{AssetClass: assetClass, DataType: dataType})
+	if err != nil {
+		warnf("could not look up condition names: %v", err)
+		return
+	}
+	names := result.Names()
+
+	fmt.Println()
+	fmt.Println("Conditions:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, code := range codes {
+		name, ok := names[code]
+		if !ok {
+			name = "unknown"
+		}
+		fmt.Fprintf(w, "  %d\t%s\n", code, name)
+	}
+	w.Flush()
+}
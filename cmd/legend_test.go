@@ -0,0 +1,52 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestPrintConditionLegendStocksQuotes verifies the stocks quotes legend
+// asks for nbbo conditions and names them from a response whose codes
+// carry a sip_mapping object, as stocks conditions do.
+func TestPrintConditionLegendStocksQuotes(t *testing.T) {
+	var dataType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dataType = r.URL.Query().Get("data_type")
+		w.Write([]byte(`{"status":"OK","count":1,"results":[
+			{"id":1,"name":"Regular, Two-Sided Open","asset_class":"stocks","data_types":["nbbo"],
+			 "sip_mapping":{"CTA":"R","UTP":"A"}}]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient("test-api-key")
+	client.SetBaseURL(server.URL)
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	printConditionLegend(client, "stocks", conditionNBBO, [][]int{{1}})
+	w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+
+	if dataType != "nbbo" {
+		t.Errorf("data_type = %q, want nbbo", dataType)
+	}
+	if !strings.Contains(string(out), "Regular, Two-Sided Open") {
+		t.Errorf("legend = %q, want the condition name", out)
+	}
+}
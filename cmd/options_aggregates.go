@@ -33,12 +33,22 @@ var optionsBarsCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		multiplier, _ := cmd.Flags().GetString("multiplier")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
 		adjusted, _ := cmd.Flags().GetString("adjusted")
 		sort, _ := cmd.Flags().GetString("sort")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitAggs, limit)
 
 		params := api.OptionsBarsParams{
 			Multiplier: multiplier,
@@ -50,28 +60,50 @@ var optionsBarsCmd = &cobra.Command{
 			Limit:      limit,
 		}
 
+		if err := confirmExplain(barsExplanation{
+			Ticker:     ticker,
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   adjusted,
+			Sort:       sort,
+			Limit:      limit,
+		}); err != nil {
+			return err
+		}
+
 		result, err := client.GetOptionsBars(ticker, params)
 		if err != nil {
 			return err
 		}
+		checkBarCounts(result.QueryCount, result.ResultsCount, limit)
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromOptions(result.Results))
+		}
 
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Bars: %d | Adjusted: %v\n\n", result.Ticker, result.ResultsCount, result.Adjusted)
+		printSummary("Ticker: %s | Bars: %d | Adjusted: %v", result.Ticker, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----\t------\t----\t------")
-
-		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+		writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tCHG%\tRANGE\tVOLUME\tVWAP\tTRADES", "----\t----\t----\t---\t-----\t----\t-----\t------\t----\t------")
+
+		var stats barStats
+		stats.setAdjusted(result.Adjusted)
+		changes := barChangePercents(result.Results, func(b api.OptionsBar) (int64, float64) { return b.Timestamp, b.Close })
+		for i, bar := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close), changes[i], priceCell("%.4f", bar.High-bar.Low),
+				bar.Volume, priceCell("%.4f", bar.VWAP), bar.NumTrades)
+			stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, bar.Volume)
 		}
+		stats.writeFooter(w, 10, "%.4f", 2, 3, 4, 7)
 		w.Flush()
 
 		return nil
@@ -106,18 +138,17 @@ var optionsDailyTickerSummaryCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Contract: %s | Date: %s\n\n", result.Symbol, result.From)
+		printSummary("Contract: %s | Date: %s", result.Symbol, result.From)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "FIELD\tVALUE")
-		fmt.Fprintln(w, "-----\t-----")
-		fmt.Fprintf(w, "Open\t%.4f\n", result.Open)
-		fmt.Fprintf(w, "High\t%.4f\n", result.High)
-		fmt.Fprintf(w, "Low\t%.4f\n", result.Low)
-		fmt.Fprintf(w, "Close\t%.4f\n", result.Close)
+		writeHeader(w, "FIELD\tVALUE", "-----\t-----")
+		fmt.Fprintf(w, "Open\t%s\n", priceCell("%.4f", result.Open))
+		fmt.Fprintf(w, "High\t%s\n", priceCell("%.4f", result.High))
+		fmt.Fprintf(w, "Low\t%s\n", priceCell("%.4f", result.Low))
+		fmt.Fprintf(w, "Close\t%s\n", priceCell("%.4f", result.Close))
 		fmt.Fprintf(w, "Volume\t%.0f\n", result.Volume)
-		fmt.Fprintf(w, "After Hours\t%.4f\n", result.AfterHours)
-		fmt.Fprintf(w, "Pre-Market\t%.4f\n", result.PreMarket)
+		fmt.Fprintf(w, "After Hours\t%s\n", priceCell("%.4f", result.AfterHours))
+		fmt.Fprintf(w, "Pre-Market\t%s\n", priceCell("%.4f", result.PreMarket))
 		w.Flush()
 
 		return nil
@@ -152,19 +183,17 @@ var optionsPreviousDayBarCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Results: %d | Adjusted: %v\n\n", result.Ticker, result.ResultsCount, result.Adjusted)
+		printSummary("Ticker: %s | Results: %d | Adjusted: %v", result.Ticker, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "------\t----\t----\t----\t---\t-----\t------\t----\t------")
+		writeHeader(w, "TICKER\tDATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES", "------\t----\t----\t----\t---\t-----\t------\t----\t------")
 
 		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
 				bar.Ticker,
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close), bar.Volume, priceCell("%.4f", bar.VWAP), bar.NumTrades)
 		}
 		w.Flush()
 
@@ -181,15 +210,18 @@ func init() {
 	optionsBarsCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
 	optionsBarsCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 	optionsBarsCmd.Flags().String("sort", "asc", "Sort order (asc/desc)")
-	optionsBarsCmd.Flags().String("limit", "5000", "Max number of results (max 50000)")
+	addLimitFlag(optionsBarsCmd, api.LimitAggs, "Max number of results")
 
 	optionsBarsCmd.MarkFlagRequired("from")
 	optionsBarsCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(optionsBarsCmd, "from", "to")
 
 	optionsDailyTickerSummaryCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 
 	optionsPreviousDayBarCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 
+	supportsExplain(optionsBarsCmd)
+	supportsParquet(optionsBarsCmd)
 	optionsCmd.AddCommand(optionsBarsCmd)
 	optionsCmd.AddCommand(optionsDailyTickerSummaryCmd)
 	optionsCmd.AddCommand(optionsPreviousDayBarCmd)
@@ -44,6 +44,7 @@ var optionsContractsListCmd = &cobra.Command{
 		strikePriceLt, _ := cmd.Flags().GetString("strike-price-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitContracts, limit)
 		sort, _ := cmd.Flags().GetString("sort")
 
 		params := api.OptionsContractsParams{
@@ -75,11 +76,10 @@ var optionsContractsListCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", len(result.Results))
+		printSummary("Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tUNDERLYING\tTYPE\tSTRIKE\tEXPIRATION\tSTYLE\tEXCHANGE")
-		fmt.Fprintln(w, "------\t----------\t----\t------\t----------\t-----\t--------")
+		writeHeader(w, "TICKER\tUNDERLYING\tTYPE\tSTRIKE\tEXPIRATION\tSTYLE\tEXCHANGE", "------\t----------\t----\t------\t----------\t-----\t--------")
 
 		for _, c := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\t%s\t%s\t%s\n",
@@ -182,7 +182,7 @@ func init() {
 	optionsContractsListCmd.Flags().String("strike-price-lte", "", "Strike price less than or equal to")
 	optionsContractsListCmd.Flags().String("strike-price-lt", "", "Strike price less than")
 	optionsContractsListCmd.Flags().String("order", "asc", "Sort order (asc/desc)")
-	optionsContractsListCmd.Flags().String("limit", "20", "Number of results to return (max 1000)")
+	addLimitFlag(optionsContractsListCmd, api.LimitContracts, "Number of results to return")
 	optionsContractsListCmd.Flags().String("sort", "ticker", "Sort field (ticker, underlying_ticker, expiration_date, strike_price)")
 
 	// Get command flags
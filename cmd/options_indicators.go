@@ -10,7 +10,6 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
-	"time"
 
 	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/spf13/cobra"
@@ -32,7 +31,10 @@ var optionsSMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildOptionsIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetOptionsSMA(ticker, params)
 		if err != nil {
@@ -40,11 +42,11 @@ var optionsSMACmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printOptionsIndicatorTable(ticker, "SMA", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -64,7 +66,10 @@ var optionsEMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildOptionsIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetOptionsEMA(ticker, params)
 		if err != nil {
@@ -72,11 +77,11 @@ var optionsEMACmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printOptionsIndicatorTable(ticker, "EMA", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -96,7 +101,10 @@ var optionsRSICmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildOptionsIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetOptionsRSI(ticker, params)
 		if err != nil {
@@ -104,11 +112,11 @@ var optionsRSICmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printOptionsIndicatorTable(ticker, "RSI", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -129,28 +137,9 @@ var optionsMACDCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		adjusted, _ := cmd.Flags().GetString("adjusted")
-		shortWindow, _ := cmd.Flags().GetString("short-window")
-		longWindow, _ := cmd.Flags().GetString("long-window")
-		signalWindow, _ := cmd.Flags().GetString("signal-window")
-		seriesType, _ := cmd.Flags().GetString("series-type")
-		order, _ := cmd.Flags().GetString("order")
-		limit, _ := cmd.Flags().GetString("limit")
-
-		params := api.MACDParams{
-			TimestampGTE: from,
-			TimestampLTE: to,
-			Timespan:     timespan,
-			Adjusted:     adjusted,
-			ShortWindow:  shortWindow,
-			LongWindow:   longWindow,
-			SignalWindow: signalWindow,
-			SeriesType:   seriesType,
-			Order:        order,
-			Limit:        limit,
+		params, err := buildMACDParams(cmd)
+		if err != nil {
+			return err
 		}
 
 		result, err := client.GetOptionsMACD(ticker, params)
@@ -159,52 +148,25 @@ var optionsMACDCmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printOptionsMACDTable(ticker, result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
-// buildOptionsIndicatorParams extracts the common indicator flags from the
-// cobra command and returns a populated IndicatorParams struct. This is shared
-// by the options SMA, EMA, and RSI commands which all use the same parameters.
-func buildOptionsIndicatorParams(cmd *cobra.Command) api.IndicatorParams {
-	from, _ := cmd.Flags().GetString("from")
-	to, _ := cmd.Flags().GetString("to")
-	timespan, _ := cmd.Flags().GetString("timespan")
-	adjusted, _ := cmd.Flags().GetString("adjusted")
-	window, _ := cmd.Flags().GetString("window")
-	seriesType, _ := cmd.Flags().GetString("series-type")
-	order, _ := cmd.Flags().GetString("order")
-	limit, _ := cmd.Flags().GetString("limit")
-
-	return api.IndicatorParams{
-		TimestampGTE: from,
-		TimestampLTE: to,
-		Timespan:     timespan,
-		Adjusted:     adjusted,
-		Window:       window,
-		SeriesType:   seriesType,
-		Order:        order,
-		Limit:        limit,
-	}
-}
-
 // printOptionsIndicatorTable renders a formatted table of indicator values for
 // the options SMA, EMA, or RSI commands. Each row displays the date and
 // computed value.
 func printOptionsIndicatorTable(ticker, indicator string, result *api.IndicatorResponse) {
-	fmt.Printf("Ticker: %s | Indicator: %s | Values: %d\n\n", ticker, indicator, len(result.Results.Values))
+	printSummary("Ticker: %s | Indicator: %s | Values: %d", ticker, indicator, len(result.Results.Values))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "DATE\tVALUE")
-	fmt.Fprintln(w, "----\t-----")
+	writeHeader(w, "DATE\tVALUE", "----\t-----")
 
 	for _, v := range result.Results.Values {
-		t := time.UnixMilli(v.Timestamp)
-		fmt.Fprintf(w, "%s\t%.4f\n", t.Format("2006-01-02"), v.Value)
+		fmt.Fprintf(w, "%s\t%.4f\n", timestampCell(v.Timestamp, api.UnitMilliseconds, "2006-01-02"), v.Value)
 	}
 	w.Flush()
 }
@@ -213,16 +175,14 @@ func printOptionsIndicatorTable(ticker, indicator string, result *api.IndicatorR
 // including the MACD line, signal line, and histogram for each data point
 // of an options contract ticker.
 func printOptionsMACDTable(ticker string, result *api.MACDResponse) {
-	fmt.Printf("Ticker: %s | Indicator: MACD | Values: %d\n\n", ticker, len(result.Results.Values))
+	printSummary("Ticker: %s | Indicator: MACD | Values: %d", ticker, len(result.Results.Values))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "DATE\tMACD\tSIGNAL\tHISTOGRAM")
-	fmt.Fprintln(w, "----\t----\t------\t---------")
+	writeHeader(w, "DATE\tMACD\tSIGNAL\tHISTOGRAM", "----\t----\t------\t---------")
 
 	for _, v := range result.Results.Values {
-		t := time.UnixMilli(v.Timestamp)
 		fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\n",
-			t.Format("2006-01-02"), v.Value, v.Signal, v.Histogram)
+			timestampCell(v.Timestamp, api.UnitMilliseconds, "2006-01-02"), v.Value, v.Signal, v.Histogram)
 	}
 	w.Flush()
 }
@@ -238,10 +198,12 @@ func addOptionsIndicatorFlags(cmd *cobra.Command, defaultWindow string) {
 	cmd.Flags().String("window", defaultWindow, "Number of periods for the indicator calculation")
 	cmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	cmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	cmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(cmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(cmd)
 
 	cmd.MarkFlagRequired("from")
 	cmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cmd, "from", "to")
 }
 
 // init registers the SMA, EMA, RSI, and MACD indicator subcommands and their
@@ -269,10 +231,12 @@ func init() {
 	optionsMACDCmd.Flags().String("signal-window", "9", "Signal line EMA period")
 	optionsMACDCmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	optionsMACDCmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	optionsMACDCmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(optionsMACDCmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(optionsMACDCmd)
 
 	optionsMACDCmd.MarkFlagRequired("from")
 	optionsMACDCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(optionsMACDCmd, "from", "to")
 
 	optionsCmd.AddCommand(optionsMACDCmd)
 }
@@ -44,21 +44,18 @@ var optionsMarketStatusCmd = &cobra.Command{
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
-		fmt.Fprintln(w, "EXCHANGES")
-		fmt.Fprintln(w, "--------")
+		writeSection(w, "EXCHANGES")
 		fmt.Fprintf(w, "NYSE\t%s\n", result.Exchanges.NYSE)
 		fmt.Fprintf(w, "NASDAQ\t%s\n", result.Exchanges.Nasdaq)
 		fmt.Fprintf(w, "OTC\t%s\n", result.Exchanges.OTC)
 		fmt.Fprintln(w)
 
-		fmt.Fprintln(w, "CURRENCIES")
-		fmt.Fprintln(w, "----------")
+		writeSection(w, "CURRENCIES")
 		fmt.Fprintf(w, "Crypto\t%s\n", result.Currencies.Crypto)
 		fmt.Fprintf(w, "Forex\t%s\n", result.Currencies.FX)
 		fmt.Fprintln(w)
 
-		fmt.Fprintln(w, "INDICES GROUPS")
-		fmt.Fprintln(w, "--------------")
+		writeSection(w, "INDICES GROUPS")
 		fmt.Fprintf(w, "S&P\t%s\n", result.IndicesGroups.SAndP)
 		fmt.Fprintf(w, "Dow Jones\t%s\n", result.IndicesGroups.DowJones)
 		fmt.Fprintf(w, "NASDAQ\t%s\n", result.IndicesGroups.Nasdaq)
@@ -106,11 +103,10 @@ var optionsMarketHolidaysCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Upcoming Market Holidays: %d\n\n", len(result))
+		printSummary("Upcoming Market Holidays: %d", len(result))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tEXCHANGE\tNAME\tSTATUS\tOPEN\tCLOSE")
-		fmt.Fprintln(w, "----\t--------\t----\t------\t----\t-----")
+		writeHeader(w, "DATE\tEXCHANGE\tNAME\tSTATUS\tOPEN\tCLOSE", "----\t--------\t----\t------\t----\t-----")
 
 		for _, h := range result {
 			openTime := "-"
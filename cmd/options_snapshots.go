@@ -55,6 +55,7 @@ var optionsSnapshotsChainCmd = &cobra.Command{
 		expirationDateLT, _ := cmd.Flags().GetString("expiration-date-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitSnapshots, limit)
 		sort, _ := cmd.Flags().GetString("sort")
 
 		params := api.OptionsChainSnapshotParams{
@@ -88,11 +89,10 @@ var optionsSnapshotsChainCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Options Chain: %s (%d contracts)\n\n", underlying, len(result.Results))
+		printSummary("Options Chain: %s (%d contracts)", underlying, len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "CONTRACT\tTYPE\tSTRIKE\tEXPIRATION\tCLOSE\tVOLUME\tOI\tIV\tDELTA\tGAMMA\tTHETA\tVEGA")
-		fmt.Fprintln(w, "--------\t----\t------\t----------\t-----\t------\t--\t--\t-----\t-----\t-----\t----")
+		writeHeader(w, "CONTRACT\tTYPE\tSTRIKE\tEXPIRATION\tCLOSE\tVOLUME\tOI\tIV\tDELTA\tGAMMA\tTHETA\tVEGA", "--------\t----\t------\t----------\t-----\t------\t--\t--\t-----\t-----\t-----\t----")
 
 		for _, r := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%.2f\t%s\t%.2f\t%.0f\t%.0f\t%.4f\t%.4f\t%.4f\t%.4f\t%.4f\n",
@@ -145,15 +145,13 @@ var optionsSnapshotsContractCmd = &cobra.Command{
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
 		fmt.Fprintln(w, "-- Day Bar --")
-		fmt.Fprintln(w, "OPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tCHANGE\tCHANGE %")
-		fmt.Fprintln(w, "----\t----\t---\t-----\t------\t----\t------\t--------")
+		writeHeader(w, "OPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tCHANGE\tCHANGE %", "----\t----\t---\t-----\t------\t----\t------\t--------")
 		fmt.Fprintf(w, "%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%.4f\t%.2f%%\n\n",
 			r.Day.Open, r.Day.High, r.Day.Low, r.Day.Close,
 			r.Day.Volume, r.Day.VWAP, r.Day.Change, r.Day.ChangePercent)
 
 		fmt.Fprintln(w, "-- Greeks --")
-		fmt.Fprintln(w, "DELTA\tGAMMA\tTHETA\tVEGA")
-		fmt.Fprintln(w, "-----\t-----\t-----\t----")
+		writeHeader(w, "DELTA\tGAMMA\tTHETA\tVEGA", "-----\t-----\t-----\t----")
 		fmt.Fprintf(w, "%.4f\t%.4f\t%.4f\t%.4f\n",
 			r.Greeks.Delta, r.Greeks.Gamma, r.Greeks.Theta, r.Greeks.Vega)
 
@@ -178,7 +176,7 @@ func init() {
 	optionsSnapshotsChainCmd.Flags().String("expiration-date-lte", "", "Expiration date less than or equal to (YYYY-MM-DD)")
 	optionsSnapshotsChainCmd.Flags().String("expiration-date-lt", "", "Expiration date less than (YYYY-MM-DD)")
 	optionsSnapshotsChainCmd.Flags().String("order", "", "Sort direction for results (asc or desc)")
-	optionsSnapshotsChainCmd.Flags().String("limit", "", "Maximum number of results (default: 10, max: 250)")
+	addLimitFlag(optionsSnapshotsChainCmd, api.LimitSnapshots, "Maximum number of results")
 	optionsSnapshotsChainCmd.Flags().String("sort", "", "Field to sort results by")
 
 	optionsSnapshotsCmd.AddCommand(optionsSnapshotsChainCmd)
@@ -8,6 +8,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -33,13 +34,21 @@ var optionsTradesCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		timestamp, _ := cmd.Flags().GetString("timestamp")
-		timestampGte, _ := cmd.Flags().GetString("timestamp-gte")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
-		timestampLte, _ := cmd.Flags().GetString("timestamp-lte")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTrades, limit)
 		sort, _ := cmd.Flags().GetString("sort")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.OptionsTradesParams{
 			Timestamp:    timestamp,
@@ -50,6 +59,7 @@ var optionsTradesCmd = &cobra.Command{
 			Order:        order,
 			Limit:        limit,
 			Sort:         sort,
+			Cursor:       cursor,
 		}
 
 		result, err := client.GetOptionsTrades(ticker, params)
@@ -61,19 +71,29 @@ var optionsTradesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Options Ticker: %s | Trades: %d\n\n", ticker, len(result.Results))
+		printSummary("Options Ticker: %s | Trades: %d", ticker, len(result.Results))
 
+		legend, _ := cmd.Flags().GetBool("legend")
+		header, sep := legendColumns(legend, "TIMESTAMP\tPRICE\tSIZE\tEXCHANGE\tCORRECTION", "---------\t-----\t----\t--------\t----------")
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tPRICE\tSIZE\tEXCHANGE\tCORRECTION")
-		fmt.Fprintln(w, "---------\t-----\t----\t--------\t----------")
-
-		for _, trade := range result.Results {
-			t := time.Unix(0, trade.SipTimestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.0f\t%d\t%d\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				trade.Price, trade.Size, trade.Exchange, trade.Correction)
+		writeHeader(w, header, sep)
+
+		conditions := make([][]int, len(result.Results))
+		for i, trade := range result.Results {
+			row := fmt.Sprintf("%s\t%s\t%.0f\t%d\t%d",
+				timestampCell(trade.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", trade.Price), trade.Size, trade.Exchange, trade.Correction)
+			if legend {
+				row += "\t" + conditionsCell(trade.Conditions)
+			}
+			fmt.Fprintln(w, row)
+			conditions[i] = trade.Conditions
 		}
 		w.Flush()
+		if legend {
+			printConditionLegend(client, "options", conditionTrade, conditions)
+		}
+		printNextCursor(result.NextURL)
 
 		return nil
 	},
@@ -86,17 +106,20 @@ var optionsTradesCmd = &cobra.Command{
 var optionsLastTradeCmd = &cobra.Command{
 	Use:   "last-trade [optionsTicker]",
 	Short: "Get the most recent trade for an options contract",
-	Long:  "Retrieve the last available trade for an options contract including price, size, exchange, and timestamp.",
+	Long:  "Retrieve the last available trade for an options contract including price, size, exchange, and timestamp. The contract must be an OCC option symbol (O:<root><YYMMDD><C|P><strike x1000>); the O: prefix may be omitted.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := newClient()
+		symbol, err := api.ParseOptionSymbol(args[0])
 		if err != nil {
 			return err
 		}
 
-		ticker := strings.ToUpper(args[0])
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
 
-		result, err := client.GetOptionsLastTrade(ticker)
+		result, err := client.GetOptionsLastTrade(symbol.Ticker())
 		if err != nil {
 			return err
 		}
@@ -106,15 +129,15 @@ var optionsLastTradeCmd = &cobra.Command{
 		}
 
 		trade := result.Results
-		t := time.Unix(0, trade.SipTimestamp)
 
 		fmt.Printf("Ticker:    %s\n", trade.Ticker)
-		fmt.Printf("Price:     $%.4f\n", trade.Price)
+		fmt.Printf("Contract:  %s\n", optionContractLabel(symbol))
+		fmt.Printf("Price:     $%s\n", priceCell("%.4f", trade.Price))
 		fmt.Printf("Size:      %.0f\n", trade.Size)
 		fmt.Printf("Exchange:  %d\n", trade.Exchange)
 		fmt.Printf("Tape:      %d\n", trade.Tape)
 		fmt.Printf("Trade ID:  %s\n", trade.ID)
-		fmt.Printf("Timestamp: %s\n", t.Format("2006-01-02 15:04:05.000"))
+		fmt.Printf("Timestamp: %s\n", timestampCell(trade.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
 
 		return nil
 	},
@@ -137,13 +160,21 @@ var optionsQuotesCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		timestamp, _ := cmd.Flags().GetString("timestamp")
-		timestampGte, _ := cmd.Flags().GetString("timestamp-gte")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
-		timestampLte, _ := cmd.Flags().GetString("timestamp-lte")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitQuotes, limit)
 		sort, _ := cmd.Flags().GetString("sort")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.OptionsQuotesParams{
 			Timestamp:    timestamp,
@@ -154,6 +185,7 @@ var optionsQuotesCmd = &cobra.Command{
 			Order:        order,
 			Limit:        limit,
 			Sort:         sort,
+			Cursor:       cursor,
 		}
 
 		result, err := client.GetOptionsQuotes(ticker, params)
@@ -165,21 +197,20 @@ var optionsQuotesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Options Ticker: %s | Quotes: %d\n\n", ticker, len(result.Results))
+		printSummary("Options Ticker: %s | Quotes: %d", ticker, len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tBID PRICE\tBID SIZE\tASK PRICE\tASK SIZE\tBID EX\tASK EX")
-		fmt.Fprintln(w, "---------\t---------\t--------\t---------\t--------\t------\t------")
+		writeHeader(w, "TIMESTAMP\tBID PRICE\tBID SIZE\tASK PRICE\tASK SIZE\tBID EX\tASK EX", "---------\t---------\t--------\t---------\t--------\t------\t------")
 
 		for _, quote := range result.Results {
-			t := time.Unix(0, quote.SipTimestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.0f\t%.4f\t%.0f\t%d\t%d\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				quote.BidPrice, quote.BidSize,
-				quote.AskPrice, quote.AskSize,
+			fmt.Fprintf(w, "%s\t%s\t%.0f\t%s\t%.0f\t%d\t%d\n",
+				timestampCell(quote.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", quote.BidPrice), quote.BidSize,
+				priceCell("%.4f", quote.AskPrice), quote.AskSize,
 				quote.BidExchange, quote.AskExchange)
 		}
 		w.Flush()
+		printNextCursor(result.NextURL)
 
 		return nil
 	},
@@ -192,17 +223,20 @@ var optionsQuotesCmd = &cobra.Command{
 var optionsLastQuoteCmd = &cobra.Command{
 	Use:   "last-quote [optionsTicker]",
 	Short: "Get the most recent NBBO quote for an options contract",
-	Long:  "Retrieve the last available NBBO (National Best Bid and Offer) quote for an options contract including bid/ask prices, sizes, and exchange information.",
+	Long:  "Retrieve the last available NBBO (National Best Bid and Offer) quote for an options contract including bid/ask prices, sizes, and exchange information. The contract must be an OCC option symbol (O:<root><YYMMDD><C|P><strike x1000>); the O: prefix may be omitted.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := newClient()
+		symbol, err := api.ParseOptionSymbol(args[0])
 		if err != nil {
 			return err
 		}
 
-		ticker := strings.ToUpper(args[0])
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
 
-		result, err := client.GetOptionsLastQuote(ticker)
+		result, err := client.GetOptionsLastQuote(symbol.Ticker())
 		if err != nil {
 			return err
 		}
@@ -212,22 +246,28 @@ var optionsLastQuoteCmd = &cobra.Command{
 		}
 
 		quote := result.Results
-		t := time.Unix(0, quote.SipTimestamp)
 
 		fmt.Printf("Ticker:       %s\n", quote.Ticker)
-		fmt.Printf("Bid Price:    $%.4f\n", quote.BidPrice)
+		fmt.Printf("Contract:     %s\n", optionContractLabel(symbol))
+		fmt.Printf("Bid Price:    $%s\n", priceCell("%.4f", quote.BidPrice))
 		fmt.Printf("Bid Size:     %d\n", quote.BidSize)
 		fmt.Printf("Bid Exchange: %d\n", quote.BidExchange)
-		fmt.Printf("Ask Price:    $%.4f\n", quote.AskPrice)
+		fmt.Printf("Ask Price:    $%s\n", priceCell("%.4f", quote.AskPrice))
 		fmt.Printf("Ask Size:     %d\n", quote.AskSize)
 		fmt.Printf("Ask Exchange: %d\n", quote.AskExchange)
 		fmt.Printf("Tape:         %d\n", quote.Tape)
-		fmt.Printf("Timestamp:    %s\n", t.Format("2006-01-02 15:04:05.000"))
+		fmt.Printf("Timestamp:    %s\n", timestampCell(quote.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
 
 		return nil
 	},
 }
 
+// optionContractLabel describes a parsed option symbol for humans, e.g.
+// "AAPL 2025-06-20 150 call".
+func optionContractLabel(s *api.OptionSymbol) string {
+	return fmt.Sprintf("%s %s %s %s", s.Root, s.Expiration.Format("2006-01-02"), strconv.FormatFloat(s.Strike, 'f', -1, 64), s.Type)
+}
+
 // init registers the options trades, last-trade, quotes, and last-quote commands
 // and their flags under the options parent command.
 func init() {
@@ -237,9 +277,12 @@ func init() {
 	optionsTradesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
 	optionsTradesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
 	optionsTradesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(optionsTradesCmd, "timestamp-gte", "timestamp-lte")
 	optionsTradesCmd.Flags().String("order", "", "Sort order (asc/desc)")
-	optionsTradesCmd.Flags().String("limit", "1000", "Max number of results (max 50000)")
+	addLimitFlag(optionsTradesCmd, api.LimitTrades, "Max number of results")
 	optionsTradesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	optionsTradesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+	addLegendFlag(optionsTradesCmd)
 
 	// Quotes command flags
 	optionsQuotesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD) or nanosecond timestamp")
@@ -247,9 +290,11 @@ func init() {
 	optionsQuotesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
 	optionsQuotesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
 	optionsQuotesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(optionsQuotesCmd, "timestamp-gte", "timestamp-lte")
 	optionsQuotesCmd.Flags().String("order", "", "Sort order (asc/desc)")
-	optionsQuotesCmd.Flags().String("limit", "1000", "Max number of results (max 50000)")
+	addLimitFlag(optionsQuotesCmd, api.LimitQuotes, "Max number of results")
 	optionsQuotesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	optionsQuotesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
 
 	// Register all four commands under the options parent
 	optionsCmd.AddCommand(optionsTradesCmd)
@@ -0,0 +1,141 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/parquet"
+	"github.com/spf13/cobra"
+)
+
+// parquetFormat is the --output value that writes bars to a Parquet file
+// named by --out instead of printing them.
+const parquetFormat = "parquet"
+
+// parquetAnnotation marks commands that support parquet output. Other
+// commands reject the format instead of silently printing a table.
+const parquetAnnotation = "parquet"
+
+// supportsParquet marks cmd as able to write parquet output and registers
+// the --out flag naming the destination file.
+func supportsParquet(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[parquetAnnotation] = "true"
+	cmd.Flags().String("out", "", "File to write with --output parquet")
+}
+
+// checkParquetFlags validates --output parquet and --out before a command
+// runs: the format needs a supporting command and a destination file, and
+// --out means nothing without the format.
+func checkParquetFlags(cmd *cobra.Command) error {
+	if cmd.Annotations[parquetAnnotation] == "" {
+		if outputFormat == parquetFormat {
+			return fmt.Errorf("--output %s is only supported by bars commands", parquetFormat)
+		}
+		return nil
+	}
+
+	out, _ := cmd.Flags().GetString("out")
+	if outputFormat == parquetFormat && out == "" {
+		return fmt.Errorf("--output %s requires --out <file>", parquetFormat)
+	}
+	if outputFormat != parquetFormat && out != "" {
+		return fmt.Errorf("--out is only used with --output %s", parquetFormat)
+	}
+
+	return nil
+}
+
+// parquetBar is one row of a bars Parquet file. Timestamp is in epoch
+// milliseconds.
+type parquetBar struct {
+	Timestamp int64
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	Volume    float64
+	VWAP      float64
+}
+
+// parquetBarsFromAggs converts aggregate bars to Parquet rows.
+func parquetBarsFromAggs(bars []api.Bar) []parquetBar {
+	out := make([]parquetBar, len(bars))
+	for i, b := range bars {
+		out[i] = parquetBar{b.Timestamp, b.Open, b.High, b.Low, b.Close, b.Volume, b.VWAP}
+	}
+	return out
+}
+
+// parquetBarsFromOptions converts options aggregate bars to Parquet rows.
+func parquetBarsFromOptions(bars []api.OptionsBar) []parquetBar {
+	out := make([]parquetBar, len(bars))
+	for i, b := range bars {
+		out[i] = parquetBar{b.Timestamp, b.Open, b.High, b.Low, b.Close, b.Volume, b.VWAP}
+	}
+	return out
+}
+
+// parquetBarsFromFutures converts futures bars, whose window start is in
+// nanoseconds, to Parquet rows. Futures bars carry no VWAP, so it is
+// derived from dollar volume over volume.
+func parquetBarsFromFutures(bars []api.FuturesBar) []parquetBar {
+	out := make([]parquetBar, len(bars))
+	for i, b := range bars {
+		var vwap float64
+		if b.Volume != 0 {
+			vwap = b.DollarVolume / b.Volume
+		}
+		out[i] = parquetBar{b.WindowStart / 1e6, b.Open, b.High, b.Low, b.Close, b.Volume, vwap}
+	}
+	return out
+}
+
+// writeBarsParquet writes bars to the --out file as typed columns:
+// a millisecond timestamp and double open, high, low, close, volume, and
+// vwap. Rows keep the order the API returned them in.
+func writeBarsParquet(cmd *cobra.Command, bars []parquetBar) error {
+	path, _ := cmd.Flags().GetString("out")
+
+	columns := []parquet.Column{
+		{Name: "timestamp", Type: parquet.TimestampMillis, Int64s: make([]int64, len(bars))},
+		{Name: "open", Type: parquet.Double, Doubles: make([]float64, len(bars))},
+		{Name: "high", Type: parquet.Double, Doubles: make([]float64, len(bars))},
+		{Name: "low", Type: parquet.Double, Doubles: make([]float64, len(bars))},
+		{Name: "close", Type: parquet.Double, Doubles: make([]float64, len(bars))},
+		{Name: "volume", Type: parquet.Double, Doubles: make([]float64, len(bars))},
+		{Name: "vwap", Type: parquet.Double, Doubles: make([]float64, len(bars))},
+	}
+	for i, b := range bars {
+		columns[0].Int64s[i] = b.Timestamp
+		columns[1].Doubles[i] = b.Open
+		columns[2].Doubles[i] = b.High
+		columns[3].Doubles[i] = b.Low
+		columns[4].Doubles[i] = b.Close
+		columns[5].Doubles[i] = b.Volume
+		columns[6].Doubles[i] = b.VWAP
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create parquet file: %w", err)
+	}
+	if err := parquet.Write(f, columns); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write parquet file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write parquet file: %w", err)
+	}
+
+	infof("Wrote %d bars to %s", len(bars), path)
+	return nil
+}
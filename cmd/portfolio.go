@@ -0,0 +1,168 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cloudmanic/massive-cli/internal/analytics"
+	"github.com/spf13/cobra"
+)
+
+// portfolioValuation is the JSON output of the portfolio value command:
+// every valued position plus the portfolio totals.
+type portfolioValuation struct {
+	Positions []portfolioPosition       `json:"positions"`
+	Totals    analytics.PortfolioTotals `json:"totals"`
+}
+
+// portfolioPosition is one holding in the JSON output. Status carries the
+// market status for priced tickers or the reason a ticker could not be
+// priced.
+type portfolioPosition struct {
+	analytics.Position
+	Status string `json:"status"`
+}
+
+// portfolioCmd is the parent command for portfolio tools that combine
+// market data across a set of holdings.
+var portfolioCmd = &cobra.Command{
+	Use:   "portfolio",
+	Short: "Portfolio valuation tools",
+	Long:  "Tools that value a set of holdings across stocks, options, indices, forex, and crypto using live snapshot data.",
+}
+
+// portfolioValueCmd values a holdings file using the unified snapshot
+// endpoint. Tickers keep their usual prefixes, so a single file can mix
+// asset classes. Tickers the API cannot price are listed with "-" and
+// excluded from the totals.
+// Usage: massive portfolio value --file holdings.csv
+var portfolioValueCmd = &cobra.Command{
+	Use:   "value",
+	Short: "Value a CSV of holdings with per-position weight and day change",
+	Long:  "Read a CSV of ticker,quantity rows, fetch the latest snapshot price for each ticker across asset classes, and show each position's value, portfolio weight, and day change along with portfolio totals.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, _ := cmd.Flags().GetString("file")
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+
+		in := cmd.InOrStdin()
+		if file != "-" {
+			f, err := os.Open(file)
+			if err != nil {
+				return fmt.Errorf("failed to open holdings file: %w", err)
+			}
+			defer f.Close()
+			in = f
+		}
+
+		holdings, err := analytics.ReadHoldings(in)
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		// Request each ticker once even if it appears on several lines.
+		seen := make(map[string]bool)
+		var tickers []string
+		for _, h := range holdings {
+			if !seen[h.Ticker] {
+				seen[h.Ticker] = true
+				tickers = append(tickers, h.Ticker)
+			}
+		}
+
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		snapshots, err := fetchUnifiedSnapshots(client, tickers, concurrency)
+		if err != nil {
+			return err
+		}
+
+		quotes := make(map[string]analytics.PositionQuote)
+		statuses := make(map[string]string)
+		for _, s := range snapshots.Results {
+			if s.Error != "" {
+				statuses[s.Ticker] = s.Error
+				continue
+			}
+
+			price := s.Price()
+			if price == 0 {
+				statuses[s.Ticker] = "NO_PRICE"
+				continue
+			}
+
+			var change float64
+			if s.Session != nil {
+				change = s.Session.Change
+			}
+
+			quotes[s.Ticker] = analytics.PositionQuote{Price: price, Change: change}
+			statuses[s.Ticker] = s.MarketStatus
+		}
+
+		positions, totals := analytics.ValuePortfolio(holdings, quotes)
+
+		if outputFormat == "json" {
+			out := portfolioValuation{Totals: totals}
+			for _, p := range positions {
+				status, ok := statuses[p.Ticker]
+				if !ok {
+					status = "NOT_FOUND"
+				}
+				out.Positions = append(out.Positions, portfolioPosition{Position: p, Status: status})
+			}
+			return printJSON(out)
+		}
+
+		printSummary("Positions: %d", len(positions))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "TICKER\tQUANTITY\tPRICE\tVALUE\tWEIGHT\tDAY CHANGE\tSTATUS", "------\t--------\t-----\t-----\t------\t----------\t------")
+
+		for _, p := range positions {
+			status, ok := statuses[p.Ticker]
+			if !ok {
+				status = "NOT_FOUND"
+			}
+
+			if !p.Found {
+				fmt.Fprintf(w, "%s\t%g\t-\t-\t-\t-\t%s\n", p.Ticker, p.Quantity, status)
+				continue
+			}
+
+			fmt.Fprintf(w, "%s\t%g\t%.4f\t%.2f\t%.2f%%\t%.2f\t%s\n",
+				p.Ticker, p.Quantity, p.Price, p.Value, p.Weight, p.DayChange, status)
+		}
+
+		fmt.Fprintf(w, "TOTAL\t\t\t%.2f\t100.00%%\t%.2f (%.2f%%)\t\n",
+			totals.Value, totals.DayChange, totals.DayChangePercent)
+		w.Flush()
+
+		if totals.Missing > 0 {
+			fmt.Printf("\n%d holding(s) could not be priced and are excluded from the totals.\n", totals.Missing)
+		}
+
+		return nil
+	},
+}
+
+// init registers the portfolio command group and its subcommands under the
+// root command.
+func init() {
+	portfolioValueCmd.Flags().String("file", "", "CSV file of ticker,quantity holdings, or - for stdin (required)")
+	portfolioValueCmd.Flags().Int("concurrency", 4, "Maximum parallel requests when the holdings span multiple batches")
+
+	portfolioCmd.AddCommand(portfolioValueCmd)
+	rootCmd.AddCommand(portfolioCmd)
+}
@@ -0,0 +1,171 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/analytics"
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// precisionFlag is the global --precision flag: a number of decimals,
+// auto, or tick (futures), overriding the asset class default.
+var precisionFlag string
+
+// pricePrecision is the price precision resolved for the running command
+// by resolvePrecision: empty for each table's own format, auto for
+// formatPrice, tick for futures tick alignment, or a number of decimals.
+var pricePrecision string
+
+// tickRound is the futures --tick-round flag, shorthand for --precision
+// tick.
+var tickRound bool
+
+// priceTick is the futures contract's trade tick size that priceCell
+// rounds to when pricePrecision is tick. Zero until applyFuturesTick
+// looks it up.
+var priceTick float64
+
+// assetClasses are the command groups that have a per-asset-class price
+// precision.
+var assetClasses = []string{"stocks", "options", "indices", "crypto", "forex", "futures"}
+
+// commandAssetClass returns the asset class a command belongs to, from
+// the nearest ancestor named after one (so ws crypto trades is crypto),
+// or an empty string for commands outside any asset class.
+func commandAssetClass(cmd *cobra.Command) string {
+	for c := cmd; c != nil; c = c.Parent() {
+		for _, class := range assetClasses {
+			if c.Name() == class {
+				return class
+			}
+		}
+	}
+	return ""
+}
+
+// resolvePrecision sets pricePrecision for cmd from --tick-round or
+// --smart-precision, then --precision, then the asset class default in the
+// config file or config.DefaultPrecision. The flags cannot be combined.
+func resolvePrecision(cmd *cobra.Command) error {
+	assetClass := commandAssetClass(cmd)
+
+	switch {
+	case tickRound && (smartPrecision || precisionFlag != ""):
+		return fmt.Errorf("--tick-round cannot be combined with --precision or --smart-precision")
+	case tickRound:
+		pricePrecision = config.PrecisionTick
+	case smartPrecision && precisionFlag != "":
+		return fmt.Errorf("--smart-precision and --precision cannot be used together")
+	case smartPrecision:
+		pricePrecision = config.PrecisionAuto
+	case precisionFlag != "":
+		value := strings.ToLower(strings.TrimSpace(precisionFlag))
+		if err := config.ValidatePrecision(assetClass, value); err != nil {
+			return fmt.Errorf("--precision: %w", err)
+		}
+		pricePrecision = value
+	case assetClass != "":
+		value, err := config.GetPrecision(assetClass)
+		if err != nil {
+			return err
+		}
+		pricePrecision = value
+	}
+
+	return nil
+}
+
+// applyFuturesTick looks up ticker's trade tick size for priceCell when
+// the futures precision is tick. Only table output needs it, so JSON skips
+// the extra request. A failed lookup or a contract without a tick size
+// warns and falls back to the table's own format.
+func applyFuturesTick(client *api.Client, ticker string) {
+	if !tickPrecision() {
+		return
+	}
+
+	if tick, ok := lookupTickSize(client, ticker); ok {
+		priceTick = tick
+	}
+}
+
+// futuresTickSizes looks up the trade tick size of each ticker, for
+// tables that mix contracts and set priceTick per row. It returns nil
+// unless the futures precision is tick and the output is a table; tickers
+// whose lookup fails are missing from the map, so their rows fall back to
+// the table's own format.
+func futuresTickSizes(client *api.Client, tickers []string) map[string]float64 {
+	if !tickPrecision() {
+		return nil
+	}
+
+	ticks := make([]float64, len(tickers))
+	fetcher := api.NewAdaptiveFetcher(client, tickLookupConcurrency)
+	_ = fetcher.Run(len(tickers), func(i int) error {
+		ticks[i], _ = lookupTickSize(client, tickers[i])
+		return nil
+	})
+
+	sizes := make(map[string]float64, len(tickers))
+	for i, ticker := range tickers {
+		if ticks[i] > 0 {
+			sizes[ticker] = ticks[i]
+		}
+	}
+	return sizes
+}
+
+// tickLookupConcurrency is the most contract lookups futuresTickSizes
+// runs in parallel.
+const tickLookupConcurrency = 4
+
+// tickPrecision reports whether prices are rounded to the contract tick
+// in this run's output.
+func tickPrecision() bool {
+	return pricePrecision == config.PrecisionTick && outputFormat != "json"
+}
+
+// lookupTickSize fetches ticker's trade tick size from its contract,
+// warning and reporting false when the lookup fails or the contract has
+// none.
+func lookupTickSize(client *api.Client, ticker string) (float64, bool) {
+	result, err := client.GetFuturesContracts(api.FuturesContractsParams{Ticker: ticker, Limit: "1"})
+	if err != nil {
+		warnf("could not look up the tick size for %s: %v", ticker, err)
+		return 0, false
+	}
+	if len(result.Results) == 0 || result.Results[0].TradeTickSize <= 0 {
+		warnf("no trade tick size found for %s; showing prices unrounded", ticker)
+		return 0, false
+	}
+
+	return result.Results[0].TradeTickSize, true
+}
+
+// formatWithPrecision renders a price under the resolved precision, and
+// reports false when the table's own format applies instead.
+func formatWithPrecision(f float64) (string, bool) {
+	switch pricePrecision {
+	case "":
+		return "", false
+	case config.PrecisionAuto:
+		return formatPrice(f), true
+	case config.PrecisionTick:
+		if priceTick <= 0 {
+			return "", false
+		}
+		return strconv.FormatFloat(analytics.RoundToTick(f, priceTick), 'f', analytics.TickDecimals(priceTick), 64), true
+	}
+
+	decimals, _ := strconv.Atoi(pricePrecision)
+	return strconv.FormatFloat(f, 'f', decimals, 64), true
+}
@@ -0,0 +1,380 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// referenceCmd is the parent command for reference data that is shared
+// across asset classes, such as ticker type codes.
+var referenceCmd = &cobra.Command{
+	Use:   "reference",
+	Short: "Reference data shared across asset classes",
+}
+
+// referenceTickerTypesCmd lists the ticker type codes (CS, ETF, ADRC, etc.)
+// with their descriptions. Useful for decoding the type column returned by
+// the tickers commands.
+// Usage: massive reference ticker-types --asset-class stocks
+var referenceTickerTypesCmd = &cobra.Command{
+	Use:   "ticker-types",
+	Short: "List ticker type codes and descriptions",
+	Long:  "Retrieve the list of ticker type codes (such as CS, ETF, and ADRC) with their descriptions, optionally filtered by asset class and locale.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		assetClass, _ := cmd.Flags().GetString("asset-class")
+		locale, _ := cmd.Flags().GetString("locale")
+
+		result, err := client.GetTickerTypes(assetClass, locale)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		printSummary("Ticker Types: %d", result.Count)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "CODE\tDESCRIPTION\tASSET CLASS\tLOCALE", "----\t-----------\t-----------\t------")
+
+		for _, tt := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				tt.Code, tt.Description, tt.AssetClass, tt.Locale)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// referenceExchangesCmd lists the exchanges of any asset class from one
+// place, dispatching futures to the futures endpoint and every other class
+// to the shared reference endpoint. Without --asset-class it lists them all.
+// Usage: massive reference exchanges --asset-class crypto
+var referenceExchangesCmd = &cobra.Command{
+	Use:   "exchanges",
+	Short: "List exchanges for any asset class",
+	Long:  "Retrieve the known exchanges for an asset class (stocks, options, crypto, fx, or futures) in a common table with identifiers, MIC codes, and locale. Without --asset-class the exchanges of every class are listed.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		assetClass, _ := cmd.Flags().GetString("asset-class")
+
+		result, err := client.GetAssetClassExchanges(assetClass)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		printExchangesTable(result)
+		return nil
+	},
+}
+
+// Condition data types: trades, exchange quotes (bbo), and the national
+// best bid and offer quotes the quotes endpoints return (nbbo).
+const (
+	conditionTrade = "trade"
+	conditionBBO   = "bbo"
+	conditionNBBO  = "nbbo"
+)
+
+// conditionAssetClasses and conditionDataTypes are the values the
+// conditions endpoint accepts for its asset_class and data_type filters.
+var conditionAssetClasses = []string{"stocks", "options", "crypto", "fx"}
+var conditionDataTypes = []string{conditionTrade, conditionBBO, conditionNBBO}
+
+// referenceConditionsCmd lists the trade and quote condition codes of any
+// asset class, optionally narrowed to the codes that apply to one data
+// type. Without filters it lists every code.
+// Usage: massive reference conditions --asset-class crypto --data-type trade
+var referenceConditionsCmd = &cobra.Command{
+	Use:   "conditions",
+	Short: "List trade and quote condition codes",
+	Long:  "Retrieve the condition codes that describe trades and quotes (such as odd lot or average price trades), filtered by asset class (stocks, options, crypto, fx) and data type (trade, bbo, nbbo). Without filters every code is listed.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		assetClass, _ := cmd.Flags().GetString("asset-class")
+		dataType, _ := cmd.Flags().GetString("data-type")
+		assetClass = strings.ToLower(strings.TrimSpace(assetClass))
+		dataType = strings.ToLower(strings.TrimSpace(dataType))
+		if assetClass != "" && !slices.Contains(conditionAssetClasses, assetClass) {
+			return fmt.Errorf("unknown asset class %q: must be one of %s", assetClass, strings.Join(conditionAssetClasses, ", "))
+		}
+		if dataType != "" && !slices.Contains(conditionDataTypes, dataType) {
+			return fmt.Errorf("unknown data type %q: must be one of %s", dataType, strings.Join(conditionDataTypes, ", "))
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		result, err := client.GetConditions(api.ConditionsParams{AssetClass: assetClass, DataType: dataType})
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		printConditionsTable(result)
+		return nil
+	},
+}
+
+// printConditionsTable renders condition codes as a table of ID, name,
+// type, asset class, and the data types each applies to, preceded by the
+// count summary.
+func printConditionsTable(result *api.ConditionsResponse) {
+	printSummary("Conditions: %d", result.Count)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "ID\tNAME\tTYPE\tASSET CLASS\tDATA TYPES", "--\t----\t----\t-----------\t----------")
+
+	for _, c := range result.Results {
+		dataTypes := strings.Join(c.DataTypes, ", ")
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			c.ID, c.Name, c.Type, c.AssetClass, dataTypes)
+	}
+	w.Flush()
+}
+
+// printExchangesTable renders exchanges as a table of ID, name, acronym,
+// MIC, type, asset class, and locale, preceded by the count summary.
+// Missing acronyms and MICs show as "-".
+func printExchangesTable(result *api.ExchangesResponse) {
+	printSummary("Exchanges: %d", result.Count)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeHeader(w, "ID\tNAME\tACRONYM\tMIC\tTYPE\tASSET CLASS\tLOCALE", "--\t----\t-------\t---\t----\t-----------\t------")
+
+	for _, e := range result.Results {
+		acronym := e.Acronym
+		if acronym == "" {
+			acronym = "-"
+		}
+		mic := e.MIC
+		if mic == "" {
+			mic = "-"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			e.ID, e.Name, acronym, mic, e.Type, e.AssetClass, e.Locale)
+	}
+	w.Flush()
+}
+
+// referenceSearchCmd searches tickers by name or symbol across every
+// market at once, so a name can be found without knowing whether it is a
+// stock, crypto pair, currency, or index. Unlike the per-asset tickers
+// commands no market filter is sent.
+// Usage: massive reference search "apple"
+var referenceSearchCmd = &cobra.Command{
+	Use:   "search [term]",
+	Short: "Search tickers across all markets",
+	Long:  "Search reference tickers by name or symbol across stocks, OTC, crypto, forex, and indices in a single query. Each result shows the market it belongs to.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		active, _ := cmd.Flags().GetString("active")
+		locale, _ := cmd.Flags().GetString("locale")
+		date, _ := cmd.Flags().GetString("date")
+		includeOTC, _ := cmd.Flags().GetBool("include-otc")
+		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTickers, limit)
+		all, _ := cmd.Flags().GetBool("all")
+
+		params := api.TickerParams{
+			Search: args[0],
+			Active: active,
+			Locale: locale,
+			Date:   date,
+			Limit:  limit,
+		}
+		if includeOTC {
+			params.IncludeOTC = "true"
+		}
+
+		fetcher := api.NewAdaptiveFetcher(client, 1)
+
+		var result *api.TickersResponse
+		err = fetcher.Do(func() error {
+			var err error
+			result, err = client.GetTickers(params)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		// Follow next_url until the last page when --all is set, backing
+		// off whenever the API reports the rate limit is exhausted.
+		for all && result.NextURL != "" {
+			var page *api.TickersResponse
+			err = fetcher.Do(func() error {
+				var err error
+				page, err = client.GetTickersNext(result.NextURL)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			result.Results = append(result.Results, page.Results...)
+			result.NextURL = page.NextURL
+		}
+		result.Count = len(result.Results)
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		printSummary("Results: %d", result.Count)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "TICKER\tNAME\tMARKET\tTYPE\tEXCHANGE\tACTIVE", "------\t----\t------\t----\t--------\t------")
+
+		for _, t := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n",
+				t.Ticker, truncateString(t.Name, 40), t.Market, t.Type, t.PrimaryExchange, t.Active)
+		}
+		w.Flush()
+
+		if result.NextURL != "" {
+			fmt.Println("\nMore results available. Increase --limit or use --all.")
+		}
+
+		return nil
+	},
+}
+
+// referenceSyncTickersCmd downloads the full list of active tickers for a
+// market into the local ticker index used by shell completion. A fresh
+// index is left alone unless --force is given.
+// Usage: massive reference sync-tickers --market crypto
+var referenceSyncTickersCmd = &cobra.Command{
+	Use:   "sync-tickers",
+	Short: "Download the ticker list for a market into the local cache",
+	Long:  "Pull every active ticker for a market (stocks, otc, crypto, fx, or indices) into a local index that shell completion reads offline. The index is refreshed when older than 24 hours or when --force is given.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		market, _ := cmd.Flags().GetString("market")
+		force, _ := cmd.Flags().GetBool("force")
+
+		dir, err := config.CacheDir()
+		if err != nil {
+			return err
+		}
+
+		ix, refreshed, err := syncTickerIndex(client, dir, market, force)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(map[string]interface{}{
+				"market":     ix.Market,
+				"count":      len(ix.Tickers),
+				"fetched_at": ix.FetchedAt,
+				"refreshed":  refreshed,
+				"directory":  dir,
+			})
+		}
+
+		status := "Cache is fresh"
+		if refreshed {
+			status = "Synced"
+		}
+
+		fmt.Printf("%s: %d %s tickers, age %s (%s)\n",
+			status, len(ix.Tickers), ix.Market, ix.Age().Round(time.Second), dir)
+
+		return nil
+	},
+}
+
+// syncTickerIndex returns market's ticker index from dir, pulling a new
+// one first when it is missing, older than api.TickerCacheTTL, or force is
+// set. refreshed reports whether the index was pulled.
+func syncTickerIndex(client *api.Client, dir, market string, force bool) (ix *api.TickerIndex, refreshed bool, err error) {
+	ix, err = api.LoadTickerIndex(dir, market)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, false, err
+	}
+
+	if force || ix == nil || !ix.Fresh(api.TickerCacheTTL) {
+		ix, err = client.RefreshTickerCache(market)
+		if err != nil {
+			return nil, false, err
+		}
+		refreshed = true
+	}
+
+	return ix, refreshed, nil
+}
+
+// init registers the reference command and its subcommands under the
+// root command.
+func init() {
+	referenceTickerTypesCmd.Flags().String("asset-class", "", "Filter by asset class (stocks, options, crypto, fx, indices)")
+	referenceTickerTypesCmd.Flags().String("locale", "", "Filter by locale (us, global)")
+	referenceCmd.AddCommand(referenceTickerTypesCmd)
+
+	referenceExchangesCmd.Flags().String("asset-class", "", "Asset class to list (stocks, options, crypto, fx, futures); empty for all")
+	referenceCmd.AddCommand(referenceExchangesCmd)
+
+	referenceConditionsCmd.Flags().String("asset-class", "", "Filter by asset class (stocks, options, crypto, fx)")
+	referenceConditionsCmd.Flags().String("data-type", "", "Filter by data type (trade, bbo, nbbo)")
+	referenceCmd.AddCommand(referenceConditionsCmd)
+
+	referenceSearchCmd.Flags().String("active", "true", "Filter by active status (true, false, or all for both)")
+	referenceSearchCmd.Flags().String("locale", "", "Filter by locale (us for US markets, global for crypto and forex)")
+	referenceSearchCmd.Flags().String("date", "", "Search tickers as of a past date (YYYY-MM-DD)")
+	referenceSearchCmd.Flags().Bool("include-otc", false, "Include OTC securities in the results")
+	addLimitFlag(referenceSearchCmd, api.LimitTickers, "Number of results per page")
+	referenceSearchCmd.Flags().Bool("all", false, "Follow next_url pagination and fetch every page")
+	referenceCmd.AddCommand(referenceSearchCmd)
+
+	referenceSyncTickersCmd.Flags().String("market", "stocks", "Market to sync (stocks, otc, crypto, fx, indices)")
+	referenceSyncTickersCmd.Flags().Bool("force", false, "Refresh even if the cached list is still fresh")
+	referenceCmd.AddCommand(referenceSyncTickersCmd)
+
+	rootCmd.AddCommand(referenceCmd)
+}
@@ -6,19 +6,94 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/cloudmanic/massive-cli/internal/version"
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
 )
 
+// outputFormat selects table, JSON, or a command-specific format such as
+// parquet or csv. Set via the global --output flag; when the flag is not
+// given, MASSIVE_OUTPUT or the config file's output value supplies the
+// default (see config.GetOutputFormat).
 var outputFormat string
 
-// version is the current version of the CLI, injected at build time
-// via -ldflags "-X github.com/cloudmanic/massive-cli/cmd.version=vX.Y.Z".
-// Defaults to "dev" for local development builds.
-var version = "dev"
+// noHeader suppresses the summary line, column header, and dashed
+// separator rows in table output. Set via the global --no-header flag.
+var noHeader bool
+
+// compactJSON prints JSON output on a single line without indentation.
+// Set via the global --compact flag.
+var compactJSON bool
+
+// resultsOnly makes JSON output drop the API envelope (status,
+// request_id, next_url, ...) and print only the results payload. Set via
+// the global --results-only flag.
+var resultsOnly bool
+
+// withMeta wraps JSON output in {"meta": ..., "data": ...}, where meta
+// holds the status code, request_id, fetch time, and timing of the most
+// recent response. Set via the global --with-meta flag.
+var withMeta bool
+
+// archiveDir is the directory where raw API responses are saved for
+// auditing. Set via the global --archive-dir flag; empty disables it.
+var archiveDir string
+
+// printRequest makes commands print the HTTP request URL they would send
+// instead of executing it. Set via the hidden --print-request flag.
+var printRequest bool
+
+// dryRun makes commands build and validate their params, then print "OK"
+// with the resolved request instead of sending it. Set via the global
+// --dry-run flag so CI can lint command invocations without API calls.
+var dryRun bool
+
+// authMode selects how the API key is attached to requests (query,
+// bearer, or header) and authHeader names the header used in header mode.
+// Set via the global --auth-mode and --auth-header flags for gateways that
+// expect a different scheme than Massive's apiKey query parameter.
+var authMode string
+var authHeader string
+
+// userAgent is the User-Agent header sent with every API request. It
+// defaults to massive-cli/<version> and is set via the global --user-agent
+// flag so automated jobs can tag their traffic.
+var userAgent string
+
+// noCompression disables gzip-compressed responses so raw bytes can be
+// inspected while debugging. Set via the global --no-compression flag.
+var noCompression bool
+
+// followRedirects and maxRedirects set the client's redirect policy via
+// the global --follow-redirects and --max-redirects flags.
+var followRedirects bool
+var maxRedirects int
+
+// redirectHosts are extra hosts followed redirects may carry the API key
+// to, set via the repeatable global --redirect-host flag.
+var redirectHosts []string
+
+// smartPrecision renders prices with decimals chosen by magnitude and
+// trailing zeros trimmed (see formatPrice) instead of each table's fixed
+// precision. Set via the global --smart-precision flag.
+var smartPrecision bool
+
+// rawTimestamps prints integer timestamps in tables as the API's epoch
+// values instead of formatted local dates (see timestampCell). Set via the
+// global --raw-timestamps flag.
+var rawTimestamps bool
+
+// quiet suppresses everything but the data itself: the summary lines above
+// tables, resume-cursor hints, warnings, and status messages on stderr.
+// Set via the global --quiet flag.
+var quiet bool
 
 // rootCmd is the base command for the Massive CLI. All subcommands
 // are registered as children of this command.
@@ -26,24 +101,121 @@ var rootCmd = &cobra.Command{
 	Use:     "massive",
 	Short:   "CLI for the Massive financial data API",
 	Long:    "A command-line interface for interacting with the Massive API to access stocks, crypto, forex, and other financial data.",
-	Version: version,
+	Version: version.Version,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("output") {
+			format, err := config.GetOutputFormat()
+			if err != nil {
+				return err
+			}
+			if format != "" {
+				outputFormat = format
+			}
+		}
+		if printRequest || dryRun || explain || prettyErrors {
+			cmd.Root().SilenceErrors = true
+			cmd.Root().SilenceUsage = true
+		}
+		if explain && cmd.Annotations[explainAnnotation] == "" {
+			return fmt.Errorf("--explain is not supported by %s", cmd.CommandPath())
+		}
+		if outputFormat == chartJSONFormat && cmd.Annotations[chartJSONAnnotation] == "" {
+			return fmt.Errorf("--output %s is only supported by bars commands", chartJSONFormat)
+		}
+		if err := checkParquetFlags(cmd); err != nil {
+			return err
+		}
+		if err := checkStreamFormat(cmd); err != nil {
+			return err
+		}
+		if err := checkCSVFlags(cmd); err != nil {
+			return err
+		}
+		if maxRedirects < 0 {
+			return fmt.Errorf("--max-redirects must be 0 or more, got %d", maxRedirects)
+		}
+		if err := checkPostFlags(); err != nil {
+			return err
+		}
+		if err := checkJSONNumberFlag(cmd); err != nil {
+			return err
+		}
+		if err := resolvePrecision(cmd); err != nil {
+			return err
+		}
+		return nil
+	},
 }
 
 // Execute runs the root command and exits with a non-zero status code
-// if any error occurs during command execution.
+// if any error occurs during command execution, using the codes defined
+// in exitcodes.go. A command stopped by --print-request or --dry-run after
+// printing its URL, or declined at the --explain prompt, is treated as a success,
+// and an alert rule that did not fire exits 1 without an error message.
+// With --timings the request timing report is printed to stderr first,
+// whether or not the command failed.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+	initCompletionCmd()
+	start := time.Now()
+	err := rootCmd.Execute()
+	if showTimings {
+		printTimings(os.Stderr, time.Since(start), totalTimings())
+	}
+
+	if err != nil {
+		if errors.Is(err, api.ErrRequestPrinted) || errors.Is(err, errExplainDeclined) {
+			return
+		}
+		if errors.Is(err, errAlertNotFired) {
+			os.Exit(exitGeneric)
+		}
+		printError(os.Stderr, err)
+		os.Exit(exitCode(err))
+	}
+
+	if failOnEmpty && resultsEmpty() {
+		fmt.Fprintln(os.Stderr, "no results")
+		os.Exit(exitNoResults)
 	}
 }
 
-// init registers persistent flags and loads environment variables from
-// the .env file if present. The output flag controls whether results
-// are displayed as a table or raw JSON.
+// init registers the global persistent flags, each documented by its
+// help text, and loads environment variables from the .env file if present.
 func init() {
 	cobra.OnInitialize(loadEnv)
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json)")
+	rootCmd.SetVersionTemplate(version.String())
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json, chart-json or parquet for bars, csv or ndjson for trades)")
+	rootCmd.PersistentFlags().BoolVar(&compactJSON, "compact", false, "Print JSON output on a single line instead of indented")
+	rootCmd.PersistentFlags().BoolVar(&resultsOnly, "results-only", false, "Print only the results payload of JSON output, without status, request_id, or next_url")
+	rootCmd.PersistentFlags().BoolVar(&withMeta, "with-meta", false, "Wrap JSON output as {\"meta\": {status_code, request_id, fetched_at, elapsed_ms, latency_ms}, \"data\": ...}")
+	rootCmd.PersistentFlags().BoolVar(&noHeader, "no-header", false, "Suppress the summary line, column header, and separator rows in table output")
+	rootCmd.PersistentFlags().StringVar(&csvDelimiter, "csv-delimiter", ",", "Field separator for CSV output (e.g. ';' for spreadsheets localized to use a comma decimal)")
+	rootCmd.PersistentFlags().StringVar(&csvDecimal, "csv-decimal", ".", "Decimal mark for numbers in CSV output (e.g. ',')")
+	rootCmd.PersistentFlags().StringVar(&archiveDir, "archive-dir", "", "Save every raw API response and its request metadata to this directory")
+	rootCmd.PersistentFlags().BoolVar(&printRequest, "print-request", false, "Print the HTTP request URL instead of sending it")
+	_ = rootCmd.PersistentFlags().MarkHidden("print-request")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Validate the command's params and print OK with the resolved request, without calling the API")
+	rootCmd.PersistentFlags().BoolVar(&failOnEmpty, "fail-on-empty", false, "Exit with status 5 when the API returns no results")
+	rootCmd.PersistentFlags().StringVar(&authMode, "auth-mode", string(api.AuthQuery), "How to send the API key: query (apiKey parameter), bearer (Authorization header), or header (custom header)")
+	rootCmd.PersistentFlags().StringVar(&authHeader, "auth-header", api.DefaultAuthHeader, "Header name used to send the API key when --auth-mode is header")
+	rootCmd.PersistentFlags().StringVar(&userAgent, "user-agent", api.DefaultUserAgent+"/"+version.Version, "User-Agent header sent with every API request")
+	rootCmd.PersistentFlags().BoolVar(&jsonNumber, "json-number", false, "Print JSON numbers exactly as the API sent them instead of via float64 (stocks fundamentals; requires --output json)")
+	rootCmd.PersistentFlags().BoolVar(&noCompression, "no-compression", false, "Request uncompressed responses instead of gzip (for debugging)")
+	rootCmd.PersistentFlags().BoolVar(&followRedirects, "follow-redirects", true, "Follow API redirects, re-attaching the API key (false returns the 3xx as an error)")
+	rootCmd.PersistentFlags().IntVar(&maxRedirects, "max-redirects", api.DefaultMaxRedirects, "Most redirects to follow per request (0 returns the first redirect as an error, like --follow-redirects=false)")
+	rootCmd.PersistentFlags().StringArrayVar(&redirectHosts, "redirect-host", nil, "Host besides the API's that followed redirects may send the API key to, e.g. a CDN (repeatable)")
+	rootCmd.PersistentFlags().BoolVar(&explain, "explain", false, "Describe what the command will fetch and ask for confirmation before sending")
+	rootCmd.PersistentFlags().BoolVar(&assumeYes, "yes", false, "Skip the --explain confirmation prompt")
+	rootCmd.PersistentFlags().BoolVar(&prettyErrors, "pretty-errors", true, "Print API errors as a formatted block with status, message, request ID, URL, and a hint")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Print only data: no summary lines, resume hints, warnings, or status messages")
+	rootCmd.PersistentFlags().BoolVar(&smartPrecision, "smart-precision", false, "Print prices with decimals chosen by magnitude (2 above 1000, 4 from 1, 6+ below 1) and trailing zeros trimmed")
+	rootCmd.PersistentFlags().StringVar(&precisionFlag, "precision", "", "Decimals for prices in tables (0-12), auto to choose by magnitude, or tick to round futures to the contract tick size (default per asset class: crypto auto, forex 5)")
+	rootCmd.PersistentFlags().BoolVar(&rawTimestamps, "raw-timestamps", false, "Print timestamps in tables as the raw epoch values from the API (ms for bars, ns for trades and quotes) instead of formatted dates")
+	rootCmd.PersistentFlags().BoolVar(&diagnostics, "diagnostics", false, "Print bars responses' queryCount, resultsCount, and limit to stderr and warn when queryCount far exceeds resultsCount")
+	rootCmd.PersistentFlags().BoolVar(&showTimings, "timings", false, "Print wall time, HTTP round trips, and average server latency to stderr when the command finishes")
+	rootCmd.PersistentFlags().StringVar(&postTo, "post-to", "", "POST the JSON output to this URL (e.g. a Slack or Discord webhook); requires --output json")
+	rootCmd.PersistentFlags().StringArrayVar(&postHeaders, "post-header", nil, "Extra header for --post-to as \"Key: value\" (repeatable)")
+	rootCmd.PersistentFlags().BoolVar(&showStats, "stats", false, "Append min/max/mean/last summary rows to bar and indicator tables")
 }
 
 // loadEnv attempts to load environment variables from a .env file in
@@ -0,0 +1,124 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// snapshotBatchSize is the maximum number of tickers sent in a single
+// request to the unified snapshot endpoint.
+const snapshotBatchSize = 250
+
+// snapshotCmd retrieves snapshots for a mixed list of tickers across asset
+// classes in a single call to the unified snapshot endpoint. Tickers keep
+// their usual prefixes (X: for crypto, C: for forex, I: for indices, O: for
+// options) and each row is labeled with the asset type returned by the API.
+// Long lists are split into batches fetched with rate-limit-aware concurrency.
+// Usage: massive snapshot X:BTCUSD AAPL C:EURUSD I:SPX
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot [tickers...]",
+	Short: "Get snapshots for a mixed list of tickers across asset classes",
+	Long:  "Retrieve snapshot data for any combination of stock, option, index, forex, and crypto tickers in one call using the unified snapshot endpoint.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tickers, err := batchTickers(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		result, err := fetchUnifiedSnapshots(client, tickers, concurrency)
+		if err != nil {
+			return err
+		}
+
+		if err := checkSnapshotAges(cmd, unifiedSnapshotAges(result.Results)); err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(result)
+		}
+
+		printSummary("Snapshots: %d", len(result.Results))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "TICKER\tTYPE\tPRICE\tCHANGE\tCHANGE%\tSTATUS\tNAME", "------\t----\t-----\t------\t-------\t------\t----")
+
+		for _, s := range result.Results {
+			if s.Error != "" {
+				fmt.Fprintf(w, "%s\t-\t-\t-\t-\t%s\t%s\n", s.Ticker, s.Error, s.Message)
+				continue
+			}
+
+			change, changePct := "-", "-"
+			if s.Session != nil {
+				change = priceCell("%.4f", s.Session.Change)
+				changePct = fmt.Sprintf("%.2f%%", s.Session.ChangePercent)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				s.Ticker, s.Type, priceCell("%.4f", s.Price()), change, changePct,
+				s.MarketStatus, truncateString(s.Name, 30))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// fetchUnifiedSnapshots retrieves unified snapshots for tickers, splitting
+// the list into endpoint-sized batches fetched with adaptive concurrency so
+// large lists stay under the rate limit. Results from all batches are
+// merged into a single response in batch order.
+func fetchUnifiedSnapshots(client *api.Client, tickers []string, concurrency int) (*api.UniversalSnapshotResponse, error) {
+	var batches [][]string
+	for start := 0; start < len(tickers); start += snapshotBatchSize {
+		end := min(start+snapshotBatchSize, len(tickers))
+		batches = append(batches, tickers[start:end])
+	}
+
+	responses := make([]*api.UniversalSnapshotResponse, len(batches))
+	fetcher := api.NewAdaptiveFetcher(client, concurrency)
+	err := fetcher.Run(len(batches), func(i int) error {
+		resp, err := client.GetUnifiedSnapshot(batches[i])
+		if err != nil {
+			return err
+		}
+		responses[i] = resp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := responses[0]
+	for _, resp := range responses[1:] {
+		result.Results = append(result.Results, resp.Results...)
+	}
+
+	return result, nil
+}
+
+// init registers the unified snapshot command and its flags under the
+// root command.
+func init() {
+	snapshotCmd.Flags().Int("concurrency", 4, "Maximum parallel requests when the ticker list spans multiple batches")
+	addMaxAgeFlags(snapshotCmd)
+	addTickersFileFlag(snapshotCmd)
+	rootCmd.AddCommand(snapshotCmd)
+}
@@ -0,0 +1,120 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// errStaleSnapshot is wrapped by the error returned when --max-age is
+// combined with --strict and a snapshot is too old. It maps to exitStale.
+var errStaleSnapshot = errors.New("stale snapshot")
+
+// snapshotAge pairs a ticker with the raw updated timestamp from its
+// snapshot, in whatever epoch unit the endpoint reports.
+type snapshotAge struct {
+	Ticker  string
+	Updated int64
+}
+
+// addMaxAgeFlags registers the --max-age and --strict flags shared by the
+// snapshot commands.
+func addMaxAgeFlags(cmd *cobra.Command) {
+	cmd.Flags().Duration("max-age", 0, "Warn when a ticker's snapshot was last updated longer ago than this (e.g. 15m, 2h)")
+	cmd.Flags().Bool("strict", false, "With --max-age, fail instead of warning when any snapshot is stale")
+}
+
+// checkSnapshotAges compares each ticker's updated time against --max-age
+// and warns on stderr for every stale one, or for one with no updated time
+// at all. With --strict the warnings become an error wrapping
+// errStaleSnapshot, returned before anything is printed so stale data is
+// never acted on. It does nothing when --max-age is not set.
+func checkSnapshotAges(cmd *cobra.Command, ages []snapshotAge) error {
+	maxAge, _ := cmd.Flags().GetDuration("max-age")
+	if maxAge <= 0 {
+		return nil
+	}
+	strict, _ := cmd.Flags().GetBool("strict")
+
+	now := time.Now()
+	var stale []string
+	for _, a := range ages {
+		var reason string
+		if a.Updated == 0 {
+			reason = "has no updated time"
+		} else if age := now.Sub(api.EpochTime(a.Updated)); age > maxAge {
+			reason = fmt.Sprintf("was last updated %s ago (at %s)", age.Round(time.Second), api.EpochTime(a.Updated).Format("2006-01-02 15:04:05"))
+		} else {
+			continue
+		}
+
+		stale = append(stale, a.Ticker)
+		warnf("%s snapshot %s; --max-age is %s", a.Ticker, reason, maxAge)
+	}
+
+	if strict && len(stale) > 0 {
+		return fmt.Errorf("%w: %s older than --max-age %s", errStaleSnapshot, strings.Join(stale, ", "), maxAge)
+	}
+
+	return nil
+}
+
+// stocksSnapshotAges extracts the updated times of stock snapshots.
+func stocksSnapshotAges(tickers ...api.SnapshotTicker) []snapshotAge {
+	ages := make([]snapshotAge, len(tickers))
+	for i, t := range tickers {
+		ages[i] = snapshotAge{Ticker: t.Ticker, Updated: t.Updated}
+	}
+	return ages
+}
+
+// cryptoSnapshotAges extracts the updated times of crypto snapshots.
+func cryptoSnapshotAges(tickers ...api.CryptoSnapshotTicker) []snapshotAge {
+	ages := make([]snapshotAge, len(tickers))
+	for i, t := range tickers {
+		ages[i] = snapshotAge{Ticker: t.Ticker, Updated: t.Updated}
+	}
+	return ages
+}
+
+// forexSnapshotAges extracts the updated times of forex snapshots.
+func forexSnapshotAges(tickers ...api.ForexSnapshotTicker) []snapshotAge {
+	ages := make([]snapshotAge, len(tickers))
+	for i, t := range tickers {
+		ages[i] = snapshotAge{Ticker: t.Ticker, Updated: t.Updated}
+	}
+	return ages
+}
+
+// indicesSnapshotAges extracts the last updated times of index snapshots,
+// skipping entries the API returned as errors.
+func indicesSnapshotAges(results []api.IndicesSnapshotResult) []snapshotAge {
+	var ages []snapshotAge
+	for _, r := range results {
+		if r.Error == "" {
+			ages = append(ages, snapshotAge{Ticker: r.Ticker, Updated: r.LastUpdated})
+		}
+	}
+	return ages
+}
+
+// unifiedSnapshotAges extracts the last updated times of unified snapshot
+// results, skipping entries the API returned as errors.
+func unifiedSnapshotAges(results []api.UniversalSnapshot) []snapshotAge {
+	var ages []snapshotAge
+	for _, r := range results {
+		if r.Error == "" {
+			ages = append(ages, snapshotAge{Ticker: r.Ticker, Updated: r.LastUpdated})
+		}
+	}
+	return ages
+}
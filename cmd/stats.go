@@ -0,0 +1,171 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// showStats appends a summary statistics footer to bar and indicator
+// tables. Set via the global --stats flag.
+var showStats bool
+
+// statsColumn describes one table column to summarize in the --stats
+// footer. Index is the zero-based column position, Format is the printf
+// verb used for the column's values, and Stats lists which footer rows
+// (MIN, MAX, MEAN, LAST, TOTAL) show a value for this column. Price
+// columns follow --smart-precision like the table rows above them.
+type statsColumn struct {
+	Index  int
+	Format string
+	Values []float64
+	Stats  []string
+	Price  bool
+}
+
+// statsRows is the fixed order of the footer rows. A row is only written
+// when at least one column asks for it.
+var statsRows = []string{"MIN", "MAX", "MEAN", "LAST", "TOTAL"}
+
+// writeStatsFooter appends summary rows for the given columns to a table
+// of width columns. LAST is the value at the most recent timestamp, so it
+// is correct whether the table is sorted ascending or descending. Nothing
+// is written when --stats is off or the table has no rows.
+func writeStatsFooter(w io.Writer, width int, timestamps []int64, columns ...statsColumn) {
+	if !showStats || len(timestamps) == 0 {
+		return
+	}
+
+	latest := 0
+	for i, ts := range timestamps {
+		if ts > timestamps[latest] {
+			latest = i
+		}
+	}
+
+	// An all-empty row keeps the footer inside the same tabwriter column
+	// block as the table so it stays aligned.
+	fmt.Fprintln(w, strings.Repeat("\t", width-1))
+
+	for _, row := range statsRows {
+		cells := make([]string, width)
+		cells[0] = row
+		used := false
+
+		for _, col := range columns {
+			if !containsString(col.Stats, row) || len(col.Values) == 0 {
+				continue
+			}
+			value := columnStat(row, col.Values, latest)
+			if col.Price {
+				cells[col.Index] = priceCell(col.Format, value)
+			} else {
+				cells[col.Index] = fmt.Sprintf(col.Format, value)
+			}
+			used = true
+		}
+
+		if used {
+			fmt.Fprintln(w, strings.Join(cells, "\t"))
+		}
+	}
+}
+
+// columnStat computes a single footer statistic over values. latest is the
+// index of the most recent row, used for LAST.
+func columnStat(row string, values []float64, latest int) float64 {
+	switch row {
+	case "MIN":
+		min := math.Inf(1)
+		for _, v := range values {
+			min = math.Min(min, v)
+		}
+		return min
+	case "MAX":
+		max := math.Inf(-1)
+		for _, v := range values {
+			max = math.Max(max, v)
+		}
+		return max
+	case "MEAN", "TOTAL":
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		if row == "MEAN" {
+			return sum / float64(len(values))
+		}
+		return sum
+	case "LAST":
+		return values[latest]
+	}
+	return math.NaN()
+}
+
+// containsString reports whether s is present in list.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// barStats collects the columns of an OHLC bars table as rows are
+// written so the --stats footer can be rendered after the last row.
+type barStats struct {
+	timestamps []int64
+	highs      []float64
+	lows       []float64
+	closes     []float64
+	volumes    []float64
+	adjusted   *bool
+}
+
+// add records one bar's timestamp, high, low, close, and volume.
+func (s *barStats) add(timestamp int64, high, low, close, volume float64) {
+	s.timestamps = append(s.timestamps, timestamp)
+	s.highs = append(s.highs, high)
+	s.lows = append(s.lows, low)
+	s.closes = append(s.closes, close)
+	s.volumes = append(s.volumes, volume)
+}
+
+// setAdjusted records whether the bars were split-adjusted, as reported by
+// the response, so the footer can show it. Stats computed over unadjusted
+// bars that span a split mix pre- and post-split prices.
+func (s *barStats) setAdjusted(adjusted bool) {
+	s.adjusted = &adjusted
+}
+
+// writeFooter appends the --stats footer for a bars table: min/max/mean/last
+// of the close, the range high and low, the total volume, and the adjusted
+// status when setAdjusted was called. The indexes locate the HIGH, LOW,
+// CLOSE, and VOLUME columns in a table of width columns; pass a negative
+// volumeIdx for tables without volume.
+func (s *barStats) writeFooter(w io.Writer, width int, format string, highIdx, lowIdx, closeIdx, volumeIdx int) {
+	columns := []statsColumn{
+		{Index: highIdx, Format: format, Values: s.highs, Stats: []string{"MAX"}, Price: true},
+		{Index: lowIdx, Format: format, Values: s.lows, Stats: []string{"MIN"}, Price: true},
+		{Index: closeIdx, Format: format, Values: s.closes, Stats: []string{"MIN", "MAX", "MEAN", "LAST"}, Price: true},
+	}
+	if volumeIdx >= 0 {
+		columns = append(columns, statsColumn{Index: volumeIdx, Format: "%.0f", Values: s.volumes, Stats: []string{"TOTAL"}})
+	}
+
+	writeStatsFooter(w, width, s.timestamps, columns...)
+
+	if showStats && len(s.timestamps) > 0 && s.adjusted != nil {
+		cells := make([]string, width)
+		cells[0] = "ADJUSTED"
+		cells[1] = fmt.Sprintf("%v", *s.adjusted)
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
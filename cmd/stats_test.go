@@ -0,0 +1,59 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"text/tabwriter"
+)
+
+// TestBarStatsAdjustedRow verifies the --stats footer of a bars table
+// ends with an ADJUSTED row when the response reported its adjusted flag,
+// and leaves it out when the flag is unknown or --stats is off.
+func TestBarStatsAdjustedRow(t *testing.T) {
+	saved := showStats
+	defer func() { showStats = saved }()
+
+	render := func(adjusted *bool) string {
+		var s barStats
+		s.add(1704171600000, 186.0, 183.0, 185.64, 1000)
+		s.add(1704258000000, 185.0, 182.5, 184.25, 2000)
+		if adjusted != nil {
+			s.setAdjusted(*adjusted)
+		}
+
+		var b strings.Builder
+		w := tabwriter.NewWriter(&b, 0, 0, 1, ' ', 0)
+		s.writeFooter(w, 6, "%.2f", 2, 3, 4, 5)
+		w.Flush()
+		return b.String()
+	}
+	yes, no := true, false
+
+	showStats = true
+	lines := strings.Split(strings.TrimSpace(render(&yes)), "\n")
+	if last := strings.Fields(lines[len(lines)-1]); len(last) != 2 || last[0] != "ADJUSTED" || last[1] != "true" {
+		t.Errorf("adjusted bars: last footer row = %q, want ADJUSTED true", lines[len(lines)-1])
+	}
+	if !strings.HasPrefix(lines[0], "MIN") {
+		t.Errorf("adjusted bars: footer starts with %q, want the MIN row first", lines[0])
+	}
+
+	lines = strings.Split(strings.TrimSpace(render(&no)), "\n")
+	if last := strings.Fields(lines[len(lines)-1]); len(last) != 2 || last[0] != "ADJUSTED" || last[1] != "false" {
+		t.Errorf("unadjusted bars: last footer row = %q, want ADJUSTED false", lines[len(lines)-1])
+	}
+
+	if out := render(nil); strings.Contains(out, "ADJUSTED") {
+		t.Errorf("unknown adjusted flag: footer has an ADJUSTED row:\n%s", out)
+	}
+
+	showStats = false
+	if out := render(&yes); out != "" {
+		t.Errorf("--stats off: footer = %q, want nothing", out)
+	}
+}
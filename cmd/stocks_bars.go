@@ -32,12 +32,22 @@ var stocksBarsCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		multiplier, _ := cmd.Flags().GetString("multiplier")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
+		timespan, err := timespanFlag(cmd)
+		if err != nil {
+			return err
+		}
+		from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
+		to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+		if err != nil {
+			return err
+		}
 		adjusted, _ := cmd.Flags().GetString("adjusted")
 		sort, _ := cmd.Flags().GetString("sort")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitAggs, limit)
 
 		params := api.BarsParams{
 			Multiplier: multiplier,
@@ -49,34 +59,97 @@ var stocksBarsCmd = &cobra.Command{
 			Limit:      limit,
 		}
 
+		if err := confirmExplain(barsExplanation{
+			Ticker:     ticker,
+			Multiplier: multiplier,
+			Timespan:   timespan,
+			From:       from,
+			To:         to,
+			Adjusted:   adjusted,
+			Sort:       sort,
+			Limit:      limit,
+		}); err != nil {
+			return err
+		}
+
 		result, err := client.GetBars(ticker, params)
 		if err != nil {
 			return err
 		}
+		checkBarCounts(result.QueryCount, result.ResultsCount, limit)
+
+		if outputFormat == chartJSONFormat {
+			return printChartJSON(chartBarsFromAggs(result.Results))
+		}
+
+		if outputFormat == parquetFormat {
+			return writeBarsParquet(cmd, parquetBarsFromAggs(result.Results))
+		}
 
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Bars: %d | Adjusted: %v\n\n", result.Ticker, result.ResultsCount, result.Adjusted)
+		if showStats && !result.Adjusted {
+			warnUnadjustedSplits(client, ticker, result.Results)
+		}
+
+		printSummary("Ticker: %s | Bars: %d | Adjusted: %v", result.Ticker, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "----\t----\t----\t---\t-----\t------\t----\t------")
-
-		for _, bar := range result.Results {
-			t := time.UnixMilli(bar.Timestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				t.Format("2006-01-02"),
-				bar.Open, bar.High, bar.Low, bar.Close,
-				bar.Volume, bar.VWAP, bar.NumTrades)
+		writeHeader(w, "DATE\tOPEN\tHIGH\tLOW\tCLOSE\tCHG%\tRANGE\tVOLUME\tVWAP\tTRADES", "----\t----\t----\t---\t-----\t----\t-----\t------\t----\t------")
+
+		var stats barStats
+		stats.setAdjusted(result.Adjusted)
+		changes := barChangePercents(result.Results, func(b api.Bar) (int64, float64) { return b.Timestamp, b.Close })
+		for i, bar := range result.Results {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				timestampCell(bar.Timestamp, api.UnitMilliseconds, "2006-01-02"),
+				priceCell("%.4f", bar.Open), priceCell("%.4f", bar.High), priceCell("%.4f", bar.Low),
+				priceCell("%.4f", bar.Close), changes[i], priceCell("%.4f", bar.High-bar.Low),
+				bar.Volume, priceCell("%.4f", bar.VWAP), bar.NumTrades)
+			stats.add(bar.Timestamp, bar.High, bar.Low, bar.Close, bar.Volume)
 		}
+		stats.writeFooter(w, 10, "%.4f", 2, 3, 4, 7)
 		w.Flush()
 
 		return nil
 	},
 }
 
+// warnUnadjustedSplits warns on stderr about each split of ticker that
+// executed within the span of unadjusted bars, since --stats over such a
+// range mixes pre- and post-split prices. A split on the first bar's date
+// is already reflected in every bar and is ignored. If the splits lookup
+// fails the check is skipped with a note rather than failing the command.
+func warnUnadjustedSplits(client *api.Client, ticker string, bars []api.Bar) {
+	if len(bars) < 2 {
+		return
+	}
+
+	first, last := bars[0].Timestamp, bars[0].Timestamp
+	for _, bar := range bars {
+		first = min(first, bar.Timestamp)
+		last = max(last, bar.Timestamp)
+	}
+
+	splits, err := client.GetSplits(api.SplitsParams{
+		Ticker:           ticker,
+		ExecutionDateGT:  api.UnitMilliseconds.Time(first).UTC().Format("2006-01-02"),
+		ExecutionDateLTE: api.UnitMilliseconds.Time(last).UTC().Format("2006-01-02"),
+		Sort:             "execution_date.asc",
+	})
+	if err != nil {
+		warnf("could not check %s for splits in this range: %v", ticker, err)
+		return
+	}
+
+	for _, split := range splits.Results {
+		warnf("%s had a %g-for-%g split on %s within these unadjusted bars; the --stats summary mixes pre- and post-split prices. Rerun with --adjusted true.",
+			ticker, split.SplitTo, split.SplitFrom, split.ExecutionDate)
+	}
+}
+
 // init registers the bars command and its flags under the stocks parent command.
 func init() {
 	stocksBarsCmd.Flags().String("multiplier", "1", "Size of the timespan multiplier")
@@ -85,10 +158,15 @@ func init() {
 	stocksBarsCmd.Flags().String("to", "", "End date (YYYY-MM-DD) [required]")
 	stocksBarsCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 	stocksBarsCmd.Flags().String("sort", "asc", "Sort order (asc/desc)")
-	stocksBarsCmd.Flags().String("limit", "5000", "Max number of results (max 50000)")
+	addLimitFlag(stocksBarsCmd, api.LimitAggs, "Max number of results")
 
 	stocksBarsCmd.MarkFlagRequired("from")
 	stocksBarsCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(stocksBarsCmd, "from", "to")
 
+	useTickerIndex(stocksBarsCmd, "stocks")
+	supportsChartJSON(stocksBarsCmd)
+	supportsParquet(stocksBarsCmd)
+	supportsExplain(stocksBarsCmd)
 	stocksCmd.AddCommand(stocksBarsCmd)
 }
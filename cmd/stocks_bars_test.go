@@ -0,0 +1,56 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestWarnUnadjustedSplits verifies the splits lookup covers the bars'
+// UTC dates whatever the local time zone, and that each split found is
+// reported on stderr.
+func TestWarnUnadjustedSplits(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"status":"OK","results":[{"ticker":"NVDA","execution_date":"2024-06-10","split_from":1,"split_to":10}]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient("test-api-key")
+	client.SetBaseURL(server.URL)
+
+	savedLocal := time.Local
+	time.Local = time.FixedZone("UTC-10", -10*60*60)
+	defer func() { time.Local = savedLocal }()
+
+	// Daily bars open at midnight UTC, which is the previous evening in
+	// UTC-10.
+	bars := []api.Bar{
+		{Timestamp: time.Date(2024, 6, 7, 0, 0, 0, 0, time.UTC).UnixMilli()},
+		{Timestamp: time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC).UnixMilli()},
+		{Timestamp: time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC).UnixMilli()},
+	}
+
+	out := captureStderr(t, func() { warnUnadjustedSplits(client, "NVDA", bars) })
+
+	if got := query.Get("execution_date.gt"); got != "2024-06-07" {
+		t.Errorf("execution_date.gt = %q, want 2024-06-07", got)
+	}
+	if got := query.Get("execution_date.lte"); got != "2024-06-11" {
+		t.Errorf("execution_date.lte = %q, want 2024-06-11", got)
+	}
+	if !strings.Contains(out, "NVDA had a 10-for-1 split on 2024-06-10") {
+		t.Errorf("stderr = %q, want the split warning", out)
+	}
+}
@@ -63,11 +63,10 @@ var stocksDividendsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Dividends: %d result(s)\n\n", len(result.Results))
+		printSummary("Dividends: %d result(s)", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tEX-DIV DATE\tPAY DATE\tCASH AMT\tCURRENCY\tFREQ\tTYPE\tSPLIT-ADJ AMT")
-		fmt.Fprintln(w, "------\t-----------\t--------\t--------\t--------\t----\t----\t-------------")
+		writeHeader(w, "TICKER\tEX-DIV DATE\tPAY DATE\tCASH AMT\tCURRENCY\tFREQ\tTYPE\tSPLIT-ADJ AMT", "------\t-----------\t--------\t--------\t--------\t----\t----\t-------------")
 
 		for _, d := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%.6f\t%s\t%d\t%s\t%.6f\n",
@@ -127,11 +126,10 @@ var stocksSplitsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Splits: %d result(s)\n\n", len(result.Results))
+		printSummary("Splits: %d result(s)", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tEXECUTION DATE\tSPLIT FROM\tSPLIT TO\tTYPE\tADJ FACTOR")
-		fmt.Fprintln(w, "------\t--------------\t----------\t--------\t----\t----------")
+		writeHeader(w, "TICKER\tEXECUTION DATE\tSPLIT FROM\tSPLIT TO\tTYPE\tADJ FACTOR", "------\t--------------\t----------\t--------\t----\t----------")
 
 		for _, s := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%.0f\t%.0f\t%s\t%.6f\n",
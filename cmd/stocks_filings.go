@@ -72,11 +72,10 @@ var stocksFilingsSectionsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", len(result.Results))
+		printSummary("Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tSECTION\tFILING DATE\tPERIOD END\tTEXT PREVIEW")
-		fmt.Fprintln(w, "------\t-------\t-----------\t----------\t------------")
+		writeHeader(w, "TICKER\tSECTION\tFILING DATE\tPERIOD END\tTEXT PREVIEW", "------\t-------\t-----------\t----------\t------------")
 
 		for _, s := range result.Results {
 			preview := s.Text
@@ -135,11 +134,10 @@ var stocksFilingsRiskFactorsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", len(result.Results))
+		printSummary("Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tFILING DATE\tPRIMARY\tSECONDARY\tTERTIARY")
-		fmt.Fprintln(w, "------\t-----------\t-------\t---------\t--------")
+		writeHeader(w, "TICKER\tFILING DATE\tPRIMARY\tSECONDARY\tTERTIARY", "------\t-----------\t-------\t---------\t--------")
 
 		for _, rf := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
@@ -191,11 +189,10 @@ var stocksFilingsRiskCategoriesCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", len(result.Results))
+		printSummary("Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "PRIMARY\tSECONDARY\tTERTIARY\tTAXONOMY\tDESCRIPTION")
-		fmt.Fprintln(w, "-------\t---------\t--------\t--------\t-----------")
+		writeHeader(w, "PRIMARY\tSECONDARY\tTERTIARY\tTAXONOMY\tDESCRIPTION", "-------\t---------\t--------\t--------\t-----------")
 
 		for _, rc := range result.Results {
 			desc := rc.Description
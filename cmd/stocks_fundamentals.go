@@ -7,8 +7,11 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/cloudmanic/massive-cli/internal/api"
@@ -18,10 +21,270 @@ import (
 // stocksFundamentalsCmd is the parent command for all stock fundamentals
 // subcommands including short interest, short volume, float, balance
 // sheets, income statements, cash flow statements, and financial ratios.
+// Given a ticker directly it renders a one-page overview of the latest
+// period from all of them (see runFundamentalsOverview).
+// Usage: massive stocks fundamentals AAPL
 var stocksFundamentalsCmd = &cobra.Command{
-	Use:   "fundamentals",
+	Use:   "fundamentals [ticker]",
 	Short: "Stock fundamentals data commands",
-	Long:  "Access fundamental financial data for stocks including short interest, short volume, float, balance sheets, income statements, cash flow statements, and financial ratios.",
+	Long:  "Access fundamental financial data for stocks including short interest, short volume, float, balance sheets, income statements, cash flow statements, and financial ratios. Pass a ticker to fetch the latest period from all of them concurrently as a one-page overview.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+		if err := checkMistypedSubcommand(cmd, args[0]); err != nil {
+			return err
+		}
+		return runFundamentalsOverview(strings.ToUpper(args[0]))
+	},
+}
+
+// stockTickerPattern matches the shape of a stock ticker: up to five
+// letters with an optional share class suffix such as BRK.B.
+var stockTickerPattern = regexp.MustCompile(`^[A-Za-z]{1,5}(\.[A-Za-z]{1,2})?$`)
+
+// checkMistypedSubcommand rejects an argument to a command that takes
+// either a ticker or a subcommand when it is close to a subcommand name
+// and cannot be a ticker, so "fundamentals balance-sheet" reports the
+// typo instead of fetching an overview for the ticker BALANCE-SHEET.
+// Ticker-shaped arguments such as F are always treated as tickers, even
+// though they prefix a subcommand name.
+func checkMistypedSubcommand(cmd *cobra.Command, arg string) error {
+	if stockTickerPattern.MatchString(arg) {
+		return nil
+	}
+	// Cobra only defaults the suggestion distance when it reports an
+	// unknown command itself, so apply the same default here.
+	if cmd.SuggestionsMinimumDistance <= 0 {
+		cmd.SuggestionsMinimumDistance = 2
+	}
+	suggestions := cmd.SuggestionsFor(arg)
+	if len(suggestions) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unknown command %q for %q\n\nDid you mean this?\n\t%s", arg, cmd.CommandPath(), strings.Join(suggestions, "\n\t"))
+}
+
+// fundamentalsOverview bundles the latest record from each fundamentals
+// endpoint for one ticker. Sections that failed or returned no data are
+// nil, and any per-endpoint errors are collected in Errors.
+type fundamentalsOverview struct {
+	Ticker          string                 `json:"ticker"`
+	BalanceSheet    *api.BalanceSheet      `json:"balance_sheet,omitempty"`
+	IncomeStatement *api.IncomeStatement   `json:"income_statement,omitempty"`
+	CashFlow        *api.CashFlowStatement `json:"cash_flow_statement,omitempty"`
+	Ratios          *api.Ratio             `json:"ratios,omitempty"`
+	Float           *api.FloatData         `json:"float,omitempty"`
+	ShortInterest   *api.ShortInterest     `json:"short_interest,omitempty"`
+	Errors          map[string]string      `json:"errors,omitempty"`
+}
+
+// runFundamentalsOverview fetches balance sheet, income statement, cash
+// flow, ratios, float, and short interest for the ticker concurrently and
+// renders them as a single overview. A failing endpoint is reported
+// alongside the others rather than aborting the whole command; an error
+// is only returned when every endpoint fails.
+func runFundamentalsOverview(ticker string) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	overview := fundamentalsOverview{Ticker: ticker, Errors: map[string]string{}}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+
+	// fetch runs one endpoint in its own goroutine and records its error
+	// under the section name so the rest of the overview still renders.
+	fetch := func(section string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				overview.Errors[section] = err.Error()
+				errs = append(errs, fmt.Errorf("%s: %w", section, err))
+			}
+		}()
+	}
+
+	fetch("balance_sheet", func() error {
+		r, err := client.GetBalanceSheets(api.BalanceSheetsParams{Tickers: ticker, Limit: "1", Sort: "period_end.desc"})
+		if err == nil && len(r.Results) > 0 {
+			overview.BalanceSheet = &r.Results[0]
+		}
+		return err
+	})
+	fetch("income_statement", func() error {
+		r, err := client.GetIncomeStatements(api.IncomeStatementsParams{Tickers: ticker, Limit: "1", Sort: "period_end.desc"})
+		if err == nil && len(r.Results) > 0 {
+			overview.IncomeStatement = &r.Results[0]
+		}
+		return err
+	})
+	fetch("cash_flow_statement", func() error {
+		r, err := client.GetCashFlowStatements(api.CashFlowStatementsParams{Tickers: ticker, Limit: "1", Sort: "period_end.desc"})
+		if err == nil && len(r.Results) > 0 {
+			overview.CashFlow = &r.Results[0]
+		}
+		return err
+	})
+	fetch("ratios", func() error {
+		r, err := client.GetRatios(api.RatiosParams{Ticker: ticker, Limit: "1"})
+		if err == nil && len(r.Results) > 0 {
+			overview.Ratios = &r.Results[0]
+		}
+		return err
+	})
+	fetch("float", func() error {
+		r, err := client.GetFloat(api.FloatParams{Ticker: ticker, Limit: "1"})
+		if err == nil && len(r.Results) > 0 {
+			overview.Float = &r.Results[0]
+		}
+		return err
+	})
+	fetch("short_interest", func() error {
+		r, err := client.GetShortInterest(api.ShortInterestParams{Ticker: ticker, Limit: "1", Sort: "settlement_date.desc"})
+		if err == nil && len(r.Results) > 0 {
+			overview.ShortInterest = &r.Results[0]
+		}
+		return err
+	})
+	wg.Wait()
+
+	if len(errs) == 6 {
+		return fmt.Errorf("failed to fetch fundamentals for %s: %w", ticker, errs[0])
+	}
+
+	if outputFormat == "json" {
+		return printJSON(overview)
+	}
+
+	printSummary("Fundamentals Overview: %s", ticker)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	writeSection(w, "BALANCE SHEET")
+	if bs := overview.BalanceSheet; bs != nil {
+		fmt.Fprintf(w, "Period End\t%s (%s)\n", bs.PeriodEnd, bs.Timeframe)
+		fmt.Fprintf(w, "Total Assets\t%s\n", formatOptionalFundamental(bs.TotalAssets))
+		fmt.Fprintf(w, "Total Liabilities\t%s\n", formatOptionalFundamental(bs.TotalLiabilities))
+		fmt.Fprintf(w, "Total Equity\t%s\n", formatOptionalFundamental(bs.TotalEquity))
+		fmt.Fprintf(w, "Cash\t%s\n", formatOptionalFundamental(bs.CashAndEquivalents))
+	} else {
+		writeOverviewMissing(w, overview.Errors["balance_sheet"])
+	}
+	fmt.Fprintln(w)
+
+	writeSection(w, "INCOME STATEMENT")
+	if is := overview.IncomeStatement; is != nil {
+		fmt.Fprintf(w, "Period End\t%s (%s)\n", is.PeriodEnd, is.Timeframe)
+		fmt.Fprintf(w, "Revenue\t%s\n", formatOptionalFundamental(is.Revenue))
+		fmt.Fprintf(w, "Gross Profit\t%s\n", formatOptionalFundamental(is.GrossProfit))
+		fmt.Fprintf(w, "Operating Income\t%s\n", formatOptionalFundamental(is.OperatingIncome))
+		fmt.Fprintf(w, "Net Income\t%s\n", formatOptionalFundamental(is.ConsolidatedNetIncomeLoss))
+		fmt.Fprintf(w, "Diluted EPS\t%s\n", formatOptional(is.DilutedEarningsPerShare, "$%.2f"))
+	} else {
+		writeOverviewMissing(w, overview.Errors["income_statement"])
+	}
+	fmt.Fprintln(w)
+
+	writeSection(w, "CASH FLOW")
+	if cf := overview.CashFlow; cf != nil {
+		fmt.Fprintf(w, "Period End\t%s (%s)\n", cf.PeriodEnd, cf.Timeframe)
+		fmt.Fprintf(w, "Operating\t%s\n", formatOptionalFundamental(cf.NetCashFromOperatingActivities))
+		fmt.Fprintf(w, "Investing\t%s\n", formatOptionalFundamental(cf.NetCashFromInvestingActivities))
+		fmt.Fprintf(w, "Financing\t%s\n", formatOptionalFundamental(cf.NetCashFromFinancingActivities))
+		fmt.Fprintf(w, "Net Change\t%s\n", formatOptionalFundamental(cf.ChangeInCashAndEquivalents))
+	} else {
+		writeOverviewMissing(w, overview.Errors["cash_flow_statement"])
+	}
+	fmt.Fprintln(w)
+
+	writeSection(w, "RATIOS")
+	if r := overview.Ratios; r != nil {
+		fmt.Fprintf(w, "Date\t%s\n", r.Date)
+		fmt.Fprintf(w, "Price\t%s\n", formatOptional(r.Price, "$%.2f"))
+		fmt.Fprintf(w, "Market Cap\t%s\n", formatOptionalFundamental(r.MarketCap))
+		fmt.Fprintf(w, "P/E | P/B | P/S\t%s | %s | %s\n", formatOptional(r.PriceToEarnings, "%.2f"), formatOptional(r.PriceToBook, "%.2f"), formatOptional(r.PriceToSales, "%.2f"))
+		fmt.Fprintf(w, "ROE | ROA\t%s | %s\n", formatOptional(r.ReturnOnEquity, "%.2f%%"), formatOptional(r.ReturnOnAssets, "%.2f%%"))
+		fmt.Fprintf(w, "Debt/Equity\t%s\n", formatOptional(r.DebtToEquity, "%.2f"))
+		fmt.Fprintf(w, "Dividend Yield\t%s\n", formatOptional(r.DividendYield, "%.2f%%"))
+	} else {
+		writeOverviewMissing(w, overview.Errors["ratios"])
+	}
+	fmt.Fprintln(w)
+
+	writeSection(w, "FLOAT")
+	if f := overview.Float; f != nil {
+		fmt.Fprintf(w, "Effective Date\t%s\n", f.EffectiveDate)
+		fmt.Fprintf(w, "Free Float\t%s (%.2f%%)\n", formatFundamental(float64(f.FreeFloat)), f.FreeFloatPercent)
+	} else {
+		writeOverviewMissing(w, overview.Errors["float"])
+	}
+	fmt.Fprintln(w)
+
+	writeSection(w, "SHORT INTEREST")
+	if si := overview.ShortInterest; si != nil {
+		fmt.Fprintf(w, "Settlement Date\t%s\n", si.SettlementDate)
+		fmt.Fprintf(w, "Short Interest\t%s\n", formatFundamental(float64(si.ShortInterest)))
+		fmt.Fprintf(w, "Avg Daily Volume\t%s\n", formatFundamental(float64(si.AvgDailyVolume)))
+		fmt.Fprintf(w, "Days To Cover\t%.2f\n", si.DaysToCover)
+	} else {
+		writeOverviewMissing(w, overview.Errors["short_interest"])
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+// writeOverviewMissing writes the placeholder line for an overview section
+// that has no data, including the endpoint error when the fetch failed.
+func writeOverviewMissing(w io.Writer, errMsg string) {
+	if errMsg != "" {
+		fmt.Fprintf(w, "Error\t%s\n", errMsg)
+		return
+	}
+	fmt.Fprintln(w, "No data")
+}
+
+// fundamentalsHumanize is set by the --humanize flag on the fundamentals
+// command to abbreviate large figures (e.g. 391.04B) in table output.
+var fundamentalsHumanize bool
+
+// formatFundamental renders a large financial figure for table output. It
+// returns the abbreviated form when --humanize is set and the full whole
+// number otherwise. JSON output is never affected.
+func formatFundamental(f float64) string {
+	if fundamentalsHumanize {
+		return humanizeNumber(f)
+	}
+	return fmt.Sprintf("%.0f", f)
+}
+
+// formatOptionalFundamental renders a financial statement line item with a
+// dollar sign, or "N/A" when the filing did not report it.
+func formatOptionalFundamental(f *float64) string {
+	if f == nil {
+		return "N/A"
+	}
+	return "$" + formatFundamental(*f)
+}
+
+// formatOptional renders an optional metric with the given format, or
+// "N/A" when the value was not reported.
+func formatOptional(f *float64, format string) string {
+	if f == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf(format, *f)
 }
 
 // ---------------------------------------------------------------------------
@@ -54,6 +317,10 @@ var stocksShortInterestCmd = &cobra.Command{
 			Sort:           sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetShortInterest(params)
 		if err != nil {
 			return err
@@ -63,16 +330,15 @@ var stocksShortInterestCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Short Interest Results: %d\n\n", result.Count)
+		printSummary("Short Interest Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tSETTLEMENT DATE\tSHORT INTEREST\tAVG DAILY VOL\tDAYS TO COVER")
-		fmt.Fprintln(w, "------\t---------------\t--------------\t-------------\t-------------")
+		writeHeader(w, "TICKER\tSETTLEMENT DATE\tSHORT INTEREST\tAVG DAILY VOL\tDAYS TO COVER", "------\t---------------\t--------------\t-------------\t-------------")
 
 		for _, si := range result.Results {
-			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.2f\n",
-				si.Ticker, si.SettlementDate, si.ShortInterest,
-				si.AvgDailyVolume, si.DaysToCover)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f\n",
+				si.Ticker, si.SettlementDate, formatFundamental(float64(si.ShortInterest)),
+				formatFundamental(float64(si.AvgDailyVolume)), si.DaysToCover)
 		}
 		w.Flush()
 
@@ -110,6 +376,10 @@ var stocksShortVolumeCmd = &cobra.Command{
 			Sort:   sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetShortVolume(params)
 		if err != nil {
 			return err
@@ -119,16 +389,17 @@ var stocksShortVolumeCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Short Volume Results: %d\n\n", result.Count)
+		printSummary("Short Volume Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDATE\tSHORT VOL\tTOTAL VOL\tRATIO\tEXEMPT\tNON-EXEMPT")
-		fmt.Fprintln(w, "------\t----\t---------\t---------\t-----\t------\t----------")
+		writeHeader(w, "TICKER\tDATE\tSHORT VOL\tTOTAL VOL\tRATIO\tEXEMPT\tNON-EXEMPT", "------\t----\t---------\t---------\t-----\t------\t----------")
 
 		for _, sv := range result.Results {
-			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.2f%%\t%d\t%d\n",
-				sv.Ticker, sv.Date, sv.ShortVolume, sv.TotalVolume,
-				sv.ShortVolumeRatio, sv.ExemptVolume, sv.NonExemptVolume)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f%%\t%s\t%s\n",
+				sv.Ticker, sv.Date, formatFundamental(float64(sv.ShortVolume)),
+				formatFundamental(float64(sv.TotalVolume)), sv.ShortVolumeRatio,
+				formatFundamental(float64(sv.ExemptVolume)),
+				formatFundamental(float64(sv.NonExemptVolume)))
 		}
 		w.Flush()
 
@@ -164,6 +435,10 @@ var stocksFloatCmd = &cobra.Command{
 			Sort:   sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetFloat(params)
 		if err != nil {
 			return err
@@ -173,15 +448,14 @@ var stocksFloatCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Float Results: %d\n\n", len(result.Results))
+		printSummary("Float Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tEFFECTIVE DATE\tFREE FLOAT\tFREE FLOAT %")
-		fmt.Fprintln(w, "------\t--------------\t----------\t------------")
+		writeHeader(w, "TICKER\tEFFECTIVE DATE\tFREE FLOAT\tFREE FLOAT %", "------\t--------------\t----------\t------------")
 
 		for _, f := range result.Results {
-			fmt.Fprintf(w, "%s\t%s\t%d\t%.2f%%\n",
-				f.Ticker, f.EffectiveDate, f.FreeFloat, f.FreeFloatPercent)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.2f%%\n",
+				f.Ticker, f.EffectiveDate, formatFundamental(float64(f.FreeFloat)), f.FreeFloatPercent)
 		}
 		w.Flush()
 
@@ -221,6 +495,10 @@ var stocksBalanceSheetsCmd = &cobra.Command{
 			Sort:      sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetBalanceSheets(params)
 		if err != nil {
 			return err
@@ -230,18 +508,17 @@ var stocksBalanceSheetsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Balance Sheet Results: %d\n\n", len(result.Results))
+		printSummary("Balance Sheet Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKERS\tPERIOD END\tTIMEFRAME\tTOTAL ASSETS\tTOTAL LIABILITIES\tTOTAL EQUITY\tCASH")
-		fmt.Fprintln(w, "-------\t----------\t---------\t------------\t-----------------\t------------\t----")
+		writeHeader(w, "TICKERS\tPERIOD END\tTIMEFRAME\tTOTAL ASSETS\tTOTAL LIABILITIES\tTOTAL EQUITY\tCASH", "-------\t----------\t---------\t------------\t-----------------\t------------\t----")
 
 		for _, bs := range result.Results {
 			tickerStr := strings.Join(bs.Tickers, ",")
-			fmt.Fprintf(w, "%s\t%s\t%s\t$%.0f\t$%.0f\t$%.0f\t$%.0f\n",
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 				tickerStr, bs.PeriodEnd, bs.Timeframe,
-				bs.TotalAssets, bs.TotalLiabilities, bs.TotalEquity,
-				bs.CashAndEquivalents)
+				formatOptionalFundamental(bs.TotalAssets), formatOptionalFundamental(bs.TotalLiabilities),
+				formatOptionalFundamental(bs.TotalEquity), formatOptionalFundamental(bs.CashAndEquivalents))
 		}
 		w.Flush()
 
@@ -281,6 +558,10 @@ var stocksIncomeStatementsCmd = &cobra.Command{
 			Sort:      sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetIncomeStatements(params)
 		if err != nil {
 			return err
@@ -290,18 +571,18 @@ var stocksIncomeStatementsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Income Statement Results: %d\n\n", len(result.Results))
+		printSummary("Income Statement Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKERS\tPERIOD END\tTIMEFRAME\tREVENUE\tGROSS PROFIT\tOPERATING INCOME\tNET INCOME\tEPS")
-		fmt.Fprintln(w, "-------\t----------\t---------\t-------\t------------\t----------------\t----------\t---")
+		writeHeader(w, "TICKERS\tPERIOD END\tTIMEFRAME\tREVENUE\tGROSS PROFIT\tOPERATING INCOME\tNET INCOME\tEPS", "-------\t----------\t---------\t-------\t------------\t----------------\t----------\t---")
 
 		for _, is := range result.Results {
 			tickerStr := strings.Join(is.Tickers, ",")
-			fmt.Fprintf(w, "%s\t%s\t%s\t$%.0f\t$%.0f\t$%.0f\t$%.0f\t$%.2f\n",
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 				tickerStr, is.PeriodEnd, is.Timeframe,
-				is.Revenue, is.GrossProfit, is.OperatingIncome,
-				is.ConsolidatedNetIncomeLoss, is.DilutedEarningsPerShare)
+				formatOptionalFundamental(is.Revenue), formatOptionalFundamental(is.GrossProfit),
+				formatOptionalFundamental(is.OperatingIncome), formatOptionalFundamental(is.ConsolidatedNetIncomeLoss),
+				formatOptional(is.DilutedEarningsPerShare, "$%.2f"))
 		}
 		w.Flush()
 
@@ -341,6 +622,10 @@ var stocksCashFlowStatementsCmd = &cobra.Command{
 			Sort:      sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetCashFlowStatements(params)
 		if err != nil {
 			return err
@@ -350,20 +635,19 @@ var stocksCashFlowStatementsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Cash Flow Statement Results: %d\n\n", len(result.Results))
+		printSummary("Cash Flow Statement Results: %d", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKERS\tPERIOD END\tTIMEFRAME\tOPERATING\tINVESTING\tFINANCING\tNET CHANGE")
-		fmt.Fprintln(w, "-------\t----------\t---------\t---------\t---------\t---------\t----------")
+		writeHeader(w, "TICKERS\tPERIOD END\tTIMEFRAME\tOPERATING\tINVESTING\tFINANCING\tNET CHANGE", "-------\t----------\t---------\t---------\t---------\t---------\t----------")
 
 		for _, cf := range result.Results {
 			tickerStr := strings.Join(cf.Tickers, ",")
-			fmt.Fprintf(w, "%s\t%s\t%s\t$%.0f\t$%.0f\t$%.0f\t$%.0f\n",
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 				tickerStr, cf.PeriodEnd, cf.Timeframe,
-				cf.NetCashFromOperatingActivities,
-				cf.NetCashFromInvestingActivities,
-				cf.NetCashFromFinancingActivities,
-				cf.ChangeInCashAndEquivalents)
+				formatOptionalFundamental(cf.NetCashFromOperatingActivities),
+				formatOptionalFundamental(cf.NetCashFromInvestingActivities),
+				formatOptionalFundamental(cf.NetCashFromFinancingActivities),
+				formatOptionalFundamental(cf.ChangeInCashAndEquivalents))
 		}
 		w.Flush()
 
@@ -399,6 +683,10 @@ var stocksRatiosCmd = &cobra.Command{
 			Sort:   sort,
 		}
 
+		if jsonNumber {
+			return printRawJSON(client, params)
+		}
+
 		result, err := client.GetRatios(params)
 		if err != nil {
 			return err
@@ -408,18 +696,18 @@ var stocksRatiosCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Financial Ratios Results: %d\n\n", result.Count)
+		printSummary("Financial Ratios Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDATE\tPRICE\tMKT CAP\tP/E\tP/B\tP/S\tDIV YIELD\tROE\tROA\tD/E\tCURRENT")
-		fmt.Fprintln(w, "------\t----\t-----\t-------\t---\t---\t---\t---------\t---\t---\t---\t-------")
+		writeHeader(w, "TICKER\tDATE\tPRICE\tMKT CAP\tP/E\tP/B\tP/S\tDIV YIELD\tROE\tROA\tD/E\tCURRENT", "------\t----\t-----\t-------\t---\t---\t---\t---------\t---\t---\t---\t-------")
 
 		for _, r := range result.Results {
-			fmt.Fprintf(w, "%s\t%s\t$%.2f\t$%.0f\t%.2f\t%.2f\t%.2f\t%.2f%%\t%.2f%%\t%.2f%%\t%.2f\t%.2f\n",
-				r.Ticker, r.Date, r.Price, r.MarketCap,
-				r.PriceToEarnings, r.PriceToBook, r.PriceToSales,
-				r.DividendYield, r.ReturnOnEquity, r.ReturnOnAssets,
-				r.DebtToEquity, r.Current)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				r.Ticker, r.Date, formatOptional(r.Price, "$%.2f"), formatOptionalFundamental(r.MarketCap),
+				formatOptional(r.PriceToEarnings, "%.2f"), formatOptional(r.PriceToBook, "%.2f"),
+				formatOptional(r.PriceToSales, "%.2f"), formatOptional(r.DividendYield, "%.2f%%"),
+				formatOptional(r.ReturnOnEquity, "%.2f%%"), formatOptional(r.ReturnOnAssets, "%.2f%%"),
+				formatOptional(r.DebtToEquity, "%.2f"), formatOptional(r.Current, "%.2f"))
 		}
 		w.Flush()
 
@@ -435,6 +723,7 @@ var stocksRatiosCmd = &cobra.Command{
 // all fundamentals subcommands with their respective flags.
 func init() {
 	// Register parent fundamentals command under stocks
+	stocksFundamentalsCmd.PersistentFlags().BoolVar(&fundamentalsHumanize, "humanize", false, "Abbreviate large numbers in table output (e.g. 391.04B)")
 	stocksCmd.AddCommand(stocksFundamentalsCmd)
 
 	// Short Interest flags
@@ -442,6 +731,7 @@ func init() {
 	stocksShortInterestCmd.Flags().String("settlement-date", "", "Settlement date (YYYY-MM-DD)")
 	stocksShortInterestCmd.Flags().String("limit", "10", "Number of results to return (max 50000)")
 	stocksShortInterestCmd.Flags().String("sort", "", "Sort order (e.g., settlement_date.desc)")
+	supportsJSONNumber(stocksShortInterestCmd)
 	stocksFundamentalsCmd.AddCommand(stocksShortInterestCmd)
 
 	// Short Volume flags
@@ -449,12 +739,14 @@ func init() {
 	stocksShortVolumeCmd.Flags().String("date", "", "Date (YYYY-MM-DD)")
 	stocksShortVolumeCmd.Flags().String("limit", "10", "Number of results to return (max 50000)")
 	stocksShortVolumeCmd.Flags().String("sort", "", "Sort order (e.g., date.desc)")
+	supportsJSONNumber(stocksShortVolumeCmd)
 	stocksFundamentalsCmd.AddCommand(stocksShortVolumeCmd)
 
 	// Float flags
 	stocksFloatCmd.Flags().String("ticker", "", "Stock ticker symbol")
 	stocksFloatCmd.Flags().String("limit", "100", "Number of results to return (max 5000)")
 	stocksFloatCmd.Flags().String("sort", "ticker.asc", "Sort order (e.g., ticker.desc)")
+	supportsJSONNumber(stocksFloatCmd)
 	stocksFundamentalsCmd.AddCommand(stocksFloatCmd)
 
 	// Balance Sheets flags
@@ -463,6 +755,7 @@ func init() {
 	stocksBalanceSheetsCmd.Flags().String("timeframe", "", "Timeframe (quarterly, annual)")
 	stocksBalanceSheetsCmd.Flags().String("limit", "100", "Number of results to return (max 50000)")
 	stocksBalanceSheetsCmd.Flags().String("sort", "period_end.asc", "Sort order (e.g., period_end.desc)")
+	supportsJSONNumber(stocksBalanceSheetsCmd)
 	stocksFundamentalsCmd.AddCommand(stocksBalanceSheetsCmd)
 
 	// Income Statements flags
@@ -471,6 +764,7 @@ func init() {
 	stocksIncomeStatementsCmd.Flags().String("timeframe", "", "Timeframe (quarterly, annual, trailing_twelve_months)")
 	stocksIncomeStatementsCmd.Flags().String("limit", "100", "Number of results to return (max 50000)")
 	stocksIncomeStatementsCmd.Flags().String("sort", "period_end.asc", "Sort order (e.g., period_end.desc)")
+	supportsJSONNumber(stocksIncomeStatementsCmd)
 	stocksFundamentalsCmd.AddCommand(stocksIncomeStatementsCmd)
 
 	// Cash Flow Statements flags
@@ -479,11 +773,13 @@ func init() {
 	stocksCashFlowStatementsCmd.Flags().String("timeframe", "", "Timeframe (quarterly, annual, trailing_twelve_months)")
 	stocksCashFlowStatementsCmd.Flags().String("limit", "100", "Number of results to return (max 50000)")
 	stocksCashFlowStatementsCmd.Flags().String("sort", "period_end.asc", "Sort order (e.g., period_end.desc)")
+	supportsJSONNumber(stocksCashFlowStatementsCmd)
 	stocksFundamentalsCmd.AddCommand(stocksCashFlowStatementsCmd)
 
 	// Financial Ratios flags
 	stocksRatiosCmd.Flags().String("ticker", "", "Stock ticker symbol")
 	stocksRatiosCmd.Flags().String("limit", "100", "Number of results to return (max 50000)")
 	stocksRatiosCmd.Flags().String("sort", "", "Sort order (e.g., date.desc)")
+	supportsJSONNumber(stocksRatiosCmd)
 	stocksFundamentalsCmd.AddCommand(stocksRatiosCmd)
 }
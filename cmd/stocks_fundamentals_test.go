@@ -0,0 +1,37 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import "testing"
+
+// TestCheckMistypedSubcommand verifies a near miss on a fundamentals
+// subcommand is reported as a typo while tickers, including ones that
+// prefix a subcommand name, still run the overview.
+func TestCheckMistypedSubcommand(t *testing.T) {
+	tests := []struct {
+		arg     string
+		wantErr bool
+	}{
+		{"balance-sheet", true},
+		{"income-statement", true},
+		{"cash-flow", true},
+		{"short-volumes", true},
+		{"AAPL", false},
+		{"aapl", false},
+		{"F", false},
+		{"BRK.B", false},
+		{"GOOGL", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			err := checkMistypedSubcommand(stocksFundamentalsCmd, tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkMistypedSubcommand(%q) error = %v, wantErr %v", tt.arg, err, tt.wantErr)
+			}
+		})
+	}
+}
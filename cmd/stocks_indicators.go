@@ -8,6 +8,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -32,7 +33,10 @@ var stocksSMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetSMA(ticker, params)
 		if err != nil {
@@ -40,11 +44,11 @@ var stocksSMACmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printIndicatorTable(ticker, "SMA", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -64,7 +68,10 @@ var stocksEMACmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetEMA(ticker, params)
 		if err != nil {
@@ -72,11 +79,11 @@ var stocksEMACmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printIndicatorTable(ticker, "EMA", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -96,7 +103,10 @@ var stocksRSICmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		params := buildIndicatorParams(cmd)
+		params, err := buildIndicatorParams(cmd)
+		if err != nil {
+			return err
+		}
 
 		result, err := client.GetRSI(ticker, params)
 		if err != nil {
@@ -104,11 +114,11 @@ var stocksRSICmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printIndicatorTable(ticker, "RSI", result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
@@ -128,28 +138,9 @@ var stocksMACDCmd = &cobra.Command{
 		}
 
 		ticker := strings.ToUpper(args[0])
-		from, _ := cmd.Flags().GetString("from")
-		to, _ := cmd.Flags().GetString("to")
-		timespan, _ := cmd.Flags().GetString("timespan")
-		adjusted, _ := cmd.Flags().GetString("adjusted")
-		shortWindow, _ := cmd.Flags().GetString("short-window")
-		longWindow, _ := cmd.Flags().GetString("long-window")
-		signalWindow, _ := cmd.Flags().GetString("signal-window")
-		seriesType, _ := cmd.Flags().GetString("series-type")
-		order, _ := cmd.Flags().GetString("order")
-		limit, _ := cmd.Flags().GetString("limit")
-
-		params := api.MACDParams{
-			TimestampGTE: from,
-			TimestampLTE: to,
-			Timespan:     timespan,
-			Adjusted:     adjusted,
-			ShortWindow:  shortWindow,
-			LongWindow:   longWindow,
-			SignalWindow: signalWindow,
-			SeriesType:   seriesType,
-			Order:        order,
-			Limit:        limit,
+		params, err := buildMACDParams(cmd)
+		if err != nil {
+			return err
 		}
 
 		result, err := client.GetMACD(ticker, params)
@@ -158,26 +149,41 @@ var stocksMACDCmd = &cobra.Command{
 		}
 
 		if outputFormat == "json" {
-			return printJSON(result)
+			return printIndicatorJSON(cmd, client, result, result.Results.Underlying.URL)
 		}
 
 		printMACDTable(ticker, result)
-		return nil
+		return printIndicatorUnderlying(cmd, client, result.Results.Underlying.URL)
 	},
 }
 
 // buildIndicatorParams extracts the common indicator flags from the cobra
 // command and returns a populated IndicatorParams struct. This is shared
-// by the SMA, EMA, and RSI commands which all use the same parameters.
-func buildIndicatorParams(cmd *cobra.Command) api.IndicatorParams {
-	from, _ := cmd.Flags().GetString("from")
-	to, _ := cmd.Flags().GetString("to")
-	timespan, _ := cmd.Flags().GetString("timespan")
+// by the SMA, EMA, and RSI commands of every asset class, which all use the
+// same parameters. Returns an error if --window is not a positive integer.
+func buildIndicatorParams(cmd *cobra.Command) (api.IndicatorParams, error) {
+	from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+	if err != nil {
+		return api.IndicatorParams{}, err
+	}
+	to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+	if err != nil {
+		return api.IndicatorParams{}, err
+	}
+	timespan, err := timespanFlag(cmd)
+	if err != nil {
+		return api.IndicatorParams{}, err
+	}
 	adjusted, _ := cmd.Flags().GetString("adjusted")
 	window, _ := cmd.Flags().GetString("window")
 	seriesType, _ := cmd.Flags().GetString("series-type")
 	order, _ := cmd.Flags().GetString("order")
 	limit, _ := cmd.Flags().GetString("limit")
+	limit = clampLimit(api.LimitIndicators, limit)
+
+	if _, err := parseWindow("window", window); err != nil {
+		return api.IndicatorParams{}, err
+	}
 
 	return api.IndicatorParams{
 		TimestampGTE: from,
@@ -188,39 +194,124 @@ func buildIndicatorParams(cmd *cobra.Command) api.IndicatorParams {
 		SeriesType:   seriesType,
 		Order:        order,
 		Limit:        limit,
+	}, nil
+}
+
+// buildMACDParams extracts the MACD flags from the cobra command and returns
+// a populated MACDParams struct. This is shared by the MACD commands of every
+// asset class. Returns an error if the windows are invalid.
+func buildMACDParams(cmd *cobra.Command) (api.MACDParams, error) {
+	from, err := rangeBound(cmd, "from", "from-ts", time.Millisecond)
+	if err != nil {
+		return api.MACDParams{}, err
 	}
+	to, err := rangeBound(cmd, "to", "to-ts", time.Millisecond)
+	if err != nil {
+		return api.MACDParams{}, err
+	}
+	timespan, err := timespanFlag(cmd)
+	if err != nil {
+		return api.MACDParams{}, err
+	}
+	adjusted, _ := cmd.Flags().GetString("adjusted")
+	shortWindow, _ := cmd.Flags().GetString("short-window")
+	longWindow, _ := cmd.Flags().GetString("long-window")
+	signalWindow, _ := cmd.Flags().GetString("signal-window")
+	seriesType, _ := cmd.Flags().GetString("series-type")
+	order, _ := cmd.Flags().GetString("order")
+	limit, _ := cmd.Flags().GetString("limit")
+	limit = clampLimit(api.LimitIndicators, limit)
+
+	if err := validateMACDWindows(shortWindow, longWindow, signalWindow); err != nil {
+		return api.MACDParams{}, err
+	}
+
+	return api.MACDParams{
+		TimestampGTE: from,
+		TimestampLTE: to,
+		Timespan:     timespan,
+		Adjusted:     adjusted,
+		ShortWindow:  shortWindow,
+		LongWindow:   longWindow,
+		SignalWindow: signalWindow,
+		SeriesType:   seriesType,
+		Order:        order,
+		Limit:        limit,
+	}, nil
+}
+
+// parseWindow parses an indicator window flag value and checks that it is
+// a positive integer. The flag name is used in the error message.
+func parseWindow(flag, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("--%s must be a positive integer, got %q", flag, value)
+	}
+	return n, nil
+}
+
+// validateMACDWindows checks that the MACD short, long, and signal windows
+// are positive integers and that the short window is less than the long
+// window. Inverted windows are rejected since they produce a meaningless
+// MACD line.
+func validateMACDWindows(shortWindow, longWindow, signalWindow string) error {
+	short, err := parseWindow("short-window", shortWindow)
+	if err != nil {
+		return err
+	}
+
+	long, err := parseWindow("long-window", longWindow)
+	if err != nil {
+		return err
+	}
+
+	if _, err := parseWindow("signal-window", signalWindow); err != nil {
+		return err
+	}
+
+	if short >= long {
+		return fmt.Errorf("--short-window (%d) must be less than --long-window (%d)", short, long)
+	}
+
+	return nil
 }
 
 // printIndicatorTable renders a formatted table of indicator values for the
 // SMA, EMA, or RSI commands. Each row displays the date and computed value.
 func printIndicatorTable(ticker, indicator string, result *api.IndicatorResponse) {
-	fmt.Printf("Ticker: %s | Indicator: %s | Values: %d\n\n", ticker, indicator, len(result.Results.Values))
+	printSummary("Ticker: %s | Indicator: %s | Values: %d", ticker, indicator, len(result.Results.Values))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "DATE\tVALUE")
-	fmt.Fprintln(w, "----\t-----")
+	writeHeader(w, "DATE\tVALUE", "----\t-----")
 
+	var timestamps []int64
+	var values []float64
 	for _, v := range result.Results.Values {
-		t := time.UnixMilli(v.Timestamp)
-		fmt.Fprintf(w, "%s\t%.4f\n", t.Format("2006-01-02"), v.Value)
+		fmt.Fprintf(w, "%s\t%.4f\n", timestampCell(v.Timestamp, api.UnitMilliseconds, "2006-01-02"), v.Value)
+		timestamps = append(timestamps, v.Timestamp)
+		values = append(values, v.Value)
 	}
+	writeStatsFooter(w, 2, timestamps, statsColumn{Index: 1, Format: "%.4f", Values: values, Stats: []string{"MIN", "MAX", "MEAN", "LAST"}})
 	w.Flush()
 }
 
 // printMACDTable renders a formatted table of MACD indicator values including
 // the MACD line, signal line, and histogram for each data point.
 func printMACDTable(ticker string, result *api.MACDResponse) {
-	fmt.Printf("Ticker: %s | Indicator: MACD | Values: %d\n\n", ticker, len(result.Results.Values))
+	printSummary("Ticker: %s | Indicator: MACD | Values: %d", ticker, len(result.Results.Values))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "DATE\tMACD\tSIGNAL\tHISTOGRAM")
-	fmt.Fprintln(w, "----\t----\t------\t---------")
+	writeHeader(w, "DATE\tMACD\tSIGNAL\tHISTOGRAM", "----\t----\t------\t---------")
 
+	var timestamps []int64
+	var values []float64
 	for _, v := range result.Results.Values {
-		t := time.UnixMilli(v.Timestamp)
 		fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\n",
-			t.Format("2006-01-02"), v.Value, v.Signal, v.Histogram)
+			timestampCell(v.Timestamp, api.UnitMilliseconds, "2006-01-02"), v.Value, v.Signal, v.Histogram)
+		timestamps = append(timestamps, v.Timestamp)
+		values = append(values, v.Value)
 	}
+	writeStatsFooter(w, 4, timestamps, statsColumn{Index: 1, Format: "%.4f", Values: values, Stats: []string{"MIN", "MAX", "MEAN", "LAST"}})
 	w.Flush()
 }
 
@@ -235,10 +326,12 @@ func addIndicatorFlags(cmd *cobra.Command, defaultWindow string) {
 	cmd.Flags().String("window", defaultWindow, "Number of periods for the indicator calculation")
 	cmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	cmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	cmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(cmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(cmd)
 
 	cmd.MarkFlagRequired("from")
 	cmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(cmd, "from", "to")
 }
 
 // init registers the SMA, EMA, RSI, and MACD indicator subcommands and their
@@ -266,10 +359,12 @@ func init() {
 	stocksMACDCmd.Flags().String("signal-window", "9", "Signal line EMA period")
 	stocksMACDCmd.Flags().String("series-type", "close", "Price type for calculation (open, high, low, close)")
 	stocksMACDCmd.Flags().String("order", "desc", "Sort order by timestamp (asc/desc)")
-	stocksMACDCmd.Flags().String("limit", "10", "Max number of results (max 5000)")
+	addLimitFlag(stocksMACDCmd, api.LimitIndicators, "Max number of results")
+	addUnderlyingFlags(stocksMACDCmd)
 
 	stocksMACDCmd.MarkFlagRequired("from")
 	stocksMACDCmd.MarkFlagRequired("to")
+	addTimestampRangeFlags(stocksMACDCmd, "from", "to")
 
 	stocksCmd.AddCommand(stocksMACDCmd)
 }
@@ -0,0 +1,83 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseWindow verifies indicator windows must be positive integers
+// and that errors name the offending flag and value.
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"1", 1, false},
+		{"14", 14, false},
+		{"200", 200, false},
+		{"0", 0, true},
+		{"-5", 0, true},
+		{"", 0, true},
+		{"abc", 0, true},
+		{"12.5", 0, true},
+		{"1e2", 0, true},
+		{" 14", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseWindow("window", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseWindow(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			if !strings.Contains(err.Error(), "--window") || !strings.Contains(err.Error(), "\""+tt.value+"\"") {
+				t.Errorf("parseWindow(%q) error %q does not name the flag and value", tt.value, err)
+			}
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseWindow(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+// TestValidateMACDWindows verifies the short window must be below the
+// long window and that each window is checked in flag order.
+func TestValidateMACDWindows(t *testing.T) {
+	tests := []struct {
+		short, long, signal string
+		wantErr             string
+	}{
+		{"12", "26", "9", ""},
+		{"1", "2", "1", ""},
+		{"26", "26", "9", "--short-window (26) must be less than --long-window (26)"},
+		{"30", "26", "9", "--short-window (30) must be less than --long-window (26)"},
+		{"0", "26", "9", "--short-window must be a positive integer"},
+		{"12", "-26", "9", "--long-window must be a positive integer"},
+		{"12", "26", "0", "--signal-window must be a positive integer"},
+		{"fast", "26", "9", "--short-window must be a positive integer"},
+		{"12", "slow", "9", "--long-window must be a positive integer"},
+		{"12", "26", "x", "--signal-window must be a positive integer"},
+		{"x", "y", "z", "--short-window must be a positive integer"},
+	}
+
+	for _, tt := range tests {
+		err := validateMACDWindows(tt.short, tt.long, tt.signal)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("validateMACDWindows(%s, %s, %s) = %v, want nil", tt.short, tt.long, tt.signal, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("validateMACDWindows(%s, %s, %s) = %v, want %q", tt.short, tt.long, tt.signal, err, tt.wantErr)
+		}
+	}
+}
@@ -16,7 +16,8 @@ import (
 
 // stocksMarketCmd retrieves the grouped daily OHLC summary for all US
 // stocks on a specified date. Useful for broad market analysis and
-// screening. Usage: massive stocks market 2024-01-09
+// screening. --sort-by and --top narrow it to the most active names.
+// Usage: massive stocks market 2024-01-09 --sort-by volume --top 20
 var stocksMarketCmd = &cobra.Command{
 	Use:   "market [date]",
 	Short: "Get daily market summary for all stocks",
@@ -28,34 +29,41 @@ var stocksMarketCmd = &cobra.Command{
 			return err
 		}
 
+		screen, err := summaryScreenFlags(cmd)
+		if err != nil {
+			return err
+		}
+
 		date := args[0]
 		adjusted, _ := cmd.Flags().GetString("adjusted")
 		includeOTC, _ := cmd.Flags().GetString("include-otc")
+		locale, _ := cmd.Flags().GetString("locale")
 
 		params := api.MarketSummaryParams{
 			Adjusted:   adjusted,
 			IncludeOTC: includeOTC,
+			Locale:     locale,
 		}
 
 		result, err := client.GetMarketSummary(date, params)
 		if err != nil {
 			return err
 		}
+		screen.apply(result)
 
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Date: %s | Tickers: %d | Adjusted: %v\n\n", date, result.ResultsCount, result.Adjusted)
+		printSummary("Date: %s | Tickers: %d | Adjusted: %v", date, result.ResultsCount, result.Adjusted)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES")
-		fmt.Fprintln(w, "------\t----\t----\t---\t-----\t------\t----\t------")
+		writeHeader(w, "TICKER\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP\tTRADES", "------\t----\t----\t---\t-----\t------\t----\t------")
 
 		for _, s := range result.Results {
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%d\n",
-				s.Ticker, s.Open, s.High, s.Low, s.Close,
-				s.Volume, s.VWAP, s.NumTrades)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%d\n",
+				s.Ticker, priceCell("%.4f", s.Open), priceCell("%.4f", s.High), priceCell("%.4f", s.Low),
+				priceCell("%.4f", s.Close), s.Volume, priceCell("%.4f", s.VWAP), s.NumTrades)
 		}
 		w.Flush()
 
@@ -67,5 +75,7 @@ var stocksMarketCmd = &cobra.Command{
 func init() {
 	stocksMarketCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
 	stocksMarketCmd.Flags().String("include-otc", "false", "Include OTC securities (true/false)")
+	stocksMarketCmd.Flags().String("locale", "", "Market locale (US stocks are grouped under us)")
+	addSummaryScreenFlags(stocksMarketCmd)
 	stocksCmd.AddCommand(stocksMarketCmd)
 }
@@ -35,6 +35,7 @@ var stocksNewsCmd = &cobra.Command{
 		publishedTo, _ := cmd.Flags().GetString("published-to")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitNews, limit)
 		sort, _ := cmd.Flags().GetString("sort")
 
 		params := api.NewsParams{
@@ -57,7 +58,7 @@ var stocksNewsCmd = &cobra.Command{
 		}
 
 		// Display results count header
-		fmt.Printf("News Articles: %d\n\n", result.Count)
+		printSummary("News Articles: %d", result.Count)
 
 		if len(result.Results) == 0 {
 			fmt.Println("No news articles found.")
@@ -66,8 +67,7 @@ var stocksNewsCmd = &cobra.Command{
 
 		// Print each news article in a readable table format
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "DATE\tSOURCE\tTICKERS\tTITLE")
-		fmt.Fprintln(w, "----\t------\t-------\t-----")
+		writeHeader(w, "DATE\tSOURCE\tTICKERS\tTITLE", "----\t------\t-------\t-----")
 
 		for _, article := range result.Results {
 			// Format the published date to just the date portion
@@ -111,7 +111,7 @@ func init() {
 	stocksNewsCmd.Flags().String("published-from", "", "Filter articles published on or after this date (YYYY-MM-DD)")
 	stocksNewsCmd.Flags().String("published-to", "", "Filter articles published on or before this date (YYYY-MM-DD)")
 	stocksNewsCmd.Flags().String("order", "desc", "Sort order (asc/desc)")
-	stocksNewsCmd.Flags().String("limit", "10", "Number of results to return (max 1000)")
+	addLimitFlag(stocksNewsCmd, api.LimitNews, "Number of results to return")
 	stocksNewsCmd.Flags().String("sort", "published_utc", "Sort field (published_utc)")
 	stocksCmd.AddCommand(stocksNewsCmd)
 }
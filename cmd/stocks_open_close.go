@@ -41,13 +41,13 @@ var stocksOpenCloseCmd = &cobra.Command{
 
 		fmt.Printf("Symbol:      %s\n", result.Symbol)
 		fmt.Printf("Date:        %s\n", result.From)
-		fmt.Printf("Open:        $%.4f\n", result.Open)
-		fmt.Printf("High:        $%.4f\n", result.High)
-		fmt.Printf("Low:         $%.4f\n", result.Low)
-		fmt.Printf("Close:       $%.4f\n", result.Close)
+		fmt.Printf("Open:        $%s\n", priceCell("%.4f", result.Open))
+		fmt.Printf("High:        $%s\n", priceCell("%.4f", result.High))
+		fmt.Printf("Low:         $%s\n", priceCell("%.4f", result.Low))
+		fmt.Printf("Close:       $%s\n", priceCell("%.4f", result.Close))
 		fmt.Printf("Volume:      %d\n", result.Volume)
-		fmt.Printf("Pre-Market:  $%.4f\n", result.PreMarket)
-		fmt.Printf("After Hours: $%.4f\n", result.AfterHours)
+		fmt.Printf("Pre-Market:  $%s\n", priceCell("%.4f", result.PreMarket))
+		fmt.Printf("After Hours: $%s\n", priceCell("%.4f", result.AfterHours))
 
 		return nil
 	},
@@ -0,0 +1,136 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/analytics"
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// stocksRangeResult is the JSON form of a stock's high/low range. Dates
+// are the YYYY-MM-DD days of the bars that set each value, and
+// PositionPercent is nil when the high and low are equal.
+type stocksRangeResult struct {
+	Ticker          string   `json:"ticker"`
+	From            string   `json:"from"`
+	To              string   `json:"to"`
+	Bars            int      `json:"bars"`
+	High            float64  `json:"high"`
+	HighDate        string   `json:"high_date"`
+	Low             float64  `json:"low"`
+	LowDate         string   `json:"low_date"`
+	Last            float64  `json:"last"`
+	LastDate        string   `json:"last_date"`
+	PositionPercent *float64 `json:"position_percent"`
+	DaysSinceHigh   int      `json:"days_since_high"`
+	DaysSinceLow    int      `json:"days_since_low"`
+}
+
+// stocksRangeCmd reports a stock's 52-week high and low from a year of
+// daily bars: where the latest close sits within the range and how many
+// days ago each extreme was set. --from accepts relative windows such as
+// 6m for a shorter range.
+// Usage: massive stocks range AAPL
+var stocksRangeCmd = &cobra.Command{
+	Use:   "range [ticker]",
+	Short: "Get the 52-week high/low range for a stock ticker",
+	Long:  "Compute the 52-week high and low client-side from daily bars, with the latest close's position within the range as a percentage (0 at the low, 100 at the high) and the days since each extreme.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+		now := time.Now()
+		fromFlag, _ := cmd.Flags().GetString("from")
+		from, err := relativeDate(fromFlag, now)
+		if err != nil {
+			return fmt.Errorf("--from: %w", err)
+		}
+		to, _ := cmd.Flags().GetString("to")
+		if to == "" {
+			to = now.Format("2006-01-02")
+		}
+		adjusted, _ := cmd.Flags().GetString("adjusted")
+
+		params := api.BarsParams{
+			Multiplier: "1",
+			Timespan:   "day",
+			From:       from,
+			To:         to,
+			Adjusted:   adjusted,
+			Sort:       "asc",
+			Limit:      "50000",
+		}
+
+		result, err := client.GetBars(ticker, params)
+		if err != nil {
+			return err
+		}
+
+		r, ok := analytics.Range(result.Results)
+		if !ok {
+			return fmt.Errorf("no daily bars for %s between %s and %s", ticker, from, to)
+		}
+
+		day := func(ts int64) string { return api.FormatTimestamp(ts, api.UnitMilliseconds, "2006-01-02") }
+		out := stocksRangeResult{
+			Ticker:        ticker,
+			From:          from,
+			To:            to,
+			Bars:          len(result.Results),
+			High:          r.High,
+			HighDate:      day(r.HighTimestamp),
+			Low:           r.Low,
+			LowDate:       day(r.LowTimestamp),
+			Last:          r.Last,
+			LastDate:      day(r.LastTimestamp),
+			DaysSinceHigh: r.DaysSinceHigh,
+			DaysSinceLow:  r.DaysSinceLow,
+		}
+		if !math.IsNaN(r.Position) {
+			out.PositionPercent = &r.Position
+		}
+
+		if outputFormat == "json" {
+			return printJSON(out)
+		}
+
+		printSummary("Ticker: %s | Range: %s to %s | Bars: %d | Adjusted: %v", ticker, from, to, out.Bars, result.Adjusted)
+
+		fmt.Printf("High:        $%s on %s (%d days ago)\n", priceCell("%.4f", out.High), out.HighDate, out.DaysSinceHigh)
+		fmt.Printf("Low:         $%s on %s (%d days ago)\n", priceCell("%.4f", out.Low), out.LowDate, out.DaysSinceLow)
+		fmt.Printf("Last:        $%s on %s\n", priceCell("%.4f", out.Last), out.LastDate)
+		fmt.Printf("Position:    %s\n", formatRangePosition(out.PositionPercent))
+
+		return nil
+	},
+}
+
+// formatRangePosition renders the last close's position within the range
+// as a percentage, or N/A when the range is flat.
+func formatRangePosition(position *float64) string {
+	if position == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.2f%%", *position)
+}
+
+// init registers the range command and its flags under the stocks parent command.
+func init() {
+	stocksRangeCmd.Flags().String("from", "1y", "Start of the window as YYYY-MM-DD or relative to today (30d, 12w, 6m, 1y)")
+	stocksRangeCmd.Flags().String("to", "", "End of the window as YYYY-MM-DD (default today)")
+	stocksRangeCmd.Flags().String("adjusted", "true", "Adjust for splits (true/false)")
+	stocksCmd.AddCommand(stocksRangeCmd)
+}
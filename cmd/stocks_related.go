@@ -0,0 +1,174 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// stocksRelatedCompany is one related ticker enriched with its name and
+// current price from the unified snapshot endpoint. Price and change are
+// omitted when the snapshot has none.
+type stocksRelatedCompany struct {
+	Ticker        string   `json:"ticker"`
+	Name          string   `json:"name,omitempty"`
+	Price         *float64 `json:"price,omitempty"`
+	ChangePercent *float64 `json:"change_percent,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// stocksRelatedResponse is the JSON output of stocks related --prices,
+// mirroring the related companies envelope.
+type stocksRelatedResponse struct {
+	Status      string                 `json:"status"`
+	RequestID   string                 `json:"request_id"`
+	StockSymbol string                 `json:"stock_symbol"`
+	Results     []stocksRelatedCompany `json:"results"`
+}
+
+// stocksRelatedCmd lists the companies related to a stock ticker, such as
+// peers and competitors. With --prices each related ticker is enriched
+// with its name and current price from batched unified snapshots.
+// Usage: massive stocks related AAPL --prices
+var stocksRelatedCmd = &cobra.Command{
+	Use:   "related [ticker]",
+	Short: "List companies related to a stock ticker",
+	Long:  "Retrieve the tickers of companies related to a stock ticker, such as peers and competitors. Add --prices to show each company's name and current price.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ticker := strings.ToUpper(args[0])
+
+		result, err := client.GetRelatedCompanies(ticker)
+		if err != nil {
+			return err
+		}
+
+		prices, _ := cmd.Flags().GetBool("prices")
+		if !prices {
+			if outputFormat == "json" {
+				return printJSON(result)
+			}
+
+			printSummary("Related to %s: %d tickers", ticker, len(result.Results))
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			writeHeader(w, "TICKER", "------")
+			for _, r := range result.Results {
+				fmt.Fprintf(w, "%s\n", r.Ticker)
+			}
+			w.Flush()
+
+			return nil
+		}
+
+		related, err := enrichRelatedCompanies(cmd, client, result.Results)
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == "json" {
+			return printJSON(stocksRelatedResponse{
+				Status:      result.Status,
+				RequestID:   result.RequestID,
+				StockSymbol: result.StockSymbol,
+				Results:     related,
+			})
+		}
+
+		printSummary("Related to %s: %d tickers", ticker, len(related))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeHeader(w, "TICKER\tPRICE\tCHANGE%\tNAME", "------\t-----\t-------\t----")
+		for _, r := range related {
+			price, changePct := "-", "-"
+			if r.Price != nil {
+				price = priceCell("%.4f", *r.Price)
+			}
+			if r.ChangePercent != nil {
+				changePct = fmt.Sprintf("%.2f%%", *r.ChangePercent)
+			}
+			name := truncateString(r.Name, 30)
+			if r.Error != "" {
+				name = r.Error
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Ticker, price, changePct, name)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// enrichRelatedCompanies fetches unified snapshots for the related tickers
+// in batches and attaches each ticker's name, price, and day change. A
+// ticker the snapshot endpoint rejects keeps its error instead of a price.
+func enrichRelatedCompanies(cmd *cobra.Command, client *api.Client, companies []api.RelatedCompany) ([]stocksRelatedCompany, error) {
+	related := make([]stocksRelatedCompany, len(companies))
+	if len(companies) == 0 {
+		return related, nil
+	}
+
+	tickers := make([]string, len(companies))
+	for i, c := range companies {
+		tickers[i] = c.Ticker
+	}
+
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	snapshots, err := fetchUnifiedSnapshots(client, tickers, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	byTicker := make(map[string]api.UniversalSnapshot, len(snapshots.Results))
+	for _, s := range snapshots.Results {
+		byTicker[s.Ticker] = s
+	}
+
+	for i, ticker := range tickers {
+		related[i] = stocksRelatedCompany{Ticker: ticker}
+
+		s, ok := byTicker[ticker]
+		if !ok {
+			continue
+		}
+		if s.Error != "" {
+			related[i].Error = s.Error
+			continue
+		}
+
+		related[i].Name = s.Name
+		if price := s.Price(); price != 0 {
+			related[i].Price = &price
+		}
+		if s.Session != nil {
+			changePct := s.Session.ChangePercent
+			related[i].ChangePercent = &changePct
+		}
+	}
+
+	return related, nil
+}
+
+// init registers the related command and its flags under the stocks
+// parent command.
+func init() {
+	stocksRelatedCmd.Flags().Bool("prices", false, "Add each related ticker's name, current price, and day change from unified snapshots")
+	stocksRelatedCmd.Flags().Int("concurrency", 4, "Maximum parallel snapshot requests with --prices")
+
+	useTickerIndex(stocksRelatedCmd, "stocks")
+	stocksCmd.AddCommand(stocksRelatedCmd)
+}
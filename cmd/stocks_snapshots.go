@@ -45,28 +45,32 @@ var stocksSnapshotsTickerCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, stocksSnapshotAges(result.Ticker)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
 		t := result.Ticker
-		fmt.Printf("Ticker: %s | Change: %.4f (%.2f%%)\n\n", t.Ticker, t.TodaysChange, t.TodaysChangePct)
+		fmt.Printf("Ticker: %s | Change: %s (%.2f%%)\n\n", t.Ticker, priceCell("%.4f", t.TodaysChange), t.TodaysChangePct)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "PERIOD\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP")
-		fmt.Fprintln(w, "------\t----\t----\t---\t-----\t------\t----")
+		writeHeader(w, "PERIOD\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME\tVWAP", "------\t----\t----\t---\t-----\t------\t----")
 
-		fmt.Fprintf(w, "Day\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\n",
-			t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-			t.Day.Volume, t.Day.VWAP)
+		fmt.Fprintf(w, "Day\t%s\t%s\t%s\t%s\t%.0f\t%s\n",
+			priceCell("%.4f", t.Day.Open), priceCell("%.4f", t.Day.High), priceCell("%.4f", t.Day.Low),
+			priceCell("%.4f", t.Day.Close), t.Day.Volume, priceCell("%.4f", t.Day.VWAP))
 
-		fmt.Fprintf(w, "Prev Day\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\n",
-			t.PrevDay.Open, t.PrevDay.High, t.PrevDay.Low, t.PrevDay.Close,
-			t.PrevDay.Volume, t.PrevDay.VWAP)
+		fmt.Fprintf(w, "Prev Day\t%s\t%s\t%s\t%s\t%.0f\t%s\n",
+			priceCell("%.4f", t.PrevDay.Open), priceCell("%.4f", t.PrevDay.High),
+			priceCell("%.4f", t.PrevDay.Low), priceCell("%.4f", t.PrevDay.Close),
+			t.PrevDay.Volume, priceCell("%.4f", t.PrevDay.VWAP))
 
-		fmt.Fprintf(w, "Minute\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\n",
-			t.Min.Open, t.Min.High, t.Min.Low, t.Min.Close,
-			t.Min.Volume, t.Min.VWAP)
+		fmt.Fprintf(w, "Minute\t%s\t%s\t%s\t%s\t%.0f\t%s\n",
+			priceCell("%.4f", t.Min.Open), priceCell("%.4f", t.Min.High), priceCell("%.4f", t.Min.Low),
+			priceCell("%.4f", t.Min.Close), t.Min.Volume, priceCell("%.4f", t.Min.VWAP))
 
 		w.Flush()
 
@@ -101,20 +105,24 @@ var stocksSnapshotsAllCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, stocksSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Tickers: %d\n\n", result.Count)
+		printSummary("Tickers: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %")
-		fmt.Fprintln(w, "------\t--------\t--------\t-------\t---------\t------\t------\t--------")
+		writeHeader(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %", "------\t--------\t--------\t-------\t---------\t------\t------\t--------")
 
 		for _, t := range result.Tickers {
-			fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%.2f%%\n",
-				t.Ticker, t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-				t.Day.Volume, t.TodaysChange, t.TodaysChangePct)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%.2f%%\n",
+				t.Ticker, priceCell("%.4f", t.Day.Open), priceCell("%.4f", t.Day.High),
+				priceCell("%.4f", t.Day.Low), priceCell("%.4f", t.Day.Close),
+				t.Day.Volume, priceCell("%.4f", t.TodaysChange), t.TodaysChangePct)
 		}
 		w.Flush()
 
@@ -147,6 +155,10 @@ var stocksSnapshotsGainersCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, stocksSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -180,6 +192,10 @@ var stocksSnapshotsLosersCmd = &cobra.Command{
 			return err
 		}
 
+		if err := checkSnapshotAges(cmd, stocksSnapshotAges(result.Tickers...)); err != nil {
+			return err
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
@@ -192,16 +208,16 @@ var stocksSnapshotsLosersCmd = &cobra.Command{
 // snapshot data to stdout. The title parameter labels the output as either
 // "Gainers" or "Losers" for display clarity.
 func printGainersLosersTable(title string, result *api.GainersLosersSnapshotResponse) error {
-	fmt.Printf("Top %s: %d tickers\n\n", title, len(result.Tickers))
+	printSummary("Top %s: %d tickers", title, len(result.Tickers))
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %")
-	fmt.Fprintln(w, "------\t--------\t--------\t-------\t---------\t------\t------\t--------")
+	writeHeader(w, "TICKER\tDAY OPEN\tDAY HIGH\tDAY LOW\tDAY CLOSE\tVOLUME\tCHANGE\tCHANGE %", "------\t--------\t--------\t-------\t---------\t------\t------\t--------")
 
 	for _, t := range result.Tickers {
-		fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\t%.4f\t%.2f%%\n",
-			t.Ticker, t.Day.Open, t.Day.High, t.Day.Low, t.Day.Close,
-			t.Day.Volume, t.TodaysChange, t.TodaysChangePct)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f\t%s\t%.2f%%\n",
+			t.Ticker, priceCell("%.4f", t.Day.Open), priceCell("%.4f", t.Day.High),
+			priceCell("%.4f", t.Day.Low), priceCell("%.4f", t.Day.Close),
+			t.Day.Volume, priceCell("%.4f", t.TodaysChange), t.TodaysChangePct)
 	}
 	w.Flush()
 
@@ -218,10 +234,11 @@ func init() {
 
 	stocksSnapshotsLosersCmd.Flags().String("include-otc", "false", "Include OTC securities (true/false)")
 
-	stocksSnapshotsCmd.AddCommand(stocksSnapshotsTickerCmd)
-	stocksSnapshotsCmd.AddCommand(stocksSnapshotsAllCmd)
-	stocksSnapshotsCmd.AddCommand(stocksSnapshotsGainersCmd)
-	stocksSnapshotsCmd.AddCommand(stocksSnapshotsLosersCmd)
+	useTickerIndex(stocksSnapshotsTickerCmd, "stocks")
+	for _, c := range []*cobra.Command{stocksSnapshotsTickerCmd, stocksSnapshotsAllCmd, stocksSnapshotsGainersCmd, stocksSnapshotsLosersCmd} {
+		addMaxAgeFlags(c)
+		stocksSnapshotsCmd.AddCommand(c)
+	}
 
 	stocksCmd.AddCommand(stocksSnapshotsCmd)
 }
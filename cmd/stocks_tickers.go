@@ -21,7 +21,7 @@ import (
 var stocksTickersCmd = &cobra.Command{
 	Use:   "tickers",
 	Short: "List and search stock tickers",
-	Long:  "Retrieve a list of stock tickers with optional filtering by symbol, name, type, market, exchange, and active status.",
+	Long:  "Retrieve a list of stock tickers with optional filtering by symbol, name, type, market, exchange, active status, as-of date, and OTC inclusion.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, err := newClient()
 		if err != nil {
@@ -34,9 +34,13 @@ var stocksTickersCmd = &cobra.Command{
 		market, _ := cmd.Flags().GetString("market")
 		exchange, _ := cmd.Flags().GetString("exchange")
 		active, _ := cmd.Flags().GetString("active")
+		locale, _ := cmd.Flags().GetString("locale")
+		date, _ := cmd.Flags().GetString("date")
+		includeOTC, _ := cmd.Flags().GetBool("include-otc")
 		sort, _ := cmd.Flags().GetString("sort")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTickers, limit)
 
 		params := api.TickerParams{
 			Ticker:   ticker,
@@ -45,10 +49,15 @@ var stocksTickersCmd = &cobra.Command{
 			Market:   market,
 			Exchange: exchange,
 			Active:   active,
+			Locale:   locale,
+			Date:     date,
 			Sort:     sort,
 			Order:    order,
 			Limit:    limit,
 		}
+		if includeOTC {
+			params.IncludeOTC = "true"
+		}
 
 		result, err := client.GetTickers(params)
 		if err != nil {
@@ -59,11 +68,10 @@ var stocksTickersCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Results: %d\n\n", result.Count)
+		printSummary("Results: %d", result.Count)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tNAME\tTYPE\tEXCHANGE\tACTIVE")
-		fmt.Fprintln(w, "------\t----\t----\t--------\t------")
+		writeHeader(w, "TICKER\tNAME\tTYPE\tEXCHANGE\tACTIVE", "------\t----\t----\t--------\t------")
 
 		for _, t := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\n",
@@ -82,9 +90,12 @@ func init() {
 	stocksTickersCmd.Flags().String("type", "", "Filter by ticker type (CS, ETF, etc.)")
 	stocksTickersCmd.Flags().String("market", "", "Filter by market (stocks, crypto, fx)")
 	stocksTickersCmd.Flags().String("exchange", "", "Filter by primary exchange")
-	stocksTickersCmd.Flags().String("active", "", "Filter by active status (true/false)")
+	stocksTickersCmd.Flags().String("active", "", "Filter by active status (true, false, or all for both)")
+	stocksTickersCmd.Flags().String("locale", "", "Filter by locale (us, global)")
+	stocksTickersCmd.Flags().String("date", "", "List tickers as of a past date (YYYY-MM-DD)")
+	stocksTickersCmd.Flags().Bool("include-otc", false, "Include OTC securities (stocks or otc market only)")
 	stocksTickersCmd.Flags().String("sort", "ticker", "Sort field (ticker, name, market, type)")
 	stocksTickersCmd.Flags().String("order", "asc", "Sort order (asc/desc)")
-	stocksTickersCmd.Flags().String("limit", "20", "Number of results to return (max 1000)")
+	addLimitFlag(stocksTickersCmd, api.LimitTickers, "Number of results to return")
 	stocksCmd.AddCommand(stocksTickersCmd)
 }
@@ -7,6 +7,7 @@ package cmd
 
 import (
 	"fmt"
+	"iter"
 	"os"
 	"strings"
 	"text/tabwriter"
@@ -19,11 +20,13 @@ import (
 // stocksTradesCmd retrieves tick-level trade data for a specific stock ticker
 // with optional timestamp filtering, sorting, and pagination. Each trade
 // includes price, size, exchange, trade conditions, and nanosecond timestamps.
+// The --all flag follows pagination until every page has been fetched;
+// with --output csv or ndjson each page is written as it arrives.
 // Usage: massive stocks trades AAPL --timestamp 2025-01-06 --limit 10
 var stocksTradesCmd = &cobra.Command{
 	Use:   "trades [ticker]",
 	Short: "Get tick-level trade data for a stock ticker",
-	Long:  "Retrieve tick-level trade data for a stock ticker including price, size, exchange, conditions, and precise timestamps.",
+	Long:  "Retrieve tick-level trade data for a stock ticker including price, size, exchange, conditions, and precise timestamps. With --output csv or ndjson, trades are written page by page as they arrive, so --all can export a full history without holding it in memory.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, err := newClient()
@@ -33,13 +36,21 @@ var stocksTradesCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		timestamp, _ := cmd.Flags().GetString("timestamp")
-		timestampGte, _ := cmd.Flags().GetString("timestamp-gte")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
-		timestampLte, _ := cmd.Flags().GetString("timestamp-lte")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitTrades, limit)
 		sort, _ := cmd.Flags().GetString("sort")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.TradesParams{
 			Timestamp:    timestamp,
@@ -50,35 +61,102 @@ var stocksTradesCmd = &cobra.Command{
 			Order:        order,
 			Limit:        limit,
 			Sort:         sort,
+			Cursor:       cursor,
 		}
 
-		result, err := client.GetTrades(ticker, params)
-		if err != nil {
+		all, _ := cmd.Flags().GetBool("all")
+		if err := confirmExplain(tickExplanation{
+			Kind:         "trades",
+			Ticker:       ticker,
+			Timestamp:    timestamp,
+			TimestampGte: timestampGte,
+			TimestampGt:  timestampGt,
+			TimestampLte: timestampLte,
+			TimestampLt:  timestampLt,
+			Sort:         sort,
+			Order:        order,
+			Limit:        limit,
+			All:          all,
+		}); err != nil {
 			return err
 		}
 
+		// Pages come from next_url until the last one when --all is set,
+		// backing off whenever the API reports the rate limit is exhausted.
+		pages := client.TradesPages(api.NewAdaptiveFetcher(client, 1), ticker, params, all)
+		if streaming() {
+			return streamTrades(pages)
+		}
+
+		var result *api.TradesResponse
+		for page, err := range pages {
+			if err != nil {
+				if result == nil {
+					return err
+				}
+				return withResumeCursor(err, result.NextURL)
+			}
+			if result == nil {
+				result = page
+				continue
+			}
+			result.Results = append(result.Results, page.Results...)
+			result.NextURL = page.NextURL
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Trades: %d\n\n", ticker, len(result.Results))
+		printSummary("Ticker: %s | Trades: %d", ticker, len(result.Results))
 
+		legend, _ := cmd.Flags().GetBool("legend")
+		header, sep := legendColumns(legend, "TIMESTAMP\tPRICE\tSIZE\tEXCHANGE\tTAPE\tID", "---------\t-----\t----\t--------\t----\t--")
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tPRICE\tSIZE\tEXCHANGE\tTAPE\tID")
-		fmt.Fprintln(w, "---------\t-----\t----\t--------\t----\t--")
-
-		for _, trade := range result.Results {
-			t := time.Unix(0, trade.SipTimestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.0f\t%d\t%d\t%s\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				trade.Price, trade.Size, trade.Exchange, trade.Tape, trade.ID)
+		writeHeader(w, header, sep)
+
+		conditions := make([][]int, len(result.Results))
+		for i, trade := range result.Results {
+			row := fmt.Sprintf("%s\t%s\t%.0f\t%d\t%d\t%s",
+				timestampCell(trade.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", trade.Price), trade.Size, trade.Exchange, trade.Tape, trade.ID)
+			if legend {
+				row += "\t" + conditionsCell(trade.Conditions)
+			}
+			fmt.Fprintln(w, row)
+			conditions[i] = trade.Conditions
 		}
 		w.Flush()
+		if legend {
+			printConditionLegend(client, "stocks", conditionTrade, conditions)
+		}
+		printNextCursor(result.NextURL)
 
 		return nil
 	},
 }
 
+// streamTrades writes each page of trades to stdout as CSV or NDJSON as
+// soon as it arrives, so a --all pull of millions of trades never holds
+// more than one page in memory.
+func streamTrades(pages iter.Seq2[*api.TradesResponse, error]) error {
+	stream := newRecordStream(os.Stdout, outputFormat, tradeColumns, tradeRow)
+
+	var next string
+	for page, err := range pages {
+		if err != nil {
+			return withResumeCursor(err, next)
+		}
+		if err := stream.Write(page.Results); err != nil {
+			return err
+		}
+		next = page.NextURL
+	}
+
+	printStreamCursor(next)
+	return nil
+}
+
 // stocksLastTradeCmd retrieves the most recent trade for a specific stock
 // ticker. Returns price, size, exchange, and timestamp information useful
 // for monitoring current market activity.
@@ -106,15 +184,14 @@ var stocksLastTradeCmd = &cobra.Command{
 		}
 
 		trade := result.Results
-		t := time.Unix(0, trade.SipTimestamp)
 
 		fmt.Printf("Ticker:    %s\n", trade.Ticker)
-		fmt.Printf("Price:     $%.4f\n", trade.Price)
+		fmt.Printf("Price:     $%s\n", priceCell("%.4f", trade.Price))
 		fmt.Printf("Size:      %.0f\n", trade.Size)
 		fmt.Printf("Exchange:  %d\n", trade.Exchange)
 		fmt.Printf("Tape:      %d\n", trade.Tape)
 		fmt.Printf("Trade ID:  %s\n", trade.ID)
-		fmt.Printf("Timestamp: %s\n", t.Format("2006-01-02 15:04:05.000"))
+		fmt.Printf("Timestamp: %s\n", timestampCell(trade.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
 
 		return nil
 	},
@@ -137,13 +214,21 @@ var stocksQuotesCmd = &cobra.Command{
 
 		ticker := strings.ToUpper(args[0])
 		timestamp, _ := cmd.Flags().GetString("timestamp")
-		timestampGte, _ := cmd.Flags().GetString("timestamp-gte")
+		timestampGte, err := rangeBound(cmd, "timestamp-gte", "from-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampGt, _ := cmd.Flags().GetString("timestamp-gt")
-		timestampLte, _ := cmd.Flags().GetString("timestamp-lte")
+		timestampLte, err := rangeBound(cmd, "timestamp-lte", "to-ts", time.Nanosecond)
+		if err != nil {
+			return err
+		}
 		timestampLt, _ := cmd.Flags().GetString("timestamp-lt")
 		order, _ := cmd.Flags().GetString("order")
 		limit, _ := cmd.Flags().GetString("limit")
+		limit = clampLimit(api.LimitQuotes, limit)
 		sort, _ := cmd.Flags().GetString("sort")
+		cursor, _ := cmd.Flags().GetString("cursor")
 
 		params := api.QuotesParams{
 			Timestamp:    timestamp,
@@ -154,32 +239,83 @@ var stocksQuotesCmd = &cobra.Command{
 			Order:        order,
 			Limit:        limit,
 			Sort:         sort,
+			Cursor:       cursor,
 		}
 
-		result, err := client.GetQuotes(ticker, params)
+		all, _ := cmd.Flags().GetBool("all")
+		if err := confirmExplain(tickExplanation{
+			Kind:         "quotes",
+			Ticker:       ticker,
+			Timestamp:    timestamp,
+			TimestampGte: timestampGte,
+			TimestampGt:  timestampGt,
+			TimestampLte: timestampLte,
+			TimestampLt:  timestampLt,
+			Sort:         sort,
+			Order:        order,
+			Limit:        limit,
+			All:          all,
+		}); err != nil {
+			return err
+		}
+
+		fetcher := api.NewAdaptiveFetcher(client, 1)
+
+		var result *api.QuotesResponse
+		err = fetcher.Do(func() error {
+			var err error
+			result, err = client.GetQuotes(ticker, params)
+			return err
+		})
 		if err != nil {
 			return err
 		}
 
+		// Follow next_url until the last page when --all is set, backing
+		// off whenever the API reports the rate limit is exhausted.
+		for all && result.NextURL != "" {
+			var page *api.QuotesResponse
+			err = fetcher.Do(func() error {
+				var err error
+				page, err = client.GetQuotesNext(result.NextURL)
+				return err
+			})
+			if err != nil {
+				return withResumeCursor(err, result.NextURL)
+			}
+			result.Results = append(result.Results, page.Results...)
+			result.NextURL = page.NextURL
+		}
+
 		if outputFormat == "json" {
 			return printJSON(result)
 		}
 
-		fmt.Printf("Ticker: %s | Quotes: %d\n\n", ticker, len(result.Results))
+		printSummary("Ticker: %s | Quotes: %d", ticker, len(result.Results))
 
+		legend, _ := cmd.Flags().GetBool("legend")
+		header, sep := legendColumns(legend, "TIMESTAMP\tBID PRICE\tBID SIZE\tASK PRICE\tASK SIZE\tBID EX\tASK EX", "---------\t---------\t--------\t---------\t--------\t------\t------")
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TIMESTAMP\tBID PRICE\tBID SIZE\tASK PRICE\tASK SIZE\tBID EX\tASK EX")
-		fmt.Fprintln(w, "---------\t---------\t--------\t---------\t--------\t------\t------")
-
-		for _, quote := range result.Results {
-			t := time.Unix(0, quote.SipTimestamp)
-			fmt.Fprintf(w, "%s\t%.4f\t%.0f\t%.4f\t%.0f\t%d\t%d\n",
-				t.Format("2006-01-02 15:04:05.000"),
-				quote.BidPrice, quote.BidSize,
-				quote.AskPrice, quote.AskSize,
+		writeHeader(w, header, sep)
+
+		conditions := make([][]int, len(result.Results))
+		for i, quote := range result.Results {
+			row := fmt.Sprintf("%s\t%s\t%.0f\t%s\t%.0f\t%d\t%d",
+				timestampCell(quote.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"),
+				priceCell("%.4f", quote.BidPrice), quote.BidSize,
+				priceCell("%.4f", quote.AskPrice), quote.AskSize,
 				quote.BidExchange, quote.AskExchange)
+			if legend {
+				row += "\t" + conditionsCell(quote.Conditions)
+			}
+			fmt.Fprintln(w, row)
+			conditions[i] = quote.Conditions
 		}
 		w.Flush()
+		if legend {
+			printConditionLegend(client, "stocks", conditionNBBO, conditions)
+		}
+		printNextCursor(result.NextURL)
 
 		return nil
 	},
@@ -212,17 +348,16 @@ var stocksLastQuoteCmd = &cobra.Command{
 		}
 
 		quote := result.Results
-		t := time.Unix(0, quote.SipTimestamp)
 
 		fmt.Printf("Ticker:      %s\n", quote.Ticker)
-		fmt.Printf("Bid Price:   $%.4f\n", quote.BidPrice)
+		fmt.Printf("Bid Price:   $%s\n", priceCell("%.4f", quote.BidPrice))
 		fmt.Printf("Bid Size:    %d\n", quote.BidSize)
 		fmt.Printf("Bid Exchange: %d\n", quote.BidExchange)
-		fmt.Printf("Ask Price:   $%.4f\n", quote.AskPrice)
+		fmt.Printf("Ask Price:   $%s\n", priceCell("%.4f", quote.AskPrice))
 		fmt.Printf("Ask Size:    %d\n", quote.AskSize)
 		fmt.Printf("Ask Exchange: %d\n", quote.AskExchange)
 		fmt.Printf("Tape:        %d\n", quote.Tape)
-		fmt.Printf("Timestamp:   %s\n", t.Format("2006-01-02 15:04:05.000"))
+		fmt.Printf("Timestamp:   %s\n", timestampCell(quote.SipTimestamp, api.UnitNanoseconds, "2006-01-02 15:04:05.000"))
 
 		return nil
 	},
@@ -237,9 +372,13 @@ func init() {
 	stocksTradesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
 	stocksTradesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
 	stocksTradesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(stocksTradesCmd, "timestamp-gte", "timestamp-lte")
 	stocksTradesCmd.Flags().String("order", "", "Sort order (asc/desc)")
-	stocksTradesCmd.Flags().String("limit", "1000", "Max number of results (max 50000)")
+	addLimitFlag(stocksTradesCmd, api.LimitTrades, "Max number of results")
 	stocksTradesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	stocksTradesCmd.Flags().Bool("all", false, "Follow next_url pagination and fetch every page")
+	stocksTradesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+	addLegendFlag(stocksTradesCmd)
 
 	// Quotes command flags
 	stocksQuotesCmd.Flags().String("timestamp", "", "Filter by date (YYYY-MM-DD) or nanosecond timestamp")
@@ -247,13 +386,20 @@ func init() {
 	stocksQuotesCmd.Flags().String("timestamp-gt", "", "Timestamp greater than")
 	stocksQuotesCmd.Flags().String("timestamp-lte", "", "Timestamp less than or equal to")
 	stocksQuotesCmd.Flags().String("timestamp-lt", "", "Timestamp less than")
+	addTimestampRangeFlags(stocksQuotesCmd, "timestamp-gte", "timestamp-lte")
 	stocksQuotesCmd.Flags().String("order", "", "Sort order (asc/desc)")
-	stocksQuotesCmd.Flags().String("limit", "1000", "Max number of results (max 50000)")
+	addLimitFlag(stocksQuotesCmd, api.LimitQuotes, "Max number of results")
 	stocksQuotesCmd.Flags().String("sort", "", "Sort field (e.g., timestamp)")
+	stocksQuotesCmd.Flags().Bool("all", false, "Follow next_url pagination and fetch every page")
+	stocksQuotesCmd.Flags().String("cursor", "", "Resume pagination from a cursor printed by a previous run")
+	addLegendFlag(stocksQuotesCmd)
 
 	// Register all four commands under the stocks parent
+	supportsExplain(stocksTradesCmd)
+	supportsStreaming(stocksTradesCmd)
 	stocksCmd.AddCommand(stocksTradesCmd)
 	stocksCmd.AddCommand(stocksLastTradeCmd)
+	supportsExplain(stocksQuotesCmd)
 	stocksCmd.AddCommand(stocksQuotesCmd)
 	stocksCmd.AddCommand(stocksLastQuoteCmd)
 }
@@ -0,0 +1,193 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// csvFormat and ndjsonFormat are the --output values that stream records
+// as CSV rows or one JSON object per line, writing each page as it
+// arrives instead of collecting every page first.
+const (
+	csvFormat    = "csv"
+	ndjsonFormat = "ndjson"
+)
+
+// streamAnnotation lists, comma separated, the streaming formats a
+// command accepts. Other commands reject them instead of silently
+// printing a table.
+const streamAnnotation = "stream"
+
+// supportsStreaming marks cmd as able to write csv and ndjson output.
+func supportsStreaming(cmd *cobra.Command) {
+	setStreamFormats(cmd, csvFormat, ndjsonFormat)
+}
+
+// supportsCSV marks cmd as accepting --output csv without streaming, for
+// commands such as export bars that write CSV files of their own.
+func supportsCSV(cmd *cobra.Command) {
+	setStreamFormats(cmd, csvFormat)
+}
+
+// setStreamFormats records the streaming formats cmd accepts.
+func setStreamFormats(cmd *cobra.Command, formats ...string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[streamAnnotation] = strings.Join(formats, ",")
+}
+
+// checkStreamFormat rejects --output csv or ndjson on commands that do
+// not accept that format.
+func checkStreamFormat(cmd *cobra.Command) error {
+	if !streaming() || slices.Contains(strings.Split(cmd.Annotations[streamAnnotation], ","), outputFormat) {
+		return nil
+	}
+	return fmt.Errorf("--output %s is not supported by %s", outputFormat, cmd.CommandPath())
+}
+
+// csvDelimiter and csvDecimal are the field separator and decimal mark of
+// CSV output, set via the global --csv-delimiter and --csv-decimal flags
+// so files open cleanly in spreadsheets localized to use ';' and ','.
+var csvDelimiter string
+var csvDecimal string
+
+// checkCSVFlags validates --csv-delimiter and --csv-decimal before a
+// command runs: each must be a single character, the delimiter one that
+// encoding/csv can quote around, and the command must write CSV.
+func checkCSVFlags(cmd *cobra.Command) error {
+	for _, name := range []string{"csv-delimiter", "csv-decimal"} {
+		if cmd.Flags().Changed(name) && !slices.Contains(strings.Split(cmd.Annotations[streamAnnotation], ","), csvFormat) {
+			return fmt.Errorf("--%s is not supported by %s", name, cmd.CommandPath())
+		}
+	}
+
+	delim := []rune(csvDelimiter)
+	if len(delim) != 1 || delim[0] == '"' || delim[0] == '\r' || delim[0] == '\n' || delim[0] == utf8.RuneError {
+		return fmt.Errorf("--csv-delimiter must be a single character other than a quote or newline, got %q", csvDelimiter)
+	}
+	decimal := []rune(csvDecimal)
+	if len(decimal) != 1 || unicode.IsDigit(decimal[0]) || strings.ContainsRune("-+\"\r\n", decimal[0]) {
+		return fmt.Errorf("--csv-decimal must be a single character such as . or , got %q", csvDecimal)
+	}
+	return nil
+}
+
+// newCSVWriter returns a csv.Writer using the --csv-delimiter separator.
+// Cells containing the delimiter, such as a comma decimal with a comma
+// delimiter, are quoted by the writer.
+func newCSVWriter(w io.Writer) *csv.Writer {
+	cw := csv.NewWriter(w)
+	cw.Comma, _ = utf8.DecodeRuneInString(csvDelimiter)
+	return cw
+}
+
+// csvFloat formats a numeric CSV cell, writing the --csv-decimal mark in
+// place of the decimal point. Only numeric cells go through it, so text
+// such as trade IDs and dates is never altered.
+func csvFloat(v float64) string {
+	return strings.Replace(strconv.FormatFloat(v, 'f', -1, 64), ".", csvDecimal, 1)
+}
+
+// streaming reports whether --output selects a streaming format.
+func streaming() bool {
+	return outputFormat == csvFormat || outputFormat == ndjsonFormat
+}
+
+// recordStream renders records incrementally: each Write encodes one
+// page of records and flushes it, so memory stays bounded by the page
+// size however many pages a --all pull covers. CSV output starts with a
+// header row of columns (skipped with --no-header) and formats records
+// with row; NDJSON encodes each record as its own JSON line.
+type recordStream[T any] struct {
+	csv     *csv.Writer
+	json    *json.Encoder
+	columns []string
+	row     func(T) []string
+	started bool
+}
+
+// newRecordStream returns a recordStream writing to w in format, which
+// must be csvFormat or ndjsonFormat.
+func newRecordStream[T any](w io.Writer, format string, columns []string, row func(T) []string) *recordStream[T] {
+	s := &recordStream[T]{columns: columns, row: row}
+	if format == csvFormat {
+		s.csv = newCSVWriter(w)
+	} else {
+		s.json = json.NewEncoder(w)
+	}
+	return s
+}
+
+// Write encodes records and flushes them to the underlying writer.
+func (s *recordStream[T]) Write(records []T) error {
+	if s.json != nil {
+		for _, r := range records {
+			if err := s.json.Encode(r); err != nil {
+				return fmt.Errorf("failed to write record: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if !s.started && !noHeader {
+		s.csv.Write(s.columns)
+	}
+	s.started = true
+	for _, r := range records {
+		s.csv.Write(s.row(r))
+	}
+	s.csv.Flush()
+	if err := s.csv.Error(); err != nil {
+		return fmt.Errorf("failed to write record: %w", err)
+	}
+	return nil
+}
+
+// tradeColumns are the CSV columns of a streamed trade. Timestamps are
+// the API's nanosecond epoch values and conditions are comma separated.
+var tradeColumns = []string{"sip_timestamp", "participant_timestamp", "price", "size", "exchange", "tape", "id", "conditions", "sequence_number"}
+
+// tradeRow formats a trade as a CSV row matching tradeColumns.
+func tradeRow(t api.Trade) []string {
+	conditions := make([]string, len(t.Conditions))
+	for i, c := range t.Conditions {
+		conditions[i] = strconv.Itoa(c)
+	}
+
+	return []string{
+		strconv.FormatInt(t.SipTimestamp, 10),
+		strconv.FormatInt(t.ParticipantTimestamp, 10),
+		csvFloat(t.Price),
+		csvFloat(t.Size),
+		strconv.Itoa(t.Exchange),
+		strconv.Itoa(t.Tape),
+		t.ID,
+		strings.Join(conditions, ","),
+		strconv.FormatInt(t.SequenceNumber, 10),
+	}
+}
+
+// printStreamCursor tells the user how to resume a streamed pull that
+// stopped with more pages available. It goes to stderr so the cursor
+// hint never lands in the CSV or NDJSON data.
+func printStreamCursor(nextURL string) {
+	if cursor := api.NextCursor(nextURL); cursor != "" {
+		infof("More results available. Resume with --cursor %s", cursor)
+	}
+}
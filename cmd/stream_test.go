@@ -0,0 +1,126 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// TestCheckStreamFormat verifies each command accepts exactly the
+// streaming formats it opted into: export bars keeps its documented
+// --output csv, stocks trades streams csv and ndjson, and commands that
+// did not opt in reject both.
+func TestCheckStreamFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     *cobra.Command
+		format  string
+		wantErr bool
+	}{
+		{"export bars csv", exportBarsCmd, csvFormat, false},
+		{"export bars ndjson", exportBarsCmd, ndjsonFormat, true},
+		{"stocks trades csv", stocksTradesCmd, csvFormat, false},
+		{"stocks trades ndjson", stocksTradesCmd, ndjsonFormat, false},
+		{"stocks quotes csv", stocksQuotesCmd, csvFormat, true},
+		{"stocks quotes table", stocksQuotesCmd, "table", false},
+	}
+
+	saved := outputFormat
+	defer func() { outputFormat = saved }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFormat = tt.format
+			err := checkStreamFormat(tt.cmd)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkStreamFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCSVLocale verifies --csv-delimiter and --csv-decimal change the
+// separator and the decimal mark of numeric cells only, and that cells
+// containing the delimiter are still quoted.
+func TestCSVLocale(t *testing.T) {
+	bars := []api.Bar{{Timestamp: 1704067200000, Open: 1.5, High: 2, Low: 0.25, Close: 1.75, Volume: 100, VWAP: 1.125, NumTrades: 3}}
+	trade := api.Trade{SipTimestamp: 1, ParticipantTimestamp: 1, Price: 10.5, Size: 0.25, ID: "a.1"}
+
+	tests := []struct {
+		delimiter, decimal string
+		wantBars, wantTrade string
+	}{
+		{",", ".",
+			"timestamp,date,open,high,low,close,volume,vwap,trades\n1704067200000,2024-01-01T00:00:00Z,1.5,2,0.25,1.75,100,1.125,3\n",
+			"1,1,10.5,0.25,0,0,a.1,,0\n"},
+		{";", ",",
+			"timestamp;date;open;high;low;close;volume;vwap;trades\n1704067200000;2024-01-01T00:00:00Z;1,5;2;0,25;1,75;100;1,125;3\n",
+			"1;1;10,5;0,25;0;0;a.1;;0\n"},
+		{",", ",",
+			"timestamp,date,open,high,low,close,volume,vwap,trades\n1704067200000,2024-01-01T00:00:00Z,\"1,5\",2,\"0,25\",\"1,75\",100,\"1,125\",3\n",
+			"1,1,\"10,5\",\"0,25\",0,0,a.1,,0\n"},
+	}
+
+	savedDelimiter, savedDecimal := csvDelimiter, csvDecimal
+	defer func() { csvDelimiter, csvDecimal = savedDelimiter, savedDecimal }()
+
+	for _, tt := range tests {
+		t.Run(tt.delimiter+tt.decimal, func(t *testing.T) {
+			csvDelimiter, csvDecimal = tt.delimiter, tt.decimal
+
+			var buf bytes.Buffer
+			if err := writeBarsCSV(&buf, bars); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.wantBars {
+				t.Errorf("writeBarsCSV =\n%s\nwant\n%s", buf.String(), tt.wantBars)
+			}
+
+			buf.Reset()
+			stream := newRecordStream(&buf, csvFormat, tradeColumns, tradeRow)
+			stream.started = true
+			if err := stream.Write([]api.Trade{trade}); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.wantTrade {
+				t.Errorf("trade row = %q, want %q", buf.String(), tt.wantTrade)
+			}
+		})
+	}
+}
+
+// TestCheckCSVFlags verifies the delimiter and decimal must each be one
+// character the CSV writer can use.
+func TestCheckCSVFlags(t *testing.T) {
+	tests := []struct {
+		delimiter, decimal string
+		wantErr            bool
+	}{
+		{",", ".", false},
+		{";", ",", false},
+		{"\t", ".", false},
+		{";;", ",", true},
+		{"", ".", true},
+		{"\"", ".", true},
+		{";", "", true},
+		{";", "5", true},
+		{";", "-", true},
+	}
+
+	savedDelimiter, savedDecimal := csvDelimiter, csvDecimal
+	defer func() { csvDelimiter, csvDecimal = savedDelimiter, savedDecimal }()
+
+	for _, tt := range tests {
+		csvDelimiter, csvDecimal = tt.delimiter, tt.decimal
+		if err := checkCSVFlags(exportBarsCmd); (err != nil) != tt.wantErr {
+			t.Errorf("checkCSVFlags(%q, %q) error = %v, wantErr %v", tt.delimiter, tt.decimal, err, tt.wantErr)
+		}
+	}
+}
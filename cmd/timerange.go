@@ -0,0 +1,106 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// addTimestampRangeFlags registers --from-ts and --to-ts, precise
+// alternatives to the range flags named fromFlag and toFlag that accept an
+// RFC3339 time or a nanosecond timestamp. Each pair is mutually exclusive,
+// so there is no precedence to remember. When a range flag was marked
+// required, either form now satisfies it. Call after MarkFlagRequired.
+func addTimestampRangeFlags(cmd *cobra.Command, fromFlag, toFlag string) {
+	cmd.Flags().String("from-ts", "", fmt.Sprintf("Precise start as RFC3339 time or nanosecond timestamp (instead of --%s)", fromFlag))
+	cmd.Flags().String("to-ts", "", fmt.Sprintf("Precise end as RFC3339 time or nanosecond timestamp (instead of --%s)", toFlag))
+
+	for _, pair := range [][2]string{{fromFlag, "from-ts"}, {toFlag, "to-ts"}} {
+		cmd.MarkFlagsMutuallyExclusive(pair[0], pair[1])
+
+		f := cmd.Flags().Lookup(pair[0])
+		if _, required := f.Annotations[cobra.BashCompOneRequiredFlag]; required {
+			delete(f.Annotations, cobra.BashCompOneRequiredFlag)
+			cmd.MarkFlagsOneRequired(pair[0], pair[1])
+		}
+	}
+}
+
+// validTimespans are the bar sizes the aggregate and indicator endpoints
+// accept for --timespan, for every asset class.
+var validTimespans = []string{"minute", "hour", "day", "week", "month", "quarter", "year"}
+
+// timespanFlag returns the command's --timespan value, lower cased, or an
+// error listing the valid timespans. Checking here catches a value such as
+// "daily" before it reaches the API and comes back as a bare 400.
+func timespanFlag(cmd *cobra.Command) (string, error) {
+	value, _ := cmd.Flags().GetString("timespan")
+	timespan := strings.ToLower(strings.TrimSpace(value))
+	if !slices.Contains(validTimespans, timespan) {
+		return "", fmt.Errorf("invalid --timespan %q: must be one of %s", value, strings.Join(validTimespans, ", "))
+	}
+	return timespan, nil
+}
+
+// rangeBound returns one end of a command's time range. When tsFlag is set
+// its value is parsed with api.ParseTimestamp and returned as an integer
+// count of unit since the Unix epoch: time.Millisecond for the aggregate
+// and indicator endpoints, time.Nanosecond for trades and quotes.
+// Otherwise the value of flag is returned unchanged.
+func rangeBound(cmd *cobra.Command, flag, tsFlag string, unit time.Duration) (string, error) {
+	ts, _ := cmd.Flags().GetString(tsFlag)
+	if ts == "" {
+		value, _ := cmd.Flags().GetString(flag)
+		return value, nil
+	}
+
+	t, err := api.ParseTimestamp(ts)
+	if err != nil {
+		return "", fmt.Errorf("--%s: %w", tsFlag, err)
+	}
+
+	return strconv.FormatInt(t.UnixNano()/int64(unit), 10), nil
+}
+
+// relativeDate resolves a date flag that may be given relative to now as
+// a count and unit: 30d, 12w, 6m, or 1y for days, weeks, months, or years
+// before now. Any other value is returned unchanged for the API to parse,
+// so absolute YYYY-MM-DD dates and millisecond timestamps still work.
+func relativeDate(value string, now time.Time) (string, error) {
+	if len(value) < 2 {
+		return value, nil
+	}
+
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil {
+		return value, nil
+	}
+	if n < 0 {
+		return "", fmt.Errorf("invalid relative date %q: count must not be negative", value)
+	}
+
+	switch value[len(value)-1] {
+	case 'd':
+		now = now.AddDate(0, 0, -n)
+	case 'w':
+		now = now.AddDate(0, 0, -7*n)
+	case 'm':
+		now = now.AddDate(0, -n, 0)
+	case 'y':
+		now = now.AddDate(-n, 0, 0)
+	default:
+		return value, nil
+	}
+
+	return now.Format("2006-01-02"), nil
+}
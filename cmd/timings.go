@@ -0,0 +1,45 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// showTimings prints a timing report to stderr once the command finishes.
+// Set via the global --timings flag.
+var showTimings bool
+
+// totalTimings sums the request timings of every client created during
+// the run.
+func totalTimings() api.Timings {
+	var total api.Timings
+	for _, c := range clients {
+		total = total.Add(c.Timings())
+	}
+	return total
+}
+
+// printTimings writes the --timings report: the command's wall time, the
+// number of HTTP round trips, the time spent in them with the average
+// server latency, and the remainder spent outside requests (rate-limit
+// backoff, retries' waits, and local processing). A large remainder points
+// at rate limiting, a large latency at the server, and a gap between
+// request time and total latency at the network.
+func printTimings(w io.Writer, wall time.Duration, t api.Timings) {
+	outside := wall - t.Elapsed
+	if outside < 0 {
+		outside = 0
+	}
+
+	fmt.Fprintf(w, "Timings: %s wall, %d round trip(s), %s in requests (avg server latency %s), %s outside requests\n",
+		wall.Round(time.Millisecond), t.RoundTrips, t.Elapsed.Round(time.Millisecond),
+		t.AverageLatency().Round(time.Millisecond), outside.Round(time.Millisecond))
+}
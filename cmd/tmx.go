@@ -95,11 +95,10 @@ var tmxCorporateEventsCmd = &cobra.Command{
 			return printJSON(result)
 		}
 
-		fmt.Printf("Corporate Events: %d result(s)\n\n", len(result.Results))
+		printSummary("Corporate Events: %d result(s)", len(result.Results))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "TICKER\tDATE\tTYPE\tNAME\tSTATUS\tCOMPANY\tVENUE")
-		fmt.Fprintln(w, "------\t----\t----\t----\t------\t-------\t-----")
+		writeHeader(w, "TICKER\tDATE\tTYPE\tNAME\tSTATUS\tCOMPANY\tVENUE", "------\t----\t----\t----\t------\t-------\t-----")
 
 		for _, e := range result.Results {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
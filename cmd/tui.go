@@ -0,0 +1,470 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cloudmanic/massive-cli/internal/api"
+	"github.com/cloudmanic/massive-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// tuiChartDays is how many daily bars the detail pane's price chart spans.
+const tuiChartDays = 60
+
+// tuiListWidth is the width of the ticker list column, separator included.
+const tuiListWidth = 36
+
+// sparkBlocks are the bar heights a sparkline is drawn with, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// tuiCmd opens an interactive browser over a market's ticker index: a
+// searchable list on the left and, for the highlighted ticker, its unified
+// snapshot and a daily price chart on the right, refreshed on an interval.
+// Usage: massive tui --market crypto --refresh 10s
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse tickers and live snapshots in a terminal UI",
+	Long:  "Open an interactive terminal browser over a market's active tickers (the index 'massive reference sync-tickers' keeps, pulled first when missing or stale). The right pane shows the highlighted ticker's snapshot and a chart of its last 60 daily closes, and the snapshot refreshes every --refresh interval. Keys: up/down (or k/j), pgup/pgdown, home/end to move; / to search by ticker prefix or name, enter to keep the filter, esc to clear it; q or ctrl+c to quit.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		market, _ := cmd.Flags().GetString("market")
+		market = strings.ToLower(strings.TrimSpace(market))
+		if !slices.Contains(completionMarkets, market) {
+			return fmt.Errorf("unknown market %q: must be one of %s", market, strings.Join(completionMarkets, ", "))
+		}
+		refresh, _ := cmd.Flags().GetDuration("refresh")
+		if refresh < time.Second {
+			return fmt.Errorf("--refresh must be at least 1s")
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		dir, err := config.CacheDir()
+		if err != nil {
+			return err
+		}
+
+		ix, _, err := syncTickerIndex(client, dir, market, false)
+		if err != nil {
+			return err
+		}
+		if len(ix.Tickers) == 0 {
+			return fmt.Errorf("the %s ticker index is empty", market)
+		}
+
+		m := newTUIModel(client, market, ix.Tickers, refresh)
+		_, err = tea.NewProgram(m, tea.WithAltScreen()).Run()
+		return err
+	},
+}
+
+// tuiModel is the bubbletea model behind massive tui. filtered holds the
+// entries matching query and cursor indexes into it; offset is the first
+// visible row. Snapshot and bars are those of the ticker named by detail,
+// and responses for any other ticker are dropped as stale.
+type tuiModel struct {
+	client  *api.Client
+	market  string
+	refresh time.Duration
+
+	tickers   []api.TickerIndexEntry
+	filtered  []api.TickerIndexEntry
+	query     string
+	searching bool
+	cursor    int
+	offset    int
+
+	detail   string
+	snapshot *api.UniversalSnapshot
+	closes   []float64
+	updated  time.Time
+	err      error
+
+	width, height int
+}
+
+// tuiSnapshotMsg carries a fetched snapshot for ticker.
+type tuiSnapshotMsg struct {
+	ticker   string
+	snapshot *api.UniversalSnapshot
+	err      error
+}
+
+// tuiBarsMsg carries the daily closes fetched for ticker's chart.
+type tuiBarsMsg struct {
+	ticker string
+	closes []float64
+	err    error
+}
+
+// tuiRefreshMsg fires every refresh interval to reload the snapshot.
+type tuiRefreshMsg struct{}
+
+// newTUIModel returns a model listing tickers, which must not be empty,
+// with the first one selected.
+func newTUIModel(client *api.Client, market string, tickers []api.TickerIndexEntry, refresh time.Duration) tuiModel {
+	return tuiModel{
+		client:   client,
+		market:   market,
+		refresh:  refresh,
+		tickers:  tickers,
+		filtered: tickers,
+		detail:   tickers[0].Ticker,
+		height:   24,
+		width:    100,
+	}
+}
+
+// Init loads the first ticker's detail and starts the refresh timer.
+func (m tuiModel) Init() tea.Cmd {
+	return tea.Batch(m.snapshotCmd(m.detail), m.barsCmd(m.detail), m.tickCmd())
+}
+
+// Update handles key presses, window resizes, fetched data, and the
+// refresh timer.
+func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.scroll()
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		return m.updateBrowse(msg)
+
+	case tuiSnapshotMsg:
+		if msg.ticker == m.detail {
+			m.err = msg.err
+			if msg.err == nil {
+				m.snapshot = msg.snapshot
+				m.updated = time.Now()
+			}
+		}
+		return m, nil
+
+	case tuiBarsMsg:
+		if msg.ticker == m.detail && msg.err == nil {
+			m.closes = msg.closes
+		}
+		return m, nil
+
+	case tuiRefreshMsg:
+		return m, tea.Batch(m.snapshotCmd(m.detail), m.tickCmd())
+	}
+
+	return m, nil
+}
+
+// updateBrowse handles keys while moving through the list.
+func (m tuiModel) updateBrowse(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "/":
+		m.searching = true
+		return m, nil
+	case "esc":
+		return m.setQuery("")
+	case "up", "k":
+		return m.move(-1)
+	case "down", "j":
+		return m.move(1)
+	case "pgup":
+		return m.move(-m.listHeight())
+	case "pgdown":
+		return m.move(m.listHeight())
+	case "home":
+		return m.move(-len(m.filtered))
+	case "end":
+		return m.move(len(m.filtered))
+	}
+	return m, nil
+}
+
+// updateSearch handles keys while the search query is being typed. The
+// list narrows with every keystroke; enter keeps the filter and returns to
+// browsing, esc drops it.
+func (m tuiModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEnter:
+		m.searching = false
+		return m, nil
+	case tea.KeyEsc:
+		m.searching = false
+		return m.setQuery("")
+	case tea.KeyUp:
+		return m.move(-1)
+	case tea.KeyDown:
+		return m.move(1)
+	case tea.KeyBackspace:
+		if m.query == "" {
+			return m, nil
+		}
+		runes := []rune(m.query)
+		return m.setQuery(string(runes[:len(runes)-1]))
+	case tea.KeyRunes, tea.KeySpace:
+		return m.setQuery(m.query + string(msg.Runes))
+	}
+	return m, nil
+}
+
+// setQuery filters the list by query and selects the first match.
+func (m tuiModel) setQuery(query string) (tea.Model, tea.Cmd) {
+	m.query = query
+	m.filtered = filterTickerEntries(m.tickers, query)
+	m.cursor, m.offset = 0, 0
+	return m, m.selectCmd()
+}
+
+// move shifts the selection by delta rows, clamped to the list.
+func (m tuiModel) move(delta int) (tea.Model, tea.Cmd) {
+	if len(m.filtered) == 0 {
+		return m, nil
+	}
+	cursor := min(max(m.cursor+delta, 0), len(m.filtered)-1)
+	if cursor == m.cursor {
+		return m, nil
+	}
+	m.cursor = cursor
+	m.scroll()
+	return m, m.selectCmd()
+}
+
+// scroll keeps the cursor row inside the visible window of the list.
+func (m *tuiModel) scroll() {
+	height := m.listHeight()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+height {
+		m.offset = m.cursor - height + 1
+	}
+}
+
+// selectCmd makes the highlighted ticker the detail ticker, clearing the
+// old detail, and fetches its snapshot and chart. It does nothing when
+// the highlighted ticker is already shown.
+func (m *tuiModel) selectCmd() tea.Cmd {
+	ticker := ""
+	if len(m.filtered) > 0 {
+		ticker = m.filtered[m.cursor].Ticker
+	}
+	if ticker == m.detail {
+		return nil
+	}
+
+	m.detail, m.snapshot, m.closes, m.err = ticker, nil, nil, nil
+	if ticker == "" {
+		return nil
+	}
+	return tea.Batch(m.snapshotCmd(ticker), m.barsCmd(ticker))
+}
+
+// snapshotCmd fetches ticker's unified snapshot in the background.
+func (m tuiModel) snapshotCmd(ticker string) tea.Cmd {
+	if ticker == "" {
+		return nil
+	}
+	client := m.client
+	return func() tea.Msg {
+		result, err := client.GetUnifiedSnapshot([]string{ticker})
+		if err != nil {
+			return tuiSnapshotMsg{ticker: ticker, err: err}
+		}
+		if len(result.Results) == 0 {
+			return tuiSnapshotMsg{ticker: ticker, err: fmt.Errorf("no snapshot for %s", ticker)}
+		}
+		s := result.Results[0]
+		if s.Error != "" {
+			return tuiSnapshotMsg{ticker: ticker, err: fmt.Errorf("%s: %s", s.Error, s.Message)}
+		}
+		return tuiSnapshotMsg{ticker: ticker, snapshot: &s}
+	}
+}
+
+// barsCmd fetches the daily closes for ticker's chart in the background.
+func (m tuiModel) barsCmd(ticker string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		now := time.Now()
+		result, err := client.GetBars(ticker, api.BarsParams{
+			Multiplier: "1",
+			Timespan:   "day",
+			From:       now.AddDate(0, 0, -2*tuiChartDays).Format("2006-01-02"),
+			To:         now.Format("2006-01-02"),
+			Sort:       "desc",
+			Limit:      fmt.Sprint(tuiChartDays),
+		})
+		if err != nil {
+			return tuiBarsMsg{ticker: ticker, err: err}
+		}
+
+		closes := make([]float64, len(result.Results))
+		for i, b := range result.Results {
+			closes[len(closes)-1-i] = b.Close
+		}
+		return tuiBarsMsg{ticker: ticker, closes: closes}
+	}
+}
+
+// tickCmd schedules the next snapshot refresh.
+func (m tuiModel) tickCmd() tea.Cmd {
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg { return tuiRefreshMsg{} })
+}
+
+// listHeight is how many list rows fit below the two header lines.
+func (m tuiModel) listHeight() int {
+	return max(m.height-3, 1)
+}
+
+// View renders the header, then the ticker list and the detail pane side
+// by side.
+func (m tuiModel) View() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "massive tui | %s | %d of %d tickers | / search  ↑↓ move  q quit\n", m.market, len(m.filtered), len(m.tickers))
+	switch {
+	case m.searching:
+		fmt.Fprintf(&b, "Search: %s█\n", m.query)
+	case m.query != "":
+		fmt.Fprintf(&b, "Filter: %s (esc clears)\n", m.query)
+	default:
+		b.WriteString("\n")
+	}
+
+	detail := m.detailLines()
+	height := m.listHeight()
+	for row := 0; row < height; row++ {
+		left := ""
+		if i := m.offset + row; i < len(m.filtered) {
+			marker := "  "
+			if i == m.cursor {
+				marker = "> "
+			}
+			e := m.filtered[i]
+			left = marker + fitWidth(fmt.Sprintf("%-10s %s", e.Ticker, e.Name), tuiListWidth-4)
+		}
+		right := ""
+		if row < len(detail) {
+			right = detail[row]
+		}
+		fmt.Fprintf(&b, "%-*s│ %s\n", tuiListWidth-2, fitWidth(left, tuiListWidth-2), fitWidth(right, max(m.width-tuiListWidth-1, 10)))
+	}
+
+	return b.String()
+}
+
+// detailLines renders the detail pane for the selected ticker.
+func (m tuiModel) detailLines() []string {
+	if m.detail == "" {
+		return []string{"No tickers match the search."}
+	}
+
+	lines := []string{m.detail}
+	if m.err != nil {
+		return append(lines, "", "Error: "+m.err.Error())
+	}
+	s := m.snapshot
+	if s == nil {
+		return append(lines, "", "Loading...")
+	}
+
+	lines[0] += "  " + s.Name
+	lines = append(lines,
+		fmt.Sprintf("Type: %s | Market: %s", s.Type, s.MarketStatus),
+		"",
+		"Price:      "+priceCell("%.4f", s.Price()),
+	)
+	if sess := s.Session; sess != nil {
+		lines = append(lines,
+			fmt.Sprintf("Change:     %s (%+.2f%%)", priceCell("%.4f", sess.Change), sess.ChangePercent),
+			"Open:       "+priceCell("%.4f", sess.Open),
+			"High:       "+priceCell("%.4f", sess.High),
+			"Low:        "+priceCell("%.4f", sess.Low),
+			"Prev close: "+priceCell("%.4f", sess.PreviousClose),
+			fmt.Sprintf("Volume:     %.0f", sess.Volume),
+		)
+	}
+	if q := s.LastQuote; q != nil {
+		lines = append(lines, fmt.Sprintf("Bid/Ask:    %s / %s", priceCell("%.4f", q.Bid), priceCell("%.4f", q.Ask)))
+	}
+
+	if len(m.closes) > 1 {
+		lines = append(lines, "",
+			fmt.Sprintf("%d-day closes:", len(m.closes)),
+			sparkline(m.closes),
+			fmt.Sprintf("low %s  high %s", priceCell("%.4f", slices.Min(m.closes)), priceCell("%.4f", slices.Max(m.closes))),
+		)
+	}
+
+	return append(lines, "", fmt.Sprintf("Updated %s, refreshing every %s", m.updated.Format("15:04:05"), m.refresh))
+}
+
+// filterTickerEntries returns the entries whose ticker starts with query
+// or whose name contains it, ignoring case. An empty query matches all.
+func filterTickerEntries(entries []api.TickerIndexEntry, query string) []api.TickerIndexEntry {
+	query = strings.ToUpper(strings.TrimSpace(query))
+	if query == "" {
+		return entries
+	}
+
+	var matches []api.TickerIndexEntry
+	for _, e := range entries {
+		if strings.HasPrefix(e.Ticker, query) || strings.Contains(strings.ToUpper(e.Name), query) {
+			matches = append(matches, e)
+		}
+	}
+	return matches
+}
+
+// sparkline draws values as one block character each, scaled between the
+// lowest and highest value. A flat series draws at mid height.
+func sparkline(values []float64) string {
+	lo, hi := slices.Min(values), slices.Max(values)
+	top := len(sparkBlocks) - 1
+
+	var b strings.Builder
+	for _, v := range values {
+		level := top / 2
+		if hi > lo {
+			level = int(math.Round((v - lo) / (hi - lo) * float64(top)))
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
+
+// fitWidth cuts s to at most width runes, so multi-byte names and the
+// chart never wrap a row.
+func fitWidth(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	return string(runes[:width])
+}
+
+// init registers the tui command and its flags under the root command.
+func init() {
+	tuiCmd.Flags().String("market", "stocks", "Market whose tickers to browse (stocks, crypto, fx, indices)")
+	tuiCmd.Flags().Duration("refresh", 5*time.Second, "How often the selected ticker's snapshot is refreshed")
+	rootCmd.AddCommand(tuiCmd)
+}
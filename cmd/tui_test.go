@@ -0,0 +1,122 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// tuiTestTickers is a small sorted ticker index for the TUI tests.
+var tuiTestTickers = []api.TickerIndexEntry{
+	{Ticker: "AAPL", Name: "Apple Inc."},
+	{Ticker: "AMZN", Name: "Amazon.com Inc."},
+	{Ticker: "MSFT", Name: "Microsoft Corp"},
+	{Ticker: "PLTR", Name: "Palantir Technologies"},
+}
+
+// TestFilterTickerEntries verifies the search matches ticker prefixes and
+// name substrings without regard to case.
+func TestFilterTickerEntries(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"AAPL", "AMZN", "MSFT", "PLTR"}},
+		{"a", []string{"AAPL", "AMZN", "PLTR"}},
+		{"am", []string{"AMZN"}},
+		{"corp", []string{"MSFT"}},
+		{"zzz", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			var got []string
+			for _, e := range filterTickerEntries(tuiTestTickers, tt.query) {
+				got = append(got, e.Ticker)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("filterTickerEntries(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSparkline verifies values are scaled from the lowest to the highest
+// block and a flat series draws at mid height.
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8}); got != "▁▂▃▄▅▆▇█" {
+		t.Errorf("sparkline(rising) = %q", got)
+	}
+	if got := sparkline([]float64{5, 5, 5}); got != "▄▄▄" {
+		t.Errorf("sparkline(flat) = %q", got)
+	}
+}
+
+// TestTUIKeys verifies arrow keys move the selection, / starts a search
+// that narrows the list as it is typed, esc clears it, and q quits.
+func TestTUIKeys(t *testing.T) {
+	var m tea.Model = newTUIModel(nil, "stocks", tuiTestTickers, time.Second)
+	press := func(keys ...tea.KeyMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, k := range keys {
+			m, cmd = m.Update(k)
+		}
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+	state := func() tuiModel { return m.(tuiModel) }
+
+	press(tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+	if state().detail != "MSFT" {
+		t.Errorf("after two downs detail = %q, want MSFT", state().detail)
+	}
+	press(tea.KeyMsg{Type: tea.KeyUp})
+	if state().detail != "AMZN" {
+		t.Errorf("after up detail = %q, want AMZN", state().detail)
+	}
+
+	press(runes("/"), runes("m"), runes("s"))
+	if !state().searching || state().query != "ms" || len(state().filtered) != 1 || state().detail != "MSFT" {
+		t.Errorf("search state = %+v, want query ms selecting MSFT", state())
+	}
+
+	press(runes("q"))
+	if state().query != "msq" {
+		t.Errorf("q while searching should type, query = %q", state().query)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if state().searching || state().query != "" || len(state().filtered) != len(tuiTestTickers) {
+		t.Errorf("esc should clear the search, got %+v", state())
+	}
+
+	if cmd := press(runes("q")); cmd == nil {
+		t.Fatal("q should quit")
+	} else if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("q should return tea.Quit")
+	}
+}
+
+// TestTUIDropsStaleData verifies a snapshot that arrives after the
+// selection moved on is not shown for the new ticker.
+func TestTUIDropsStaleData(t *testing.T) {
+	var m tea.Model = newTUIModel(nil, "stocks", tuiTestTickers, time.Second)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tuiSnapshotMsg{ticker: "AAPL", snapshot: &api.UniversalSnapshot{Ticker: "AAPL"}})
+	if m.(tuiModel).snapshot != nil {
+		t.Error("snapshot for AAPL should be dropped after moving to AMZN")
+	}
+
+	m, _ = m.Update(tuiSnapshotMsg{ticker: "AMZN", snapshot: &api.UniversalSnapshot{Ticker: "AMZN"}})
+	if s := m.(tuiModel).snapshot; s == nil || s.Ticker != "AMZN" {
+		t.Errorf("snapshot = %+v, want AMZN", s)
+	}
+}
@@ -0,0 +1,50 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cloudmanic/massive-cli/internal/version"
+	"github.com/spf13/cobra"
+)
+
+// versionInfo is the JSON output of the version command.
+type versionInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	Date      string `json:"date"`
+	GoVersion string `json:"go_version"`
+}
+
+// versionCmd prints the CLI version, git commit, build date, and Go
+// version, the same text as --version. Build metadata is injected with
+// -ldflags (see the Makefile).
+// Usage: massive version
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the CLI version and build metadata",
+	Long:  "Print the CLI version, the git commit and date it was built from, and the Go version used to build it.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormat == "json" {
+			return printJSON(versionInfo{
+				Version:   version.Version,
+				Commit:    version.Commit,
+				Date:      version.Date,
+				GoVersion: version.GoVersion(),
+			})
+		}
+
+		fmt.Print(version.String())
+		return nil
+	},
+}
+
+// init registers the version command with the root command.
+func init() {
+	rootCmd.AddCommand(versionCmd)
+}
@@ -0,0 +1,80 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// postTo is the URL that rendered JSON output is POSTed to, such as a
+// Slack or Discord webhook, and postHeaders are extra "Key: value"
+// headers sent with it. Set via the global --post-to and --post-header
+// flags.
+var postTo string
+var postHeaders []string
+
+// checkPostFlags validates --post-to and --post-header before the command
+// runs. Only JSON output is posted, so --post-to requires --output json,
+// and each header must be a "Key: value" pair.
+func checkPostFlags() error {
+	if postTo == "" {
+		if len(postHeaders) > 0 {
+			return fmt.Errorf("--post-header requires --post-to")
+		}
+		return nil
+	}
+	if outputFormat != "json" {
+		return fmt.Errorf("--post-to requires --output json")
+	}
+	_, err := parsePostHeaders(postHeaders)
+	return err
+}
+
+// parsePostHeaders turns "Key: value" (or "Key:value") flag values into
+// request headers.
+func parsePostHeaders(values []string) (http.Header, error) {
+	headers := http.Header{}
+	for _, v := range values {
+		name, value, ok := strings.Cut(v, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --post-header %q: must be Key: value", v)
+		}
+		headers.Add(name, strings.TrimSpace(value))
+	}
+	return headers, nil
+}
+
+// postOutput POSTs rendered JSON output to --post-to and reports the
+// response status on stderr. It uses the HTTP client of the run's most
+// recent API client, with its timeout and User-Agent, or a fresh client
+// when the command made no API request.
+func postOutput(data []byte) error {
+	headers, err := parsePostHeaders(postHeaders)
+	if err != nil {
+		return err
+	}
+
+	var client *api.Client
+	if len(clients) > 0 {
+		client = clients[len(clients)-1]
+	} else {
+		client = api.NewClient("")
+		client.SetUserAgent(userAgent)
+	}
+
+	status, err := client.PostJSON(postTo, headers, data)
+	if err != nil {
+		return fmt.Errorf("--post-to: %w", err)
+	}
+
+	infof("Posted output: %s", status)
+	return nil
+}
@@ -166,7 +166,7 @@ func formatCryptoTrade(w *tabwriter.Writer, event map[string]interface{}) {
 	price := getFloat(event, "p")
 	size := getFloat(event, "s")
 	exchange := getFloat(event, "x")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.0f\n", ts, pair, price, size, exchange)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%.4f\t%.0f\n", ts, pair, priceCell("%.4f", price), size, exchange)
 }
 
 // formatCryptoQuote formats a single crypto quote event as a table row showing
@@ -179,7 +179,7 @@ func formatCryptoQuote(w *tabwriter.Writer, event map[string]interface{}) {
 	bidSize := getFloat(event, "bs")
 	ask := getFloat(event, "ap")
 	askSize := getFloat(event, "as")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.4f\t%.4f\n", ts, pair, bid, bidSize, ask, askSize)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%.4f\t%s\t%.4f\n", ts, pair, priceCell("%.4f", bid), bidSize, priceCell("%.4f", ask), askSize)
 }
 
 // formatCryptoAggregate formats a single crypto aggregate bar event (per-minute or
@@ -193,7 +193,8 @@ func formatCryptoAggregate(w *tabwriter.Writer, event map[string]interface{}) {
 	low := getFloat(event, "l")
 	close_ := getFloat(event, "c")
 	volume := getFloat(event, "v")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\n", ts, pair, open, high, low, close_, volume)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%.0f\n", ts, pair, priceCell("%.4f", open),
+		priceCell("%.4f", high), priceCell("%.4f", low), priceCell("%.4f", close_), volume)
 }
 
 // formatCryptoFMV formats a single crypto Fair Market Value event as a table row
@@ -203,7 +204,7 @@ func formatCryptoFMV(w *tabwriter.Writer, event map[string]interface{}) {
 	ts := formatTimestamp(event["t"])
 	sym := getStr(event, "sym")
 	fmv := getFloat(event, "fmv")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\n", ts, sym, fmv)
+	fmt.Fprintf(w, "%s\t%s\t%s\n", ts, sym, priceCell("%.4f", fmv))
 }
 
 // init registers the crypto WebSocket command and all its subcommands under
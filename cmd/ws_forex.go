@@ -125,7 +125,7 @@ func formatForexQuote(w *tabwriter.Writer, event map[string]interface{}) {
 	pair := getStr(event, "p")
 	bid := getFloat(event, "b")
 	ask := getFloat(event, "a")
-	fmt.Fprintf(w, "%s\t%s\t%.6f\t%.6f\n", ts, pair, bid, ask)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ts, pair, priceCell("%.6f", bid), priceCell("%.6f", ask))
 }
 
 // formatForexAgg formats a single forex aggregate event (per-minute or per-second)
@@ -139,7 +139,8 @@ func formatForexAgg(w *tabwriter.Writer, event map[string]interface{}) {
 	l := getFloat(event, "l")
 	c := getFloat(event, "c")
 	v := getFloat(event, "v")
-	fmt.Fprintf(w, "%s\t%s\t%.6f\t%.6f\t%.6f\t%.6f\t%.0f\n", ts, pair, o, h, l, c, v)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%.0f\n", ts, pair, priceCell("%.6f", o), priceCell("%.6f", h),
+		priceCell("%.6f", l), priceCell("%.6f", c), v)
 }
 
 // init registers all forex WebSocket subcommands under the wsForexCmd parent,
@@ -124,7 +124,7 @@ func formatFuturesTrade(w *tabwriter.Writer, event map[string]interface{}) {
 	sym := getStr(event, "sym")
 	price := getFloat(event, "p")
 	size := getFloat(event, "s")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.0f\n", ts, sym, price, size)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\n", ts, sym, priceCell("%.4f", price), size)
 }
 
 // formatFuturesQuote formats a single futures quote event as a table row
@@ -137,7 +137,7 @@ func formatFuturesQuote(w *tabwriter.Writer, event map[string]interface{}) {
 	bidSize := getFloat(event, "bs")
 	ask := getFloat(event, "ap")
 	askSize := getFloat(event, "as")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.0f\t%.4f\t%.0f\n", ts, sym, bid, bidSize, ask, askSize)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\t%s\t%.0f\n", ts, sym, priceCell("%.4f", bid), bidSize, priceCell("%.4f", ask), askSize)
 }
 
 // init registers the futures WebSocket command and all its subcommands under
@@ -109,7 +109,7 @@ func formatIndicesValue(w *tabwriter.Writer, event map[string]interface{}) {
 	ts := formatTimestamp(event["t"])
 	sym := getStr(event, "T")
 	val := getFloat(event, "val")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\n", ts, sym, val)
+	fmt.Fprintf(w, "%s\t%s\t%s\n", ts, sym, priceCell("%.4f", val))
 }
 
 // init registers the indices WebSocket streaming subcommands under the
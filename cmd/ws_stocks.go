@@ -13,8 +13,8 @@ import (
 	"os/signal"
 	"strings"
 	"text/tabwriter"
-	"time"
 
+	"github.com/cloudmanic/massive-cli/internal/api"
 	"github.com/cloudmanic/massive-cli/internal/config"
 	"github.com/gorilla/websocket"
 	"github.com/spf13/cobra"
@@ -205,6 +205,7 @@ func buildWSURL(assetClass string) string {
 // API for any asset class, authenticates, subscribes to the given tickers, and
 // reads messages in a loop until the context is cancelled (e.g., via Ctrl+C).
 // The assetClass parameter determines the WebSocket path (e.g., "stocks", "crypto").
+// With --dry-run it prints the URL and subscription instead of connecting.
 func connectAndStreamAsset(parentCtx context.Context, assetClass, channel, tickerParams string, formatter tableFormatter) error {
 	apiKey, err := config.GetAPIKey()
 	if err != nil {
@@ -213,6 +214,11 @@ func connectAndStreamAsset(parentCtx context.Context, assetClass, channel, ticke
 
 	url := buildWSURL(assetClass)
 
+	if dryRun {
+		fmt.Printf("OK WS %s subscribe %s\n", url, tickerParams)
+		return api.ErrRequestPrinted
+	}
+
 	// Set up signal handling for clean shutdown on Ctrl+C.
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -290,7 +296,7 @@ func connectAndStreamAsset(parentCtx context.Context, assetClass, channel, ticke
 		}
 	}
 
-	fmt.Fprintf(os.Stderr, "Connected to %s/%s, subscribed to: %s\n", assetClass, channel, tickerParams)
+	infof("Connected to %s/%s, subscribed to: %s", assetClass, channel, tickerParams)
 
 	// Set up table writer for table output mode. The header is printed once
 	// and flushed after each batch of events to keep output streaming smoothly.
@@ -318,11 +324,11 @@ func connectAndStreamAsset(parentCtx context.Context, assetClass, channel, ticke
 		if err != nil {
 			// If context was cancelled, exit cleanly.
 			if ctx.Err() != nil {
-				fmt.Fprintln(os.Stderr, "\nDisconnected.")
+				infof("\nDisconnected.")
 				return nil
 			}
 			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
-				fmt.Fprintln(os.Stderr, "\nServer closed connection.")
+				infof("\nServer closed connection.")
 				return nil
 			}
 			return fmt.Errorf("error reading message: %w", err)
@@ -336,7 +342,7 @@ func connectAndStreamAsset(parentCtx context.Context, assetClass, channel, ticke
 			if err2 := json.Unmarshal(message, &single); err2 == nil {
 				events = []map[string]interface{}{single}
 			} else {
-				fmt.Fprintf(os.Stderr, "Warning: failed to parse message: %s\n", string(message))
+				warnf("failed to parse message: %s", string(message))
 				continue
 			}
 		}
@@ -346,7 +352,7 @@ func connectAndStreamAsset(parentCtx context.Context, assetClass, channel, ticke
 			if outputFormat == "json" {
 				line, err := json.Marshal(event)
 				if err != nil {
-					fmt.Fprintf(os.Stderr, "Warning: failed to marshal event: %v\n", err)
+					warnf("failed to marshal event: %v", err)
 					continue
 				}
 				fmt.Println(string(line))
@@ -369,41 +375,30 @@ func printTableHeader(w *tabwriter.Writer, channel string) {
 	switch channel {
 	// Stock channels
 	case "T":
-		fmt.Fprintln(w, "TIME\tSYMBOL\tPRICE\tSIZE\tEXCHANGE")
-		fmt.Fprintln(w, "----\t------\t-----\t----\t--------")
+		writeHeader(w, "TIME\tSYMBOL\tPRICE\tSIZE\tEXCHANGE", "----\t------\t-----\t----\t--------")
 	case "Q":
-		fmt.Fprintln(w, "TIME\tSYMBOL\tBID\tBID_SIZE\tASK\tASK_SIZE")
-		fmt.Fprintln(w, "----\t------\t---\t--------\t---\t--------")
+		writeHeader(w, "TIME\tSYMBOL\tBID\tBID_SIZE\tASK\tASK_SIZE", "----\t------\t---\t--------\t---\t--------")
 	case "AM", "A":
-		fmt.Fprintln(w, "TIME\tSYMBOL\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME")
-		fmt.Fprintln(w, "----\t------\t----\t----\t---\t-----\t------")
+		writeHeader(w, "TIME\tSYMBOL\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME", "----\t------\t----\t----\t---\t-----\t------")
 	case "LULD":
-		fmt.Fprintln(w, "TIME\tSYMBOL\tHIGH\tLOW")
-		fmt.Fprintln(w, "----\t------\t----\t---")
+		writeHeader(w, "TIME\tSYMBOL\tHIGH\tLOW", "----\t------\t----\t---")
 	case "V":
-		fmt.Fprintln(w, "TIME\tSYMBOL\tVALUE")
-		fmt.Fprintln(w, "----\t------\t-----")
+		writeHeader(w, "TIME\tSYMBOL\tVALUE", "----\t------\t-----")
 	// Crypto channels
 	case "XT":
-		fmt.Fprintln(w, "TIME\tPAIR\tPRICE\tSIZE\tEXCHANGE")
-		fmt.Fprintln(w, "----\t----\t-----\t----\t--------")
+		writeHeader(w, "TIME\tPAIR\tPRICE\tSIZE\tEXCHANGE", "----\t----\t-----\t----\t--------")
 	case "XQ":
-		fmt.Fprintln(w, "TIME\tPAIR\tBID\tBID_SIZE\tASK\tASK_SIZE")
-		fmt.Fprintln(w, "----\t----\t---\t--------\t---\t--------")
+		writeHeader(w, "TIME\tPAIR\tBID\tBID_SIZE\tASK\tASK_SIZE", "----\t----\t---\t--------\t---\t--------")
 	case "XA", "XAS":
-		fmt.Fprintln(w, "TIME\tPAIR\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME")
-		fmt.Fprintln(w, "----\t----\t----\t----\t---\t-----\t------")
+		writeHeader(w, "TIME\tPAIR\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME", "----\t----\t----\t----\t---\t-----\t------")
 	// Forex channels
 	case "C":
-		fmt.Fprintln(w, "TIME\tPAIR\tBID\tASK")
-		fmt.Fprintln(w, "----\t----\t---\t---")
+		writeHeader(w, "TIME\tPAIR\tBID\tASK", "----\t----\t---\t---")
 	case "CA", "CAS":
-		fmt.Fprintln(w, "TIME\tPAIR\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME")
-		fmt.Fprintln(w, "----\t----\t----\t----\t---\t-----\t------")
+		writeHeader(w, "TIME\tPAIR\tOPEN\tHIGH\tLOW\tCLOSE\tVOLUME", "----\t----\t----\t----\t---\t-----\t------")
 	// FMV channel (used for stocks, crypto, and forex)
 	case "FMV":
-		fmt.Fprintln(w, "TIME\tSYMBOL\tFMV")
-		fmt.Fprintln(w, "----\t------\t---")
+		writeHeader(w, "TIME\tSYMBOL\tFMV", "----\t------\t---")
 	}
 }
 
@@ -413,15 +408,13 @@ func printTableHeader(w *tabwriter.Writer, channel string) {
 func formatTimestamp(v interface{}) string {
 	switch val := v.(type) {
 	case float64:
-		t := time.Unix(0, int64(val)*int64(time.Millisecond))
-		return t.Format("15:04:05.000")
+		return timestampCell(int64(val), api.UnitMilliseconds, "15:04:05.000")
 	case json.Number:
 		n, err := val.Int64()
 		if err != nil {
 			return "N/A"
 		}
-		t := time.Unix(0, n*int64(time.Millisecond))
-		return t.Format("15:04:05.000")
+		return timestampCell(n, api.UnitMilliseconds, "15:04:05.000")
 	default:
 		return "N/A"
 	}
@@ -458,7 +451,7 @@ func formatTrade(w *tabwriter.Writer, event map[string]interface{}) {
 	price := getFloat(event, "p")
 	size := getFloat(event, "s")
 	exchange := getFloat(event, "x")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.0f\t%.0f\n", ts, sym, price, size, exchange)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\t%.0f\n", ts, sym, priceCell("%.4f", price), size, exchange)
 }
 
 // formatQuote formats a single NBBO quote event as a table row showing time,
@@ -471,7 +464,7 @@ func formatQuote(w *tabwriter.Writer, event map[string]interface{}) {
 	bidSize := getFloat(event, "bs")
 	ask := getFloat(event, "ap")
 	askSize := getFloat(event, "as")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.0f\t%.4f\t%.0f\n", ts, sym, bid, bidSize, ask, askSize)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\t%s\t%.0f\n", ts, sym, priceCell("%.4f", bid), bidSize, priceCell("%.4f", ask), askSize)
 }
 
 // formatAggregate formats a single aggregate bar event (per-minute or
@@ -485,7 +478,8 @@ func formatAggregate(w *tabwriter.Writer, event map[string]interface{}) {
 	low := getFloat(event, "l")
 	close_ := getFloat(event, "c")
 	volume := getFloat(event, "v")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\t%.4f\t%.4f\t%.0f\n", ts, sym, open, high, low, close_, volume)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%.0f\n", ts, sym, priceCell("%.4f", open),
+		priceCell("%.4f", high), priceCell("%.4f", low), priceCell("%.4f", close_), volume)
 }
 
 // formatLULD formats a single Limit Up/Limit Down event as a table row
@@ -496,7 +490,7 @@ func formatLULD(w *tabwriter.Writer, event map[string]interface{}) {
 	sym := getStr(event, "T")
 	high := getFloat(event, "h")
 	low := getFloat(event, "l")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\t%.4f\n", ts, sym, high, low)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ts, sym, priceCell("%.4f", high), priceCell("%.4f", low))
 }
 
 // formatFMV formats a single Fair Market Value event as a table row showing
@@ -506,7 +500,7 @@ func formatFMV(w *tabwriter.Writer, event map[string]interface{}) {
 	ts := formatTimestamp(event["t"])
 	sym := getStr(event, "sym")
 	fmv := getFloat(event, "fmv")
-	fmt.Fprintf(w, "%s\t%s\t%.4f\n", ts, sym, fmv)
+	fmt.Fprintf(w, "%s\t%s\t%s\n", ts, sym, priceCell("%.4f", fmv))
 }
 
 // init registers the ws command under rootCmd, the stocks command under ws,
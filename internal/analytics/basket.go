@@ -0,0 +1,111 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// BasketWeightTolerance is how far the weights of a currency basket may
+// stray from 1.0 before ParseBasketWeights rejects them, allowing for
+// rounded inputs such as three weights of 0.333.
+const BasketWeightTolerance = 0.001
+
+// BasketWeight is one currency of a basket and its share of the index.
+type BasketWeight struct {
+	Currency string
+	Weight   float64
+}
+
+// BasketComponent is a basket currency valued against the base currency.
+// Rate is units of the currency per unit of base, and Factor is Rate
+// raised to the currency's weight, its multiplicative contribution to the
+// index.
+type BasketComponent struct {
+	Currency string  `json:"currency"`
+	Weight   float64 `json:"weight"`
+	Rate     float64 `json:"rate"`
+	Factor   float64 `json:"factor"`
+}
+
+// ParseBasketWeights parses a comma-separated list of CURRENCY:WEIGHT
+// entries such as "EUR:0.4,GBP:0.3,JPY:0.3". Currency codes are
+// upper-cased, each must appear once with a positive weight, and the
+// weights must sum to 1.0 within BasketWeightTolerance.
+func ParseBasketWeights(s string) ([]BasketWeight, error) {
+	var weights []BasketWeight
+	seen := map[string]bool{}
+	var sum float64
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		currency, value, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid basket weight %q: expected CURRENCY:WEIGHT", entry)
+		}
+
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if len(currency) != 3 {
+			return nil, fmt.Errorf("invalid currency %q: expected a three-letter code like EUR", currency)
+		}
+		if seen[currency] {
+			return nil, fmt.Errorf("currency %s appears more than once", currency)
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid weight %q for %s: must be a number greater than zero", value, currency)
+		}
+
+		seen[currency] = true
+		sum += weight
+		weights = append(weights, BasketWeight{Currency: currency, Weight: weight})
+	}
+
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("basket contains no currencies")
+	}
+	if math.Abs(sum-1) > BasketWeightTolerance {
+		return nil, fmt.Errorf("basket weights sum to %g: they must sum to 1.0", sum)
+	}
+
+	return weights, nil
+}
+
+// BasketIndex computes a weighted index of the base currency against a
+// basket. rates maps each basket currency to units of that currency per
+// unit of base. The index is the weighted geometric mean of the rates,
+// the method used by the US Dollar Index, so currencies quoted in large
+// units such as JPY do not dominate it. A rising index means the base is
+// strengthening against the basket. Components are returned in weight
+// order.
+func BasketIndex(weights []BasketWeight, rates map[string]float64) ([]BasketComponent, float64, error) {
+	components := make([]BasketComponent, len(weights))
+	index := 1.0
+
+	for i, w := range weights {
+		rate, ok := rates[w.Currency]
+		if !ok {
+			return nil, 0, fmt.Errorf("no rate for %s", w.Currency)
+		}
+		if rate <= 0 {
+			return nil, 0, fmt.Errorf("invalid rate %v for %s: must be greater than zero", rate, w.Currency)
+		}
+
+		factor := math.Pow(rate, w.Weight)
+		components[i] = BasketComponent{Currency: w.Currency, Weight: w.Weight, Rate: rate, Factor: factor}
+		index *= factor
+	}
+
+	return components, index, nil
+}
@@ -0,0 +1,79 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// TestParseBasketWeights verifies parsing, upper-casing, and the rounding
+// tolerance on the weight sum.
+func TestParseBasketWeights(t *testing.T) {
+	weights, err := ParseBasketWeights("eur:0.4, GBP:0.3,JPY:0.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []BasketWeight{{"EUR", 0.4}, {"GBP", 0.3}, {"JPY", 0.3}}
+	if len(weights) != len(want) {
+		t.Fatalf("expected %d weights, got %d", len(want), len(weights))
+	}
+	for i, w := range want {
+		if weights[i] != w {
+			t.Errorf("index %d: expected %+v, got %+v", i, w, weights[i])
+		}
+	}
+
+	if _, err := ParseBasketWeights("EUR:0.333,GBP:0.333,JPY:0.333"); err != nil {
+		t.Errorf("expected rounded weights to be accepted, got %v", err)
+	}
+}
+
+// TestParseBasketWeightsErrors verifies that malformed, duplicate,
+// non-positive, and unbalanced weights are rejected.
+func TestParseBasketWeightsErrors(t *testing.T) {
+	cases := []string{
+		"",
+		"EUR",
+		"EURO:1",
+		"EUR:abc",
+		"EUR:0,GBP:1",
+		"EUR:-0.5,GBP:1.5",
+		"EUR:0.5,EUR:0.5",
+		"EUR:0.4,GBP:0.4",
+		"EUR:0.6,GBP:0.6",
+	}
+	for _, c := range cases {
+		if _, err := ParseBasketWeights(c); err == nil {
+			t.Errorf("expected error for %q", c)
+		}
+	}
+}
+
+// TestBasketIndex verifies the weighted geometric mean and the per-currency
+// factors.
+func TestBasketIndex(t *testing.T) {
+	weights := []BasketWeight{{"EUR", 0.5}, {"JPY", 0.5}}
+	components, index, err := BasketIndex(weights, map[string]float64{"EUR": 0.9, "JPY": 160})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := math.Sqrt(0.9 * 160); !approxEqual(index, want) {
+		t.Errorf("expected index %v, got %v", want, index)
+	}
+	if !approxEqual(components[1].Factor, math.Sqrt(160)) {
+		t.Errorf("expected JPY factor %v, got %v", math.Sqrt(160), components[1].Factor)
+	}
+
+	if _, _, err := BasketIndex(weights, map[string]float64{"EUR": 0.9}); err == nil {
+		t.Error("expected error for a missing rate")
+	}
+	if _, _, err := BasketIndex(weights, map[string]float64{"EUR": 0.9, "JPY": 0}); err == nil {
+		t.Error("expected error for a zero rate")
+	}
+}
@@ -0,0 +1,121 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// Channel holds the upper, middle, and lower bands of a price channel.
+// Each series is aligned with the input bars and is NaN where the band is
+// still warming up.
+type Channel struct {
+	Upper  []float64
+	Middle []float64
+	Lower  []float64
+}
+
+// newChannel returns a Channel of length n with every band set to NaN.
+func newChannel(n int) Channel {
+	c := Channel{Upper: make([]float64, n), Middle: make([]float64, n), Lower: make([]float64, n)}
+	for i := range n {
+		c.Upper[i], c.Middle[i], c.Lower[i] = math.NaN(), math.NaN(), math.NaN()
+	}
+	return c
+}
+
+// TrueRange returns the true range of each bar: the largest of high - low,
+// |high - previous close|, and |low - previous close|. The first bar has
+// no previous close, so its true range is high - low.
+func TrueRange(bars []api.Bar) []float64 {
+	values := make([]float64, len(bars))
+	for i, b := range bars {
+		values[i] = b.High - b.Low
+		if i > 0 {
+			prev := bars[i-1].Close
+			values[i] = math.Max(values[i], math.Max(math.Abs(b.High-prev), math.Abs(b.Low-prev)))
+		}
+	}
+	return values
+}
+
+// ATR computes the Average True Range with Wilder smoothing. The first
+// value, at index window-1, is the simple average of the first window true
+// ranges; each later value is (previous ATR * (window-1) + true range) /
+// window. Earlier entries are NaN. Bars must be in ascending time order.
+func ATR(bars []api.Bar, window int) []float64 {
+	tr := TrueRange(bars)
+	values := make([]float64, len(bars))
+
+	var sum float64
+	for i := range tr {
+		switch {
+		case window <= 0 || i < window-1:
+			sum += tr[i]
+			values[i] = math.NaN()
+		case i == window-1:
+			values[i] = (sum + tr[i]) / float64(window)
+		default:
+			values[i] = (values[i-1]*float64(window-1) + tr[i]) / float64(window)
+		}
+	}
+
+	return values
+}
+
+// KeltnerChannels computes Keltner Channels: an EMA of closes over
+// emaWindow as the middle band, with the upper and lower bands multiplier
+// ATRs (over atrWindow) above and below it. Bands are NaN until both the
+// EMA and the ATR have warmed up. Bars must be in ascending time order.
+func KeltnerChannels(bars []api.Bar, emaWindow, atrWindow int, multiplier float64) Channel {
+	closes := make([]float64, len(bars))
+	for i, b := range bars {
+		closes[i] = b.Close
+	}
+
+	ema := EMA(closes, emaWindow)
+	atr := ATR(bars, atrWindow)
+
+	c := newChannel(len(bars))
+	for i := range bars {
+		if math.IsNaN(ema[i]) || math.IsNaN(atr[i]) {
+			continue
+		}
+		c.Middle[i] = ema[i]
+		c.Upper[i] = ema[i] + multiplier*atr[i]
+		c.Lower[i] = ema[i] - multiplier*atr[i]
+	}
+
+	return c
+}
+
+// DonchianChannels computes Donchian Channels: the highest high and lowest
+// low of the last window bars as the upper and lower bands, and their
+// midpoint as the middle band. Bands are NaN while fewer than window bars
+// are available. Bars must be in ascending time order.
+func DonchianChannels(bars []api.Bar, window int) Channel {
+	c := newChannel(len(bars))
+
+	for i := range bars {
+		if window <= 0 || i < window-1 {
+			continue
+		}
+
+		highest, lowest := bars[i].High, bars[i].Low
+		for _, b := range bars[i-window+1 : i] {
+			highest = math.Max(highest, b.High)
+			lowest = math.Min(lowest, b.Low)
+		}
+
+		c.Upper[i] = highest
+		c.Lower[i] = lowest
+		c.Middle[i] = (highest + lowest) / 2
+	}
+
+	return c
+}
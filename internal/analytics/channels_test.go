@@ -0,0 +1,106 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// channelBars is a short ascending series whose last bar gaps up, so its
+// true range comes from the previous close rather than its own range.
+var channelBars = []api.Bar{
+	{High: 10, Low: 8, Close: 9},
+	{High: 11, Low: 9, Close: 10},
+	{High: 12, Low: 9, Close: 11},
+	{High: 15, Low: 13, Close: 14},
+}
+
+// approxEqual reports whether got is within 1e-9 of want.
+func approxEqual(got, want float64) bool {
+	return math.Abs(got-want) < 1e-9
+}
+
+// TestTrueRange verifies the plain high-low range and the gap case.
+func TestTrueRange(t *testing.T) {
+	want := []float64{2, 2, 3, 4}
+	for i, got := range TrueRange(channelBars) {
+		if got != want[i] {
+			t.Errorf("index %d: expected %v, got %v", i, want[i], got)
+		}
+	}
+}
+
+// TestATR verifies the SMA seed and Wilder smoothing step.
+func TestATR(t *testing.T) {
+	values := ATR(channelBars, 3)
+
+	if !math.IsNaN(values[0]) || !math.IsNaN(values[1]) {
+		t.Errorf("expected NaN before the seed, got %v", values[:2])
+	}
+	if !approxEqual(values[2], 7.0/3) {
+		t.Errorf("index 2: expected %v, got %v", 7.0/3, values[2])
+	}
+	if !approxEqual(values[3], 26.0/9) {
+		t.Errorf("index 3: expected %v, got %v", 26.0/9, values[3])
+	}
+}
+
+// TestKeltnerChannels verifies the EMA middle band and the ATR offsets.
+func TestKeltnerChannels(t *testing.T) {
+	c := KeltnerChannels(channelBars, 2, 2, 2)
+
+	if !math.IsNaN(c.Upper[0]) || !math.IsNaN(c.Middle[0]) || !math.IsNaN(c.Lower[0]) {
+		t.Errorf("expected NaN bands at index 0, got %v/%v/%v", c.Upper[0], c.Middle[0], c.Lower[0])
+	}
+
+	want := []struct {
+		idx                  int
+		upper, middle, lower float64
+	}{
+		{1, 13.5, 9.5, 5.5},
+		{2, 15.5, 10.5, 5.5},
+		{3, 12.5 + 1.0/3 + 6.5, 12.5 + 1.0/3, 12.5 + 1.0/3 - 6.5},
+	}
+	for _, w := range want {
+		if !approxEqual(c.Upper[w.idx], w.upper) || !approxEqual(c.Middle[w.idx], w.middle) || !approxEqual(c.Lower[w.idx], w.lower) {
+			t.Errorf("index %d: expected %v/%v/%v, got %v/%v/%v", w.idx,
+				w.upper, w.middle, w.lower, c.Upper[w.idx], c.Middle[w.idx], c.Lower[w.idx])
+		}
+	}
+}
+
+// TestDonchianChannels verifies the rolling high, low, and midpoint.
+func TestDonchianChannels(t *testing.T) {
+	c := DonchianChannels(channelBars, 2)
+
+	if !math.IsNaN(c.Upper[0]) {
+		t.Errorf("expected NaN at index 0, got %v", c.Upper[0])
+	}
+
+	want := []struct {
+		idx                  int
+		upper, middle, lower float64
+	}{
+		{1, 11, 9.5, 8},
+		{2, 12, 10.5, 9},
+		{3, 15, 12, 9},
+	}
+	for _, w := range want {
+		if c.Upper[w.idx] != w.upper || c.Middle[w.idx] != w.middle || c.Lower[w.idx] != w.lower {
+			t.Errorf("index %d: expected %v/%v/%v, got %v/%v/%v", w.idx,
+				w.upper, w.middle, w.lower, c.Upper[w.idx], c.Middle[w.idx], c.Lower[w.idx])
+		}
+	}
+
+	for i, v := range DonchianChannels(channelBars, 0).Upper {
+		if !math.IsNaN(v) {
+			t.Errorf("index %d: expected NaN for a zero window, got %v", i, v)
+		}
+	}
+}
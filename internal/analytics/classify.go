@@ -0,0 +1,55 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+// TradeSide is the inferred aggressor side of a trade.
+type TradeSide string
+
+// Trade sides reported by ClassifyTrade. SideMid means neither the quote
+// nor the tick test could tell buyer- from seller-initiated.
+const (
+	SideBuy  TradeSide = "BUY"
+	SideSell TradeSide = "SELL"
+	SideMid  TradeSide = "MID"
+)
+
+// Classification rules, naming which test decided a TradeSide.
+const (
+	RuleQuote = "quote"
+	RuleTick  = "tick"
+	RuleNone  = "none"
+)
+
+// ClassifyTrade infers whether a trade was buyer- or seller-initiated
+// using the Lee-Ready rule. With a valid quote (positive bid, ask not
+// below it) a trade above the midpoint is a buy and one below it a sell,
+// which covers trades at or through the ask or bid. A trade at the
+// midpoint, or one without a quote, falls back to the tick test against
+// prior, the previous reference price: an uptick is a buy, a downtick a
+// sell. A zero prior or an unchanged price leaves the side undecided. The
+// rule that decided the side is returned alongside it.
+func ClassifyTrade(price, bid, ask, prior float64) (TradeSide, string) {
+	if bid > 0 && ask >= bid {
+		mid := (bid + ask) / 2
+		switch {
+		case price > mid:
+			return SideBuy, RuleQuote
+		case price < mid:
+			return SideSell, RuleQuote
+		}
+	}
+
+	if prior > 0 {
+		switch {
+		case price > prior:
+			return SideBuy, RuleTick
+		case price < prior:
+			return SideSell, RuleTick
+		}
+	}
+
+	return SideMid, RuleNone
+}
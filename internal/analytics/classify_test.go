@@ -0,0 +1,37 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import "testing"
+
+// TestClassifyTrade verifies the quote rule around the midpoint, the tick
+// test fallback at the midpoint and without a quote, and undecided trades.
+func TestClassifyTrade(t *testing.T) {
+	tests := []struct {
+		name                   string
+		price, bid, ask, prior float64
+		side                   TradeSide
+		rule                   string
+	}{
+		{"at ask", 101, 99, 101, 0, SideBuy, RuleQuote},
+		{"above mid", 100.5, 99, 101, 0, SideBuy, RuleQuote},
+		{"at bid", 99, 99, 101, 0, SideSell, RuleQuote},
+		{"through bid", 98, 99, 101, 100, SideSell, RuleQuote},
+		{"mid uptick", 100, 99, 101, 99.5, SideBuy, RuleTick},
+		{"mid downtick", 100, 99, 101, 100.5, SideSell, RuleTick},
+		{"no quote downtick", 50, 0, 0, 51, SideSell, RuleTick},
+		{"crossed quote uptick", 50, 52, 48, 49, SideBuy, RuleTick},
+		{"mid unchanged", 100, 99, 101, 100, SideMid, RuleNone},
+		{"nothing to compare", 50, 0, 0, 0, SideMid, RuleNone},
+	}
+
+	for _, tt := range tests {
+		side, rule := ClassifyTrade(tt.price, tt.bid, tt.ask, tt.prior)
+		if side != tt.side || rule != tt.rule {
+			t.Errorf("%s: expected %s by %s, got %s by %s", tt.name, tt.side, tt.rule, side, rule)
+		}
+	}
+}
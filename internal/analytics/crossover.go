@@ -0,0 +1,64 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import "math"
+
+// Cross directions reported by Crossovers.
+const (
+	GoldenCross = "golden"
+	DeathCross  = "death"
+)
+
+// CrossEvent is a point where the fast moving average crossed the slow
+// one. Direction is GoldenCross when the fast average moved above the slow
+// average and DeathCross when it moved below. Fast and Slow are the two
+// averages at the crossing timestamp.
+type CrossEvent struct {
+	Timestamp int64   `json:"timestamp"`
+	Direction string  `json:"direction"`
+	Fast      float64 `json:"fast"`
+	Slow      float64 `json:"slow"`
+}
+
+// Crossovers returns the crossings of fast over slow. The three slices are
+// aligned by index and must be in ascending time order. Entries where
+// either average is NaN are skipped, and a tie does not count as a cross:
+// an event is reported only once the fast average is strictly on the
+// other side of the slow one from where it last was.
+func Crossovers(fast, slow []float64, timestamps []int64) []CrossEvent {
+	n := min(len(fast), len(slow), len(timestamps))
+
+	var events []CrossEvent
+	side := 0
+	for i := range n {
+		if math.IsNaN(fast[i]) || math.IsNaN(slow[i]) {
+			continue
+		}
+
+		current := 0
+		switch {
+		case fast[i] > slow[i]:
+			current = 1
+		case fast[i] < slow[i]:
+			current = -1
+		}
+		if current == 0 {
+			continue
+		}
+
+		if side != 0 && current != side {
+			direction := GoldenCross
+			if current < 0 {
+				direction = DeathCross
+			}
+			events = append(events, CrossEvent{Timestamp: timestamps[i], Direction: direction, Fast: fast[i], Slow: slow[i]})
+		}
+		side = current
+	}
+
+	return events
+}
@@ -0,0 +1,47 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// TestCrossovers verifies golden and death crosses, that ties carry the
+// previous side instead of producing a cross, and that NaN warm-up
+// entries are skipped.
+func TestCrossovers(t *testing.T) {
+	nan := math.NaN()
+	fast := []float64{nan, 1, 3, 3, 2, 2, 4}
+	slow := []float64{5, 2, 2, 3, 3, 2, 3}
+	timestamps := []int64{10, 20, 30, 40, 50, 60, 70}
+
+	events := Crossovers(fast, slow, timestamps)
+	want := []CrossEvent{
+		{Timestamp: 30, Direction: GoldenCross, Fast: 3, Slow: 2},
+		{Timestamp: 50, Direction: DeathCross, Fast: 2, Slow: 3},
+		{Timestamp: 70, Direction: GoldenCross, Fast: 4, Slow: 3},
+	}
+
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), events)
+	}
+	for i, w := range want {
+		if events[i] != w {
+			t.Errorf("event %d: expected %+v, got %+v", i, w, events[i])
+		}
+	}
+}
+
+// TestCrossoversNone verifies that a fast average that stays on one side,
+// or starts level, reports no crosses.
+func TestCrossoversNone(t *testing.T) {
+	fast := []float64{2, 3, 4}
+	slow := []float64{2, 1, 1}
+	if events := Crossovers(fast, slow, []int64{1, 2, 3}); len(events) != 0 {
+		t.Errorf("expected no events, got %+v", events)
+	}
+}
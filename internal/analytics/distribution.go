@@ -0,0 +1,174 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// HistogramBucket is one bucket of a histogram: the half-open range
+// [Lower, Upper) and how many values fell into it. The last bucket also
+// includes its upper bound so the maximum value is counted.
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int     `json:"count"`
+}
+
+// Returns computes the percent return between each pair of consecutive
+// closes: (close - previous close) / previous close * 100. The result has
+// one fewer entry than bars. Pairs whose previous close is zero are
+// skipped. Bars must be sorted in ascending time order.
+func Returns(bars []api.Bar) []float64 {
+	var returns []float64
+
+	for i := 1; i < len(bars); i++ {
+		prior := bars[i-1].Close
+		if prior == 0 {
+			continue
+		}
+		returns = append(returns, (bars[i].Close-prior)/prior*100)
+	}
+
+	return returns
+}
+
+// Histogram groups values into the given number of equal-width buckets
+// spanning the minimum to the maximum value. NaN values are ignored. It
+// returns nil when buckets is not positive or there are no values. When
+// every value is the same, a single zero-width bucket holds them all.
+func Histogram(values []float64, buckets int) []HistogramBucket {
+	if buckets <= 0 {
+		return nil
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	n := 0
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if lo == hi {
+		return []HistogramBucket{{Lower: lo, Upper: hi, Count: n}}
+	}
+
+	width := (hi - lo) / float64(buckets)
+	result := make([]HistogramBucket, buckets)
+	for i := range result {
+		result[i].Lower = lo + float64(i)*width
+		result[i].Upper = lo + float64(i+1)*width
+	}
+	result[buckets-1].Upper = hi
+
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		i := int((v - lo) / width)
+		if i >= buckets {
+			i = buckets - 1
+		}
+		result[i].Count++
+	}
+
+	return result
+}
+
+// Mean returns the arithmetic mean of values, or NaN when values is empty.
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
+
+// StdDev returns the population standard deviation of values, or NaN when
+// values is empty.
+func StdDev(values []float64) float64 {
+	return math.Sqrt(centralMoment(values, 2))
+}
+
+// Skewness returns the population skewness of values: the third central
+// moment divided by the cubed standard deviation. A positive value means
+// the distribution has a longer right tail. It is NaN when there are
+// fewer than two values or they have no spread.
+func Skewness(values []float64) float64 {
+	m2 := centralMoment(values, 2)
+	if len(values) < 2 || m2 == 0 {
+		return math.NaN()
+	}
+
+	return centralMoment(values, 3) / math.Pow(m2, 1.5)
+}
+
+// Kurtosis returns the population excess kurtosis of values: the fourth
+// central moment divided by the squared variance, minus 3 so a normal
+// distribution scores zero. Positive values indicate fatter tails than
+// normal. It is NaN when there are fewer than two values or they have no
+// spread.
+func Kurtosis(values []float64) float64 {
+	m2 := centralMoment(values, 2)
+	if len(values) < 2 || m2 == 0 {
+		return math.NaN()
+	}
+
+	return centralMoment(values, 4)/(m2*m2) - 3
+}
+
+// Correlation returns the Pearson correlation coefficient of x and y,
+// between -1 and 1. It is NaN when the slices differ in length, hold fewer
+// than two values, or either has no spread.
+func Correlation(x, y []float64) float64 {
+	if len(x) != len(y) || len(x) < 2 {
+		return math.NaN()
+	}
+
+	mx, my := Mean(x), Mean(y)
+	var cov, vx, vy float64
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		cov += dx * dy
+		vx += dx * dx
+		vy += dy * dy
+	}
+	if vx == 0 || vy == 0 {
+		return math.NaN()
+	}
+
+	return cov / math.Sqrt(vx*vy)
+}
+
+// centralMoment returns the k-th population central moment of values, or
+// NaN when values is empty.
+func centralMoment(values []float64, k int) float64 {
+	mean := Mean(values)
+	if math.IsNaN(mean) {
+		return mean
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += math.Pow(v-mean, float64(k))
+	}
+
+	return sum / float64(len(values))
+}
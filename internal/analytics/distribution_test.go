@@ -0,0 +1,133 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestReturns verifies close-to-close percent returns for testBars and
+// that a zero prior close is skipped.
+func TestReturns(t *testing.T) {
+	got := Returns(testBars)
+
+	want := []float64{2.0 / 11 * 100, -1.0 / 13 * 100, -3.0 / 12 * 100, 2.0 / 9 * 100}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d returns, got %d", len(want), len(got))
+	}
+	for i, w := range want {
+		if math.Abs(got[i]-w) > 1e-9 {
+			t.Errorf("return %d: expected %v, got %v", i, w, got[i])
+		}
+	}
+
+	if r := Returns([]api.Bar{{Close: 0}, {Close: 5}, {Close: 10}}); len(r) != 1 || r[0] != 100 {
+		t.Errorf("expected [100] when the first close is zero, got %v", r)
+	}
+}
+
+// TestHistogram verifies bucket edges and counts, including that the
+// maximum value lands in the last bucket.
+func TestHistogram(t *testing.T) {
+	buckets := Histogram([]float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 10, math.NaN()}, 5)
+
+	if len(buckets) != 5 {
+		t.Fatalf("expected 5 buckets, got %d", len(buckets))
+	}
+
+	wantCounts := []int{2, 2, 2, 2, 2}
+	for i, b := range buckets {
+		if b.Count != wantCounts[i] {
+			t.Errorf("bucket %d: expected count %d, got %d", i, wantCounts[i], b.Count)
+		}
+		if math.Abs(b.Lower-float64(i*2)) > 1e-9 || math.Abs(b.Upper-float64(i*2+2)) > 1e-9 {
+			t.Errorf("bucket %d: unexpected range [%v, %v)", i, b.Lower, b.Upper)
+		}
+	}
+}
+
+// TestHistogramEdgeCases verifies the empty, invalid bucket count, and
+// constant-value cases.
+func TestHistogramEdgeCases(t *testing.T) {
+	if b := Histogram(nil, 5); b != nil {
+		t.Errorf("expected nil for no values, got %v", b)
+	}
+
+	if b := Histogram([]float64{1, 2}, 0); b != nil {
+		t.Errorf("expected nil for zero buckets, got %v", b)
+	}
+
+	b := Histogram([]float64{3, 3, 3}, 4)
+	if len(b) != 1 || b[0].Count != 3 || b[0].Lower != 3 || b[0].Upper != 3 {
+		t.Errorf("expected one bucket holding all constant values, got %v", b)
+	}
+}
+
+// TestMoments verifies mean, standard deviation, skewness, and excess
+// kurtosis against hand-computed population moments of 1, 2, 3, 4, 10.
+func TestMoments(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 10}
+
+	checks := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"mean", Mean(values), 4},
+		{"stddev", StdDev(values), math.Sqrt(10)},
+		{"skewness", Skewness(values), 36 / math.Pow(10, 1.5)},
+		{"kurtosis", Kurtosis(values), 278.8/100 - 3},
+	}
+
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+// TestMomentsDegenerate verifies that empty and zero-spread inputs return
+// NaN instead of dividing by zero.
+func TestMomentsDegenerate(t *testing.T) {
+	if !math.IsNaN(Mean(nil)) {
+		t.Error("expected NaN mean for no values")
+	}
+	if !math.IsNaN(Skewness([]float64{2, 2, 2})) {
+		t.Error("expected NaN skewness for constant values")
+	}
+	if !math.IsNaN(Kurtosis([]float64{5})) {
+		t.Error("expected NaN kurtosis for a single value")
+	}
+}
+
+// TestCorrelation verifies perfect positive and negative correlation, a
+// hand-computed partial case, and the NaN cases.
+func TestCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+
+	if got := Correlation(x, []float64{2, 4, 6, 8}); math.Abs(got-1) > 1e-12 {
+		t.Errorf("expected 1, got %v", got)
+	}
+	if got := Correlation(x, []float64{8, 6, 4, 2}); math.Abs(got+1) > 1e-12 {
+		t.Errorf("expected -1, got %v", got)
+	}
+	if got := Correlation(x, []float64{1, 3, 2, 4}); math.Abs(got-0.8) > 1e-12 {
+		t.Errorf("expected 0.8, got %v", got)
+	}
+
+	if !math.IsNaN(Correlation(x, []float64{1, 2})) {
+		t.Error("expected NaN for mismatched lengths")
+	}
+	if !math.IsNaN(Correlation([]float64{1}, []float64{1})) {
+		t.Error("expected NaN for a single value")
+	}
+	if !math.IsNaN(Correlation(x, []float64{5, 5, 5, 5})) {
+		t.Error("expected NaN for a constant series")
+	}
+}
@@ -0,0 +1,65 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+// Gap is the opening gap of one ticker: how far the day's open sits from
+// the previous day's close, as a price change and a percentage of that
+// close.
+type Gap struct {
+	Ticker    string  `json:"ticker"`
+	PrevClose float64 `json:"prev_close"`
+	Open      float64 `json:"open"`
+	Change    float64 `json:"change"`
+	Percent   float64 `json:"gap_percent"`
+}
+
+// OpeningGap measures the gap between prevClose and open. ok is false
+// when either price is missing (zero), as for a pair that has not traded
+// today or has no prior day.
+func OpeningGap(ticker string, prevClose, open float64) (g Gap, ok bool) {
+	if prevClose == 0 || open == 0 {
+		return Gap{}, false
+	}
+
+	change := open - prevClose
+	return Gap{
+		Ticker:    ticker,
+		PrevClose: prevClose,
+		Open:      open,
+		Change:    change,
+		Percent:   change / prevClose * 100,
+	}, true
+}
+
+// RankGaps keeps the gaps at least minPercent in size, up or down, and
+// orders them by gap percent descending, so the largest gap ups lead and
+// the largest gap downs trail. Equal gaps are ordered by ticker.
+func RankGaps(gaps []Gap, minPercent float64) []Gap {
+	kept := make([]Gap, 0, len(gaps))
+	for _, g := range gaps {
+		if math.Abs(g.Percent) >= minPercent {
+			kept = append(kept, g)
+		}
+	}
+
+	slices.SortStableFunc(kept, func(a, b Gap) int {
+		switch {
+		case a.Percent > b.Percent:
+			return -1
+		case a.Percent < b.Percent:
+			return 1
+		}
+		return strings.Compare(a.Ticker, b.Ticker)
+	})
+
+	return kept
+}
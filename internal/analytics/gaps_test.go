@@ -0,0 +1,57 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// TestOpeningGap verifies the gap change and percent, and that a missing
+// open or previous close yields no gap.
+func TestOpeningGap(t *testing.T) {
+	g, ok := OpeningGap("X:BTCUSD", 40000, 41000)
+	if !ok {
+		t.Fatal("expected a gap")
+	}
+	if g.Change != 1000 || math.Abs(g.Percent-2.5) > 1e-9 {
+		t.Errorf("expected a 1000 (2.5%%) gap, got %+v", g)
+	}
+
+	if _, ok := OpeningGap("X:ETHUSD", 0, 2000); ok {
+		t.Error("expected no gap without a previous close")
+	}
+	if _, ok := OpeningGap("X:ETHUSD", 2000, 0); ok {
+		t.Error("expected no gap without an open")
+	}
+}
+
+// TestRankGaps verifies that small gaps in either direction are dropped
+// and the rest are ordered by percent descending.
+func TestRankGaps(t *testing.T) {
+	gaps := []Gap{
+		{Ticker: "A", Percent: 0.5},
+		{Ticker: "B", Percent: -4},
+		{Ticker: "C", Percent: 3},
+		{Ticker: "D", Percent: -1.5},
+		{Ticker: "E", Percent: 3},
+	}
+
+	ranked := RankGaps(gaps, 1)
+	want := []string{"C", "E", "D", "B"}
+	if len(ranked) != len(want) {
+		t.Fatalf("expected %d gaps, got %+v", len(want), ranked)
+	}
+	for i, ticker := range want {
+		if ranked[i].Ticker != ticker {
+			t.Errorf("position %d: expected %s, got %s", i, ticker, ranked[i].Ticker)
+		}
+	}
+
+	if got := RankGaps(gaps, 0); len(got) != len(gaps) {
+		t.Errorf("expected every gap with no minimum, got %d", len(got))
+	}
+}
@@ -0,0 +1,145 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// SummarySortKeys lists the --sort-by keys SortSummaries accepts. Every
+// key but ticker sorts descending, so the most active names come first.
+var SummarySortKeys = []string{"ticker", "volume", "dollar-volume", "trades", "change", "close"}
+
+// summaryValue returns the value a daily summary is ranked by for key.
+// change is the open-to-close percent move, zero when the open is zero,
+// and dollar-volume is volume times VWAP.
+func summaryValue(s api.MarketSummary, key string) float64 {
+	switch key {
+	case "volume":
+		return s.Volume
+	case "dollar-volume":
+		return s.Volume * s.VWAP
+	case "trades":
+		return float64(s.NumTrades)
+	case "change":
+		if s.Open == 0 {
+			return 0
+		}
+		return (s.Close - s.Open) / s.Open * 100
+	case "close":
+		return s.Close
+	}
+	return 0
+}
+
+// SortSummaries orders grouped daily summaries in place by key, one of
+// SummarySortKeys: ticker ascending, anything else descending. Ties keep
+// ticker order so output is stable between runs.
+func SortSummaries(rows []api.MarketSummary, key string) error {
+	if !slices.Contains(SummarySortKeys, key) {
+		return fmt.Errorf("invalid sort key %q: must be one of %s", key, strings.Join(SummarySortKeys, ", "))
+	}
+
+	slices.SortStableFunc(rows, func(a, b api.MarketSummary) int {
+		if key != "ticker" {
+			va, vb := summaryValue(a, key), summaryValue(b, key)
+			if va != vb {
+				if va > vb {
+					return -1
+				}
+				return 1
+			}
+		}
+		return strings.Compare(a.Ticker, b.Ticker)
+	})
+
+	return nil
+}
+
+// SummaryGroup totals the daily summaries of tickers sharing a group key,
+// such as the quote currency of crypto pairs.
+type SummaryGroup struct {
+	Key          string  `json:"key"`
+	Tickers      int     `json:"tickers"`
+	Volume       float64 `json:"volume"`
+	DollarVolume float64 `json:"dollar_volume"`
+	Trades       int     `json:"trades"`
+}
+
+// GroupSummaries totals rows by the key keyOf returns for each ticker and
+// orders the groups by key (ticker sorts by group name), one of
+// SummarySortKeys; change and close have no meaning for a group and fall
+// back to volume.
+func GroupSummaries(rows []api.MarketSummary, keyOf func(ticker string) string, key string) ([]SummaryGroup, error) {
+	if !slices.Contains(SummarySortKeys, key) {
+		return nil, fmt.Errorf("invalid sort key %q: must be one of %s", key, strings.Join(SummarySortKeys, ", "))
+	}
+
+	index := map[string]int{}
+	var groups []SummaryGroup
+	for _, s := range rows {
+		k := keyOf(s.Ticker)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, SummaryGroup{Key: k})
+		}
+		groups[i].Tickers++
+		groups[i].Volume += s.Volume
+		groups[i].DollarVolume += s.Volume * s.VWAP
+		groups[i].Trades += s.NumTrades
+	}
+
+	value := func(g SummaryGroup) float64 {
+		switch key {
+		case "dollar-volume":
+			return g.DollarVolume
+		case "trades":
+			return float64(g.Trades)
+		}
+		return g.Volume
+	}
+
+	slices.SortStableFunc(groups, func(a, b SummaryGroup) int {
+		if key != "ticker" {
+			va, vb := value(a), value(b)
+			if va != vb {
+				if va > vb {
+					return -1
+				}
+				return 1
+			}
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return groups, nil
+}
+
+// cryptoQuoteCurrencies are the quote currencies crypto pairs trade
+// against, longest first so USDT is matched before USD.
+var cryptoQuoteCurrencies = []string{"USDT", "USDC", "BUSD", "USD", "EUR", "GBP", "JPY", "AUD", "CAD", "CHF", "DAI", "BTC", "ETH"}
+
+// CryptoQuoteCurrency returns the quote currency of a crypto pair ticker
+// such as X:BTCUSD (USD) or X:ETHUSDT (USDT), the market the pair trades
+// in. Unknown quotes fall back to the last three letters.
+func CryptoQuoteCurrency(ticker string) string {
+	pair := strings.ToUpper(strings.TrimPrefix(ticker, "X:"))
+	for _, quote := range cryptoQuoteCurrencies {
+		if len(pair) > len(quote) && strings.HasSuffix(pair, quote) {
+			return quote
+		}
+	}
+	if len(pair) > 3 {
+		return pair[len(pair)-3:]
+	}
+	return pair
+}
@@ -0,0 +1,103 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// groupedRows is a small grouped daily summary used by the sort and
+// grouping tests.
+var groupedRows = []api.MarketSummary{
+	{Ticker: "X:ETHUSD", Open: 100, Close: 110, Volume: 50, VWAP: 105, NumTrades: 7},
+	{Ticker: "X:BTCUSD", Open: 200, Close: 190, Volume: 10, VWAP: 195, NumTrades: 9},
+	{Ticker: "X:BTCEUR", Open: 180, Close: 180, Volume: 50, VWAP: 181, NumTrades: 3},
+	{Ticker: "X:SOLUSDT", Open: 0, Close: 20, Volume: 400, VWAP: 1, NumTrades: 2},
+}
+
+// tickersOf returns the tickers of rows in order.
+func tickersOf(rows []api.MarketSummary) []string {
+	tickers := make([]string, len(rows))
+	for i, r := range rows {
+		tickers[i] = r.Ticker
+	}
+	return tickers
+}
+
+// TestSortSummaries verifies descending sorts with ticker tie-breaks, the
+// ascending ticker sort, and rejection of unknown keys.
+func TestSortSummaries(t *testing.T) {
+	tests := []struct {
+		key  string
+		want []string
+	}{
+		{"volume", []string{"X:SOLUSDT", "X:BTCEUR", "X:ETHUSD", "X:BTCUSD"}},
+		{"dollar-volume", []string{"X:BTCEUR", "X:ETHUSD", "X:BTCUSD", "X:SOLUSDT"}},
+		{"change", []string{"X:ETHUSD", "X:BTCEUR", "X:SOLUSDT", "X:BTCUSD"}},
+		{"ticker", []string{"X:BTCEUR", "X:BTCUSD", "X:ETHUSD", "X:SOLUSDT"}},
+	}
+
+	for _, tt := range tests {
+		rows := append([]api.MarketSummary(nil), groupedRows...)
+		if err := SortSummaries(rows, tt.key); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.key, err)
+		}
+		got := tickersOf(rows)
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: expected %v, got %v", tt.key, tt.want, got)
+				break
+			}
+		}
+	}
+
+	if err := SortSummaries(groupedRows, "vwap"); err == nil {
+		t.Error("expected an error for an unknown sort key")
+	}
+}
+
+// TestGroupSummaries verifies totals per quote currency and that groups
+// are ordered by volume.
+func TestGroupSummaries(t *testing.T) {
+	groups, err := GroupSummaries(groupedRows, CryptoQuoteCurrency, "volume")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []SummaryGroup{
+		{Key: "USDT", Tickers: 1, Volume: 400, DollarVolume: 400, Trades: 2},
+		{Key: "USD", Tickers: 2, Volume: 60, DollarVolume: 50*105 + 10*195, Trades: 16},
+		{Key: "EUR", Tickers: 1, Volume: 50, DollarVolume: 50 * 181, Trades: 3},
+	}
+	if len(groups) != len(want) {
+		t.Fatalf("expected %d groups, got %+v", len(want), groups)
+	}
+	for i := range want {
+		if groups[i] != want[i] {
+			t.Errorf("group %d: expected %+v, got %+v", i, want[i], groups[i])
+		}
+	}
+}
+
+// TestCryptoQuoteCurrency verifies that longer quote currencies win and
+// unknown quotes fall back to the last three letters.
+func TestCryptoQuoteCurrency(t *testing.T) {
+	tests := map[string]string{
+		"X:BTCUSD":  "USD",
+		"X:ETHUSDT": "USDT",
+		"X:ETHBTC":  "BTC",
+		"x:dogeusd": "USD",
+		"X:ABCXYZ":  "XYZ",
+	}
+
+	for ticker, want := range tests {
+		if got := CryptoQuoteCurrency(ticker); got != want {
+			t.Errorf("CryptoQuoteCurrency(%q): expected %s, got %s", ticker, want, got)
+		}
+	}
+}
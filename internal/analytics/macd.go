@@ -0,0 +1,74 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+)
+
+// EMA computes the exponential moving average of values over window
+// periods using the smoothing factor 2 / (window + 1). The first value is
+// the simple average of the first window entries, and the result is
+// aligned with the input with NaN before that. Leading NaN inputs are
+// skipped, so an EMA can be taken of another indicator's output.
+func EMA(values []float64, window int) []float64 {
+	out := make([]float64, len(values))
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	if window <= 0 {
+		return out
+	}
+
+	start := 0
+	for start < len(values) && math.IsNaN(values[start]) {
+		start++
+	}
+
+	seed := start + window - 1
+	if seed >= len(values) {
+		return out
+	}
+
+	var sum float64
+	for _, v := range values[start : seed+1] {
+		sum += v
+	}
+	out[seed] = sum / float64(window)
+
+	k := 2 / float64(window+1)
+	for i := seed + 1; i < len(values); i++ {
+		out[i] = values[i]*k + out[i-1]*(1-k)
+	}
+
+	return out
+}
+
+// MACD computes the Moving Average Convergence/Divergence of closes: the
+// MACD line is the short EMA minus the long EMA, the signal line is the
+// signal-period EMA of the MACD line, and the histogram is their
+// difference. All three results are aligned with closes and are NaN until
+// enough values exist, i.e. the MACD line starts at index long-1 and the
+// signal line and histogram at index long+signal-2. Closes must be in
+// ascending time order.
+func MACD(closes []float64, short, long, signal int) (macd, signalLine, hist []float64) {
+	shortEMA := EMA(closes, short)
+	longEMA := EMA(closes, long)
+
+	macd = make([]float64, len(closes))
+	for i := range closes {
+		macd[i] = shortEMA[i] - longEMA[i]
+	}
+
+	signalLine = EMA(macd, signal)
+
+	hist = make([]float64, len(closes))
+	for i := range closes {
+		hist[i] = macd[i] - signalLine[i]
+	}
+
+	return macd, signalLine, hist
+}
@@ -0,0 +1,112 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// macdReferenceCloses is a 40-bar close series used as the MACD reference
+// dataset. The expected values in TestMACDReference were produced by an
+// independent SMA-seeded EMA implementation with the standard 12/26/9
+// windows.
+var macdReferenceCloses = []float64{
+	44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42, 45.84, 46.08,
+	45.89, 46.03, 45.61, 46.28, 46.28, 46.00, 46.03, 46.41, 46.22, 45.64,
+	46.21, 46.25, 45.71, 46.45, 45.78, 45.35, 44.03, 44.18, 44.22, 44.57,
+	43.42, 42.66, 43.13, 43.50, 44.05, 44.80, 45.10, 45.60, 45.20, 44.90,
+}
+
+// TestEMA verifies the SMA seed and the recursive smoothing step on a
+// short series.
+func TestEMA(t *testing.T) {
+	values := EMA([]float64{1, 2, 3, 4, 5}, 3)
+
+	if !math.IsNaN(values[0]) || !math.IsNaN(values[1]) {
+		t.Errorf("expected NaN before the seed, got %v", values[:2])
+	}
+
+	want := []float64{2, 3, 4}
+	for i, w := range want {
+		if got := values[i+2]; math.Abs(got-w) > 1e-9 {
+			t.Errorf("index %d: expected %v, got %v", i+2, w, got)
+		}
+	}
+}
+
+// TestEMASkipsLeadingNaN verifies that the EMA starts from the first
+// non-NaN input, as needed for the MACD signal line.
+func TestEMASkipsLeadingNaN(t *testing.T) {
+	values := EMA([]float64{math.NaN(), math.NaN(), 4, 6, 8}, 2)
+
+	if !math.IsNaN(values[2]) {
+		t.Errorf("expected NaN at index 2, got %v", values[2])
+	}
+	if values[3] != 5 {
+		t.Errorf("expected seed 5 at index 3, got %v", values[3])
+	}
+	if math.Abs(values[4]-7) > 1e-9 {
+		t.Errorf("expected 7 at index 4, got %v", values[4])
+	}
+}
+
+// TestMACDReference verifies the MACD line, signal line, and histogram
+// against the reference dataset, along with the warm-up boundaries.
+func TestMACDReference(t *testing.T) {
+	macd, signal, hist := MACD(macdReferenceCloses, 12, 26, 9)
+
+	if len(macd) != 40 || len(signal) != 40 || len(hist) != 40 {
+		t.Fatalf("expected 40 values per series, got %d/%d/%d", len(macd), len(signal), len(hist))
+	}
+
+	if !math.IsNaN(macd[24]) || math.IsNaN(macd[25]) {
+		t.Errorf("expected MACD line to start at index 25, got %v, %v", macd[24], macd[25])
+	}
+	if math.Abs(macd[25]-0.3066888481) > 1e-9 {
+		t.Errorf("index 25: expected MACD 0.3066888481, got %v", macd[25])
+	}
+	if !math.IsNaN(signal[32]) || !math.IsNaN(hist[32]) {
+		t.Errorf("expected NaN signal and histogram at index 32, got %v, %v", signal[32], hist[32])
+	}
+
+	want := []struct {
+		macd, signal, hist float64
+	}{
+		{-0.5020829988, -0.1484405604, -0.3536424383},
+		{-0.4739504071, -0.2135425298, -0.2604078773},
+		{-0.3866789987, -0.2481698236, -0.1385091752},
+		{-0.2899657415, -0.2565290072, -0.0334367343},
+		{-0.1710027537, -0.2394237565, 0.0684210027},
+		{-0.1077582162, -0.2130906484, 0.1053324322},
+		{-0.0809112628, -0.1866547713, 0.1057435085},
+	}
+
+	for i, w := range want {
+		idx := i + 33
+		if math.Abs(macd[idx]-w.macd) > 1e-9 {
+			t.Errorf("index %d: expected MACD %v, got %v", idx, w.macd, macd[idx])
+		}
+		if math.Abs(signal[idx]-w.signal) > 1e-9 {
+			t.Errorf("index %d: expected signal %v, got %v", idx, w.signal, signal[idx])
+		}
+		if math.Abs(hist[idx]-w.hist) > 1e-9 {
+			t.Errorf("index %d: expected histogram %v, got %v", idx, w.hist, hist[idx])
+		}
+	}
+}
+
+// TestMACDTooFewCloses verifies that a series shorter than the long window
+// yields only NaN values.
+func TestMACDTooFewCloses(t *testing.T) {
+	macd, signal, hist := MACD([]float64{1, 2, 3}, 12, 26, 9)
+
+	for i := range macd {
+		if !math.IsNaN(macd[i]) || !math.IsNaN(signal[i]) || !math.IsNaN(hist[i]) {
+			t.Fatalf("expected NaN at index %d, got %v/%v/%v", i, macd[i], signal[i], hist[i])
+		}
+	}
+}
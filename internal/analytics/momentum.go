@@ -0,0 +1,55 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// Momentum computes the n-period momentum for each bar: close - close n
+// bars ago. The result is aligned with the input bars, so values[i]
+// belongs to bars[i]. Entries are NaN for the first window bars, where no
+// earlier close exists. Bars must be sorted in ascending time order.
+func Momentum(bars []api.Bar, window int) []float64 {
+	values := make([]float64, len(bars))
+
+	for i := range bars {
+		if window <= 0 || i < window {
+			values[i] = math.NaN()
+			continue
+		}
+
+		values[i] = bars[i].Close - bars[i-window].Close
+	}
+
+	return values
+}
+
+// ROC computes the n-period Rate-of-Change for each bar as a percentage:
+// (close - close n bars ago) / close n bars ago * 100. Like Momentum the
+// result is aligned with the input bars, with NaN for the first window
+// bars and wherever the earlier close is zero.
+func ROC(bars []api.Bar, window int) []float64 {
+	values := Momentum(bars, window)
+
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+
+		prior := bars[i-window].Close
+		if prior == 0 {
+			values[i] = math.NaN()
+			continue
+		}
+
+		values[i] = v / prior * 100
+	}
+
+	return values
+}
@@ -0,0 +1,78 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestMomentum verifies two-bar momentum against the closes in testBars
+// (11, 13, 12, 9, 11) and that the warm-up entries are NaN.
+func TestMomentum(t *testing.T) {
+	values := Momentum(testBars, 2)
+
+	if len(values) != len(testBars) {
+		t.Fatalf("expected %d values, got %d", len(testBars), len(values))
+	}
+
+	for i := 0; i < 2; i++ {
+		if !math.IsNaN(values[i]) {
+			t.Errorf("expected NaN during warm-up at %d, got %v", i, values[i])
+		}
+	}
+
+	want := []float64{1, -4, -1}
+	for i, w := range want {
+		if got := values[i+2]; math.Abs(got-w) > 1e-9 {
+			t.Errorf("bar %d: expected %v, got %v", i+2, w, got)
+		}
+	}
+}
+
+// TestROC verifies two-bar Rate-of-Change percentages against the closes
+// in testBars.
+func TestROC(t *testing.T) {
+	values := ROC(testBars, 2)
+
+	if !math.IsNaN(values[0]) || !math.IsNaN(values[1]) {
+		t.Errorf("expected NaN during warm-up, got %v", values[:2])
+	}
+
+	want := []float64{1.0 / 11 * 100, -4.0 / 13 * 100, -1.0 / 12 * 100}
+	for i, w := range want {
+		if got := values[i+2]; math.Abs(got-w) > 1e-9 {
+			t.Errorf("bar %d: expected %v, got %v", i+2, w, got)
+		}
+	}
+}
+
+// TestROCZeroPriorClose verifies that a zero earlier close yields NaN
+// rather than an infinite rate of change.
+func TestROCZeroPriorClose(t *testing.T) {
+	bars := []api.Bar{{Close: 0}, {Close: 5}}
+
+	values := ROC(bars, 1)
+	if !math.IsNaN(values[1]) {
+		t.Errorf("expected NaN for zero prior close, got %v", values[1])
+	}
+
+	if m := Momentum(bars, 1); m[1] != 5 {
+		t.Errorf("expected momentum 5, got %v", m[1])
+	}
+}
+
+// TestMomentumInvalidWindow verifies that a non-positive window yields
+// all NaN values.
+func TestMomentumInvalidWindow(t *testing.T) {
+	for _, v := range Momentum(testBars, 0) {
+		if !math.IsNaN(v) {
+			t.Fatalf("expected NaN for zero window, got %v", v)
+		}
+	}
+}
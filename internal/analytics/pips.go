@@ -0,0 +1,61 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StandardLotUnits is the number of base currency units in one standard
+// forex lot. A lot size of 0.1 is a mini lot and 0.01 a micro lot.
+const StandardLotUnits = 100000
+
+// SplitForexPair splits a currency pair into its base and quote currency
+// codes. It accepts "EURUSD", "EUR/USD", and the API's "C:EURUSD" ticker
+// form, case-insensitively.
+func SplitForexPair(pair string) (base, quote string, err error) {
+	p := strings.ToUpper(strings.TrimSpace(pair))
+	p = strings.TrimPrefix(p, "C:")
+	p = strings.ReplaceAll(p, "/", "")
+
+	if len(p) != 6 {
+		return "", "", fmt.Errorf("invalid currency pair %q: expected six letters like EURUSD", pair)
+	}
+
+	return p[:3], p[3:], nil
+}
+
+// PipSize returns the price increment of one pip for the given pair. Pairs
+// quoted in Japanese yen move in 0.01 pips; all others use 0.0001.
+func PipSize(pair string) (float64, error) {
+	_, quote, err := SplitForexPair(pair)
+	if err != nil {
+		return 0, err
+	}
+
+	if quote == "JPY" {
+		return 0.01, nil
+	}
+	return 0.0001, nil
+}
+
+// PipValue returns the value of one pip for a position of lot standard
+// lots, expressed in the pair's base currency. quote is the current
+// exchange rate of the pair (quote currency per unit of base). Multiply the
+// result by quote to get the value in the quote currency instead.
+func PipValue(pair string, lot float64, quote float64) (float64, error) {
+	if quote <= 0 {
+		return 0, fmt.Errorf("invalid quote %v: must be greater than zero", quote)
+	}
+
+	size, err := PipSize(pair)
+	if err != nil {
+		return 0, err
+	}
+
+	return size * lot * StandardLotUnits / quote, nil
+}
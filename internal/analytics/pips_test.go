@@ -0,0 +1,87 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// TestSplitForexPair verifies that the supported pair notations all split
+// into the same base and quote currencies, and that bad input errors.
+func TestSplitForexPair(t *testing.T) {
+	for _, pair := range []string{"EURUSD", "eur/usd", "C:EURUSD"} {
+		base, quote, err := SplitForexPair(pair)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", pair, err)
+		}
+		if base != "EUR" || quote != "USD" {
+			t.Errorf("expected EUR/USD for %s, got %s/%s", pair, base, quote)
+		}
+	}
+
+	if _, _, err := SplitForexPair("EURO"); err == nil {
+		t.Error("expected error for malformed pair, got nil")
+	}
+}
+
+// TestPipSize verifies that JPY-quoted pairs use a 0.01 pip and all
+// other pairs use 0.0001.
+func TestPipSize(t *testing.T) {
+	tests := map[string]float64{
+		"EURUSD": 0.0001,
+		"GBPCHF": 0.0001,
+		"USDJPY": 0.01,
+		"EURJPY": 0.01,
+	}
+
+	for pair, want := range tests {
+		got, err := PipSize(pair)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", pair, err)
+		}
+		if got != want {
+			t.Errorf("expected pip size %v for %s, got %v", want, pair, got)
+		}
+	}
+}
+
+// TestPipValue verifies pip values for a standard lot against the usual
+// hand-computed figures for a USD and a JPY quoted pair.
+func TestPipValue(t *testing.T) {
+	// EURUSD at 1.25: 0.0001 * 100000 / 1.25 = 8 EUR (10 USD) per pip.
+	got, err := PipValue("EURUSD", 1, 1.25)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(got-8) > 1e-9 {
+		t.Errorf("expected EURUSD pip value 8, got %v", got)
+	}
+	if math.Abs(got*1.25-10) > 1e-9 {
+		t.Errorf("expected EURUSD pip value of 10 in quote currency, got %v", got*1.25)
+	}
+
+	// USDJPY at 150, half a lot: 0.01 * 50000 / 150 = 3.333... USD per pip.
+	got, err = PipValue("USDJPY", 0.5, 150)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(got-10.0/3) > 1e-9 {
+		t.Errorf("expected USDJPY pip value 3.3333, got %v", got)
+	}
+}
+
+// TestPipValueInvalidInput verifies that PipValue rejects a non-positive
+// quote and a malformed pair.
+func TestPipValueInvalidInput(t *testing.T) {
+	if _, err := PipValue("EURUSD", 1, 0); err == nil {
+		t.Error("expected error for zero quote, got nil")
+	}
+
+	if _, err := PipValue("EUR", 1, 1.1); err == nil {
+		t.Error("expected error for malformed pair, got nil")
+	}
+}
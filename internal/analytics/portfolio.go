@@ -0,0 +1,141 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Holding is one line of a portfolio holdings file: a ticker in the API's
+// usual prefixed form (e.g. "AAPL", "X:BTCUSD", "C:EURUSD") and the
+// quantity held.
+type Holding struct {
+	Ticker   string
+	Quantity float64
+}
+
+// PositionQuote is the market data needed to value a holding: the latest
+// price and the per-unit change since the previous close.
+type PositionQuote struct {
+	Price  float64
+	Change float64
+}
+
+// Position is a valued holding. Found is false when no quote was available
+// for the ticker, in which case the position is excluded from the totals
+// and its weight is zero.
+type Position struct {
+	Ticker    string  `json:"ticker"`
+	Quantity  float64 `json:"quantity"`
+	Price     float64 `json:"price"`
+	Value     float64 `json:"value"`
+	Weight    float64 `json:"weight"`
+	DayChange float64 `json:"day_change"`
+	Found     bool    `json:"found"`
+}
+
+// PortfolioTotals summarizes all valued positions. DayChangePercent is the
+// day change relative to the portfolio's value at the previous close.
+type PortfolioTotals struct {
+	Value            float64 `json:"value"`
+	DayChange        float64 `json:"day_change"`
+	DayChangePercent float64 `json:"day_change_percent"`
+	Missing          int     `json:"missing"`
+}
+
+// ReadHoldings parses a CSV of "ticker,quantity" rows. A header row is
+// skipped when its quantity column is not numeric, blank lines and lines
+// starting with "#" are ignored, and tickers are upper-cased.
+func ReadHoldings(r io.Reader) ([]Holding, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var holdings []Holding
+	headerSeen := false
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read holdings: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) < 2 {
+			return nil, fmt.Errorf("holdings line %d: expected ticker,quantity", line)
+		}
+
+		ticker := strings.ToUpper(strings.TrimSpace(record[0]))
+		quantity, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			if len(holdings) == 0 && !headerSeen {
+				headerSeen = true
+				continue
+			}
+			return nil, fmt.Errorf("holdings line %d: invalid quantity %q", line, record[1])
+		}
+
+		if ticker == "" {
+			return nil, fmt.Errorf("holdings line %d: ticker is required", line)
+		}
+
+		holdings = append(holdings, Holding{Ticker: ticker, Quantity: quantity})
+	}
+
+	if len(holdings) == 0 {
+		return nil, fmt.Errorf("holdings file contains no positions")
+	}
+
+	return holdings, nil
+}
+
+// ValuePortfolio values each holding using the quotes keyed by ticker and
+// returns the positions in input order along with the portfolio totals.
+// Weights are each position's share of the total value of found positions.
+func ValuePortfolio(holdings []Holding, quotes map[string]PositionQuote) ([]Position, PortfolioTotals) {
+	positions := make([]Position, len(holdings))
+	var totals PortfolioTotals
+
+	for i, h := range holdings {
+		positions[i] = Position{Ticker: h.Ticker, Quantity: h.Quantity}
+
+		q, ok := quotes[h.Ticker]
+		if !ok {
+			totals.Missing++
+			continue
+		}
+
+		positions[i].Found = true
+		positions[i].Price = q.Price
+		positions[i].Value = q.Price * h.Quantity
+		positions[i].DayChange = q.Change * h.Quantity
+
+		totals.Value += positions[i].Value
+		totals.DayChange += positions[i].DayChange
+	}
+
+	if totals.Value != 0 {
+		for i := range positions {
+			if positions[i].Found {
+				positions[i].Weight = positions[i].Value / totals.Value * 100
+			}
+		}
+	}
+
+	if prev := totals.Value - totals.DayChange; prev != 0 {
+		totals.DayChangePercent = totals.DayChange / prev * 100
+	}
+
+	return positions, totals
+}
@@ -0,0 +1,93 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestReadHoldings verifies that a holdings CSV with a header, comments,
+// and blank lines parses into upper-cased tickers and quantities.
+func TestReadHoldings(t *testing.T) {
+	input := "ticker,quantity\n# core\naapl,10\n\nX:BTCUSD, 0.5\n"
+
+	holdings, err := ReadHoldings(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(holdings) != 2 {
+		t.Fatalf("expected 2 holdings, got %d", len(holdings))
+	}
+
+	if holdings[0].Ticker != "AAPL" || holdings[0].Quantity != 10 {
+		t.Errorf("unexpected first holding: %+v", holdings[0])
+	}
+
+	if holdings[1].Ticker != "X:BTCUSD" || holdings[1].Quantity != 0.5 {
+		t.Errorf("unexpected second holding: %+v", holdings[1])
+	}
+}
+
+// TestReadHoldingsErrors verifies that malformed rows and empty files are
+// rejected with an error.
+func TestReadHoldingsErrors(t *testing.T) {
+	tests := map[string]string{
+		"bad quantity":   "AAPL,10\nMSFT,ten\n",
+		"missing column": "AAPL\n",
+		"empty ticker":   ",10\n",
+		"no positions":   "ticker,quantity\n",
+	}
+
+	for name, input := range tests {
+		if _, err := ReadHoldings(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
+
+// TestValuePortfolio verifies position values, weights, and day change
+// totals, and that holdings without a quote are excluded from the totals.
+func TestValuePortfolio(t *testing.T) {
+	holdings := []Holding{
+		{Ticker: "AAPL", Quantity: 10},
+		{Ticker: "X:BTCUSD", Quantity: 0.1},
+		{Ticker: "NOPE", Quantity: 5},
+	}
+	quotes := map[string]PositionQuote{
+		"AAPL":     {Price: 150, Change: 5},
+		"X:BTCUSD": {Price: 5000, Change: -100},
+	}
+
+	positions, totals := ValuePortfolio(holdings, quotes)
+
+	if len(positions) != 3 {
+		t.Fatalf("expected 3 positions, got %d", len(positions))
+	}
+
+	if positions[0].Value != 1500 || positions[1].Value != 500 {
+		t.Errorf("unexpected values: %v, %v", positions[0].Value, positions[1].Value)
+	}
+
+	if math.Abs(positions[0].Weight-75) > 1e-9 || math.Abs(positions[1].Weight-25) > 1e-9 {
+		t.Errorf("unexpected weights: %v, %v", positions[0].Weight, positions[1].Weight)
+	}
+
+	if positions[2].Found || positions[2].Weight != 0 {
+		t.Errorf("expected missing position to be unvalued, got %+v", positions[2])
+	}
+
+	if totals.Value != 2000 || totals.DayChange != 40 || totals.Missing != 1 {
+		t.Errorf("unexpected totals: %+v", totals)
+	}
+
+	// Previous value was 1960, so a 40 gain is about 2.04%.
+	if math.Abs(totals.DayChangePercent-40.0/1960*100) > 1e-9 {
+		t.Errorf("unexpected day change percent: %v", totals.DayChangePercent)
+	}
+}
@@ -0,0 +1,70 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"time"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// PriceRange describes where the latest close sits within the high/low
+// range of a series of bars, such as the 52-week range of daily bars.
+// Timestamps are the millisecond start times of the bars that set each
+// value. Position is the last close as a percentage of the way from Low
+// (0) to High (100), or NaN when High equals Low. DaysSinceHigh and
+// DaysSinceLow count calendar days from each extreme to the last bar.
+type PriceRange struct {
+	High          float64
+	HighTimestamp int64
+	Low           float64
+	LowTimestamp  int64
+	Last          float64
+	LastTimestamp int64
+	Position      float64
+	DaysSinceHigh int
+	DaysSinceLow  int
+}
+
+// Range scans bars for the highest high and lowest low and reports the
+// latest close's position within them. Ties keep the most recent bar, so
+// a retested extreme counts from the retest. Bars must be sorted in
+// ascending time order; ok is false when there are none.
+func Range(bars []api.Bar) (r PriceRange, ok bool) {
+	if len(bars) == 0 {
+		return PriceRange{}, false
+	}
+
+	r.High, r.Low = math.Inf(-1), math.Inf(1)
+	for _, bar := range bars {
+		if bar.High >= r.High {
+			r.High, r.HighTimestamp = bar.High, bar.Timestamp
+		}
+		if bar.Low <= r.Low {
+			r.Low, r.LowTimestamp = bar.Low, bar.Timestamp
+		}
+	}
+
+	last := bars[len(bars)-1]
+	r.Last, r.LastTimestamp = last.Close, last.Timestamp
+
+	r.Position = math.NaN()
+	if r.High > r.Low {
+		r.Position = (r.Last - r.Low) / (r.High - r.Low) * 100
+	}
+
+	r.DaysSinceHigh = daysBetween(r.HighTimestamp, r.LastTimestamp)
+	r.DaysSinceLow = daysBetween(r.LowTimestamp, r.LastTimestamp)
+
+	return r, true
+}
+
+// daysBetween returns the whole number of days from one millisecond
+// timestamp to a later one.
+func daysBetween(from, to int64) int {
+	return int(time.Duration(to-from) * time.Millisecond / (24 * time.Hour))
+}
@@ -0,0 +1,62 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// dayMS is one day in milliseconds, for building daily bar timestamps.
+const dayMS = int64(24 * 60 * 60 * 1000)
+
+// TestRange verifies the high, low, position, and days since each extreme
+// for a short daily series, with a tied high counted from the later bar.
+func TestRange(t *testing.T) {
+	bars := []api.Bar{
+		{High: 12, Low: 9, Close: 11, Timestamp: 0},
+		{High: 15, Low: 10, Close: 14, Timestamp: 1 * dayMS},
+		{High: 13, Low: 8, Close: 9, Timestamp: 3 * dayMS},
+		{High: 15, Low: 11, Close: 12, Timestamp: 6 * dayMS},
+		{High: 14, Low: 10, Close: 13.6, Timestamp: 10 * dayMS},
+	}
+
+	r, ok := Range(bars)
+	if !ok {
+		t.Fatal("expected a range")
+	}
+	if r.High != 15 || r.HighTimestamp != 6*dayMS {
+		t.Errorf("expected high 15 on day 6, got %v on %d", r.High, r.HighTimestamp)
+	}
+	if r.Low != 8 || r.LowTimestamp != 3*dayMS {
+		t.Errorf("expected low 8 on day 3, got %v on %d", r.Low, r.LowTimestamp)
+	}
+	if r.Last != 13.6 || r.LastTimestamp != 10*dayMS {
+		t.Errorf("expected last 13.6 on day 10, got %v on %d", r.Last, r.LastTimestamp)
+	}
+	// (13.6 - 8) / (15 - 8) * 100 = 80
+	if math.Abs(r.Position-80) > 1e-9 {
+		t.Errorf("expected position 80, got %v", r.Position)
+	}
+	if r.DaysSinceHigh != 4 || r.DaysSinceLow != 7 {
+		t.Errorf("expected 4 and 7 days since high and low, got %d and %d", r.DaysSinceHigh, r.DaysSinceLow)
+	}
+}
+
+// TestRangeFlat verifies that a series with no range has a NaN position
+// and that an empty series reports no range.
+func TestRangeFlat(t *testing.T) {
+	r, ok := Range([]api.Bar{{High: 5, Low: 5, Close: 5}})
+	if !ok || !math.IsNaN(r.Position) {
+		t.Errorf("expected NaN position for a flat series, got %+v", r)
+	}
+
+	if _, ok := Range(nil); ok {
+		t.Error("expected no range for empty bars")
+	}
+}
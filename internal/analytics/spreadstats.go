@@ -0,0 +1,92 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// SpreadStats summarizes the bid-ask spread over a stream of quotes.
+// Spreads are in price units (ask minus bid). TimeWeightedSpread weights
+// each quote's spread by how long it stood before the next quote, so a
+// wide spread that lasted minutes counts more than a one-tick flicker; it
+// is zero when fewer than two distinct timestamps were seen. Skipped
+// counts one-sided quotes with a zero bid or ask, which are left out of
+// every statistic.
+type SpreadStats struct {
+	Quotes             int     `json:"quotes"`
+	Skipped            int     `json:"skipped"`
+	MeanSpread         float64 `json:"mean_spread"`
+	MedianSpread       float64 `json:"median_spread"`
+	MinSpread          float64 `json:"min_spread"`
+	MaxSpread          float64 `json:"max_spread"`
+	TimeWeightedSpread float64 `json:"time_weighted_spread"`
+	MeanMid            float64 `json:"mean_mid"`
+	FirstTimestamp     int64   `json:"first_timestamp"`
+	LastTimestamp      int64   `json:"last_timestamp"`
+}
+
+// SummarizeSpreads consumes quotes one at a time and returns their spread
+// summary. Quotes must arrive in ascending timestamp order for the
+// time-weighted spread; a quote older than the one before it adds no
+// weight. Apart from one float per quote kept for the median, only running
+// totals are held, so quotes can be streamed page by page from the API.
+func SummarizeSpreads(quotes iter.Seq[api.ForexQuote]) SpreadStats {
+	var stats SpreadStats
+	var spreads []float64
+	var spreadSum, midSum, weightedSum float64
+	var weight int64
+	var prevSpread float64
+	var prevTimestamp int64
+
+	for q := range quotes {
+		if q.BidPrice <= 0 || q.AskPrice <= 0 {
+			stats.Skipped++
+			continue
+		}
+
+		spread := q.AskPrice - q.BidPrice
+		if stats.Quotes == 0 {
+			stats.MinSpread, stats.MaxSpread = spread, spread
+			stats.FirstTimestamp, stats.LastTimestamp = q.ParticipantTimestamp, q.ParticipantTimestamp
+		} else if d := q.ParticipantTimestamp - prevTimestamp; d > 0 {
+			weightedSum += prevSpread * float64(d)
+			weight += d
+		}
+
+		stats.Quotes++
+		spreads = append(spreads, spread)
+		spreadSum += spread
+		midSum += (q.AskPrice + q.BidPrice) / 2
+		stats.MinSpread = min(stats.MinSpread, spread)
+		stats.MaxSpread = max(stats.MaxSpread, spread)
+		stats.FirstTimestamp = min(stats.FirstTimestamp, q.ParticipantTimestamp)
+		stats.LastTimestamp = max(stats.LastTimestamp, q.ParticipantTimestamp)
+		prevSpread, prevTimestamp = spread, q.ParticipantTimestamp
+	}
+
+	if stats.Quotes == 0 {
+		return stats
+	}
+
+	stats.MeanSpread = spreadSum / float64(stats.Quotes)
+	stats.MeanMid = midSum / float64(stats.Quotes)
+	if weight > 0 {
+		stats.TimeWeightedSpread = weightedSum / float64(weight)
+	}
+
+	slices.Sort(spreads)
+	if n := len(spreads); n%2 == 1 {
+		stats.MedianSpread = spreads[n/2]
+	} else {
+		stats.MedianSpread = (spreads[n/2-1] + spreads[n/2]) / 2
+	}
+
+	return stats
+}
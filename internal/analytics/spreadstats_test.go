@@ -0,0 +1,70 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestSummarizeSpreads verifies mean, median, range, mean mid, and the
+// time-weighted spread, and that one-sided quotes are skipped.
+func TestSummarizeSpreads(t *testing.T) {
+	quotes := []api.ForexQuote{
+		{BidPrice: 1.0000, AskPrice: 1.0002, ParticipantTimestamp: 0},
+		{BidPrice: 1.0001, AskPrice: 1.0011, ParticipantTimestamp: 1e9},
+		{BidPrice: 0, AskPrice: 1.0010, ParticipantTimestamp: 2e9},
+		{BidPrice: 1.0002, AskPrice: 1.0006, ParticipantTimestamp: 4e9},
+		{BidPrice: 1.0003, AskPrice: 1.0005, ParticipantTimestamp: 5e9},
+	}
+
+	stats := SummarizeSpreads(slices.Values(quotes))
+
+	if stats.Quotes != 4 || stats.Skipped != 1 {
+		t.Errorf("expected 4 quotes and 1 skipped, got %d and %d", stats.Quotes, stats.Skipped)
+	}
+
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"mean spread", stats.MeanSpread, (0.0002 + 0.0010 + 0.0004 + 0.0002) / 4},
+		{"median spread", stats.MedianSpread, 0.0003},
+		{"min spread", stats.MinSpread, 0.0002},
+		{"max spread", stats.MaxSpread, 0.0010},
+		// 0.0002 for 1s, 0.0010 for 3s, 0.0004 for 1s.
+		{"time-weighted spread", stats.TimeWeightedSpread, (0.0002*1 + 0.0010*3 + 0.0004*1) / 5},
+		{"mean mid", stats.MeanMid, (1.0001 + 1.0006 + 1.0004 + 1.0004) / 4},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 1e-12 {
+			t.Errorf("expected %s %v, got %v", c.name, c.want, c.got)
+		}
+	}
+
+	if stats.FirstTimestamp != 0 || stats.LastTimestamp != 5e9 {
+		t.Errorf("expected span 0-5e9, got %d-%d", stats.FirstTimestamp, stats.LastTimestamp)
+	}
+}
+
+// TestSummarizeSpreadsEmpty verifies that no quotes produce a zero summary
+// and that a single quote has no time-weighted spread.
+func TestSummarizeSpreadsEmpty(t *testing.T) {
+	if stats := SummarizeSpreads(slices.Values([]api.ForexQuote(nil))); stats != (SpreadStats{}) {
+		t.Errorf("expected zero stats, got %+v", stats)
+	}
+
+	stats := SummarizeSpreads(slices.Values([]api.ForexQuote{{BidPrice: 1.1, AskPrice: 1.2, ParticipantTimestamp: 7}}))
+	if stats.Quotes != 1 || stats.TimeWeightedSpread != 0 {
+		t.Errorf("expected 1 quote with no time-weighted spread, got %+v", stats)
+	}
+	if math.Abs(stats.MedianSpread-0.1) > 1e-12 {
+		t.Errorf("expected median spread 0.1, got %v", stats.MedianSpread)
+	}
+}
@@ -0,0 +1,46 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundToTick rounds price to the nearest multiple of tick, such as a
+// futures contract's trade tick size, with ties rounding away from zero.
+// A quotient within float error of a half tick counts as a tie, so 0.35
+// rounds up to 0.4 on a 0.1 tick, and the result is snapped to the tick's
+// decimals so 0.1+0.2 on a 0.1 tick is exactly 0.3. A tick of zero or less
+// returns the price unchanged.
+func RoundToTick(price, tick float64) float64 {
+	if tick <= 0 {
+		return price
+	}
+
+	q := price / tick
+	n := math.Round(q)
+	if whole, frac := math.Modf(q); math.Abs(math.Abs(frac)-0.5) < 1e-9 {
+		n = whole + math.Copysign(1, q)
+	}
+
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(n*tick, 'f', TickDecimals(tick), 64), 64)
+	if err != nil {
+		return n * tick
+	}
+	return rounded
+}
+
+// TickDecimals returns how many decimals it takes to print every multiple
+// of tick exactly: 2 for 0.25, 5 for 0.03125 (1/32), 0 for 5.
+func TickDecimals(tick float64) int {
+	s := strconv.FormatFloat(math.Abs(tick), 'f', -1, 64)
+	if _, frac, ok := strings.Cut(s, "."); ok {
+		return len(frac)
+	}
+	return 0
+}
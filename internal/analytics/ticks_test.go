@@ -0,0 +1,125 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// TestRoundToTick verifies prices snap to the nearest tick multiple.
+func TestRoundToTick(t *testing.T) {
+	tests := []struct {
+		price, tick, want float64
+	}{
+		{5012.37, 0.25, 5012.25},
+		{5012.38, 0.25, 5012.5},
+		{110.17, 0.03125, 110.15625},
+		{110.18, 0.015625, 110.1875},
+		{71.234, 0.01, 71.23},
+		{12347, 5, 12345},
+		{12.345, 0, 12.345},
+		{12.345, -1, 12.345},
+		{-5012.37, 0.25, -5012.25},
+	}
+
+	for _, tt := range tests {
+		if got := RoundToTick(tt.price, tt.tick); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("RoundToTick(%v, %v) = %v, want %v", tt.price, tt.tick, got, tt.want)
+		}
+	}
+}
+
+// TestRoundToTickTies verifies that prices exactly halfway between ticks
+// round away from zero, including halves that division leaves a hair
+// below or above .5.
+func TestRoundToTickTies(t *testing.T) {
+	tests := []struct {
+		price, tick, want float64
+	}{
+		{5012.375, 0.25, 5012.5},
+		{-5012.375, 0.25, -5012.5},
+		{2.5, 5, 5},
+		{7.5, 5, 10},
+		{-7.5, 5, -10},
+		{0.35, 0.1, 0.4},
+		{1.005, 0.01, 1.01},
+		{-1.005, 0.01, -1.01},
+		{110.171875, 0.03125, 110.1875},
+	}
+
+	for _, tt := range tests {
+		if got := RoundToTick(tt.price, tt.tick); got != tt.want {
+			t.Errorf("RoundToTick(%v, %v) = %v, want %v", tt.price, tt.tick, got, tt.want)
+		}
+	}
+}
+
+// TestRoundToTickOddTicks verifies ticks that are not powers of ten snap
+// to the nearest multiple.
+func TestRoundToTickOddTicks(t *testing.T) {
+	tests := []struct {
+		price, tick, want float64
+	}{
+		{1.024, 0.05, 1.0},
+		{1.026, 0.05, 1.05},
+		{98.7, 0.2, 98.8},
+		{4321.6, 2.5, 4322.5},
+		{0.0123, 0.005, 0.01},
+		{99.98, 0.25, 100},
+		{31.9, 1.0 / 32, 31.90625},
+	}
+
+	for _, tt := range tests {
+		if got := RoundToTick(tt.price, tt.tick); got != tt.want {
+			t.Errorf("RoundToTick(%v, %v) = %v, want %v", tt.price, tt.tick, got, tt.want)
+		}
+	}
+}
+
+// TestRoundToTickFloatError verifies results come back as the exact
+// decimal multiple rather than carrying binary float error.
+func TestRoundToTickFloatError(t *testing.T) {
+	tests := []struct {
+		price, tick, want float64
+	}{
+		{0.1 + 0.2, 0.1, 0.3},
+		{0.1 + 0.2, 0.01, 0.3},
+		{0.7 + 0.1, 0.1, 0.8},
+		{1.1 * 3, 0.1, 3.3},
+		{4.35 * 100, 0.5, 435},
+		{0.3 - 0.1, 0.1, 0.2},
+	}
+
+	for _, tt := range tests {
+		if got := RoundToTick(tt.price, tt.tick); got != tt.want {
+			t.Errorf("RoundToTick(%v, %v) = %v, want %v", tt.price, tt.tick, got, tt.want)
+		}
+	}
+}
+
+// TestTickDecimals verifies the decimals needed to print a tick exactly.
+func TestTickDecimals(t *testing.T) {
+	tests := []struct {
+		tick float64
+		want int
+	}{
+		{0.25, 2},
+		{0.03125, 5},
+		{0.015625, 6},
+		{0.01, 2},
+		{0.0001, 4},
+		{5, 0},
+		{1, 0},
+		{0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := TickDecimals(tt.tick); got != tt.want {
+			t.Errorf("TickDecimals(%v) = %d, want %d", tt.tick, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"iter"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TradeStats summarizes a stream of trades. FirstTimestamp and
+// LastTimestamp are the earliest and latest trade times in nanoseconds.
+// TradesPerMinute is measured over the span between those two trades and
+// is zero when fewer than two distinct timestamps were seen.
+type TradeStats struct {
+	Trades          int     `json:"trades"`
+	Volume          float64 `json:"volume"`
+	Notional        float64 `json:"notional"`
+	VWAP            float64 `json:"vwap"`
+	AvgSize         float64 `json:"avg_size"`
+	High            float64 `json:"high"`
+	Low             float64 `json:"low"`
+	FirstTimestamp  int64   `json:"first_timestamp"`
+	LastTimestamp   int64   `json:"last_timestamp"`
+	TradesPerMinute float64 `json:"trades_per_minute"`
+}
+
+// SummarizeTrades consumes trades one at a time and returns their summary.
+// Only running totals are kept, so the trades can be streamed page by page
+// from the API without holding the whole window in memory. Trades may
+// arrive in any order.
+func SummarizeTrades(trades iter.Seq[api.CryptoTrade]) TradeStats {
+	var stats TradeStats
+
+	for trade := range trades {
+		if stats.Trades == 0 {
+			stats.High, stats.Low = trade.Price, trade.Price
+			stats.FirstTimestamp, stats.LastTimestamp = trade.ParticipantTimestamp, trade.ParticipantTimestamp
+		}
+
+		stats.Trades++
+		stats.Volume += trade.Size
+		stats.Notional += trade.Price * trade.Size
+		stats.High = max(stats.High, trade.Price)
+		stats.Low = min(stats.Low, trade.Price)
+		stats.FirstTimestamp = min(stats.FirstTimestamp, trade.ParticipantTimestamp)
+		stats.LastTimestamp = max(stats.LastTimestamp, trade.ParticipantTimestamp)
+	}
+
+	if stats.Trades == 0 {
+		return stats
+	}
+
+	stats.AvgSize = stats.Volume / float64(stats.Trades)
+	if stats.Volume != 0 {
+		stats.VWAP = stats.Notional / stats.Volume
+	}
+
+	if span := stats.LastTimestamp - stats.FirstTimestamp; span > 0 {
+		stats.TradesPerMinute = float64(stats.Trades) / (float64(span) / 6e10)
+	}
+
+	return stats
+}
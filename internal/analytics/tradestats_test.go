@@ -0,0 +1,65 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// TestSummarizeTrades verifies count, volume, VWAP, average size, price
+// range, and trades per minute for trades spanning two minutes that
+// arrive out of order.
+func TestSummarizeTrades(t *testing.T) {
+	trades := []api.CryptoTrade{
+		{Price: 100, Size: 1, ParticipantTimestamp: 60e9},
+		{Price: 110, Size: 3, ParticipantTimestamp: 180e9},
+		{Price: 90, Size: 2, ParticipantTimestamp: 120e9},
+		{Price: 105, Size: 2, ParticipantTimestamp: 150e9},
+	}
+
+	stats := SummarizeTrades(slices.Values(trades))
+
+	if stats.Trades != 4 {
+		t.Errorf("expected 4 trades, got %d", stats.Trades)
+	}
+	if stats.Volume != 8 {
+		t.Errorf("expected volume 8, got %v", stats.Volume)
+	}
+
+	wantVWAP := (100.0*1 + 110*3 + 90*2 + 105*2) / 8
+	if math.Abs(stats.VWAP-wantVWAP) > 1e-9 {
+		t.Errorf("expected VWAP %v, got %v", wantVWAP, stats.VWAP)
+	}
+	if stats.AvgSize != 2 {
+		t.Errorf("expected average size 2, got %v", stats.AvgSize)
+	}
+	if stats.High != 110 || stats.Low != 90 {
+		t.Errorf("expected range 90-110, got %v-%v", stats.Low, stats.High)
+	}
+	if stats.FirstTimestamp != 60e9 || stats.LastTimestamp != 180e9 {
+		t.Errorf("expected span 60e9-180e9, got %d-%d", stats.FirstTimestamp, stats.LastTimestamp)
+	}
+	if stats.TradesPerMinute != 2 {
+		t.Errorf("expected 2 trades per minute, got %v", stats.TradesPerMinute)
+	}
+}
+
+// TestSummarizeTradesEmpty verifies that no trades produce a zero summary
+// and that a single trade has no trade rate.
+func TestSummarizeTradesEmpty(t *testing.T) {
+	if stats := SummarizeTrades(slices.Values([]api.CryptoTrade(nil))); stats != (TradeStats{}) {
+		t.Errorf("expected zero stats, got %+v", stats)
+	}
+
+	stats := SummarizeTrades(slices.Values([]api.CryptoTrade{{Price: 50, Size: 0.5, ParticipantTimestamp: 1}}))
+	if stats.Trades != 1 || stats.VWAP != 50 || stats.TradesPerMinute != 0 {
+		t.Errorf("unexpected single-trade stats: %+v", stats)
+	}
+}
@@ -0,0 +1,45 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// WilliamsR computes the Williams %R momentum oscillator for each bar using
+// the given lookback window: (highestHigh - close) / (highestHigh - lowestLow)
+// * -100. The result is aligned with the input bars, so values[i] belongs to
+// bars[i]. Entries are NaN while fewer than window bars are available, or
+// when the high/low range over the window is zero. Bars must be sorted in
+// ascending time order.
+func WilliamsR(bars []api.Bar, window int) []float64 {
+	values := make([]float64, len(bars))
+
+	for i := range bars {
+		if window <= 0 || i < window-1 {
+			values[i] = math.NaN()
+			continue
+		}
+
+		highest := bars[i].High
+		lowest := bars[i].Low
+		for _, b := range bars[i-window+1 : i] {
+			highest = math.Max(highest, b.High)
+			lowest = math.Min(lowest, b.Low)
+		}
+
+		if highest == lowest {
+			values[i] = math.NaN()
+			continue
+		}
+
+		values[i] = (highest - bars[i].Close) / (highest - lowest) * -100
+	}
+
+	return values
+}
@@ -0,0 +1,98 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/api"
+)
+
+// testBars is a small ascending series of OHLC bars used by the analytics
+// tests. Highs and lows are chosen so window results are easy to verify.
+var testBars = []api.Bar{
+	{Open: 10, High: 12, Low: 9, Close: 11, Timestamp: 1},
+	{Open: 11, High: 14, Low: 10, Close: 13, Timestamp: 2},
+	{Open: 13, High: 15, Low: 12, Close: 12, Timestamp: 3},
+	{Open: 12, High: 13, Low: 8, Close: 9, Timestamp: 4},
+	{Open: 9, High: 11, Low: 9, Close: 11, Timestamp: 5},
+}
+
+// TestWilliamsR verifies Williams %R values against hand-computed results
+// for a three-bar window.
+func TestWilliamsR(t *testing.T) {
+	values := WilliamsR(testBars, 3)
+
+	if len(values) != len(testBars) {
+		t.Fatalf("expected %d values, got %d", len(testBars), len(values))
+	}
+
+	if !math.IsNaN(values[0]) || !math.IsNaN(values[1]) {
+		t.Errorf("expected NaN for warm-up bars, got %v, %v", values[0], values[1])
+	}
+
+	// Bar 3: HH=15, LL=9, close=12 -> (15-12)/(15-9)*-100 = -50
+	// Bar 4: HH=15, LL=8, close=9  -> (15-9)/(15-8)*-100  = -85.714...
+	// Bar 5: HH=15, LL=8, close=11 -> (15-11)/(15-8)*-100 = -57.142...
+	expected := map[int]float64{2: -50, 3: -600.0 / 7, 4: -400.0 / 7}
+	for i, want := range expected {
+		if math.Abs(values[i]-want) > 1e-9 {
+			t.Errorf("bar %d: expected %.6f, got %.6f", i, want, values[i])
+		}
+	}
+}
+
+// TestWilliamsRBounds verifies that a close at the window high yields 0 and
+// a close at the window low yields -100.
+func TestWilliamsRBounds(t *testing.T) {
+	bars := []api.Bar{
+		{High: 10, Low: 5, Close: 7},
+		{High: 12, Low: 6, Close: 12},
+		{High: 11, Low: 4, Close: 4},
+	}
+
+	values := WilliamsR(bars, 2)
+
+	if values[1] != 0 {
+		t.Errorf("expected 0 when close equals highest high, got %v", values[1])
+	}
+
+	if values[2] != -100 {
+		t.Errorf("expected -100 when close equals lowest low, got %v", values[2])
+	}
+}
+
+// TestWilliamsRFlatRange verifies that a zero high/low range yields NaN
+// instead of dividing by zero.
+func TestWilliamsRFlatRange(t *testing.T) {
+	bars := []api.Bar{
+		{High: 10, Low: 10, Close: 10},
+		{High: 10, Low: 10, Close: 10},
+	}
+
+	values := WilliamsR(bars, 2)
+
+	if !math.IsNaN(values[1]) {
+		t.Errorf("expected NaN for flat range, got %v", values[1])
+	}
+}
+
+// TestWilliamsRWindowTooLarge verifies that every value is NaN when the
+// window exceeds the number of bars, and that an invalid window is handled.
+func TestWilliamsRWindowTooLarge(t *testing.T) {
+	for _, window := range []int{10, 0, -1} {
+		for i, v := range WilliamsR(testBars, window) {
+			if !math.IsNaN(v) {
+				t.Errorf("window %d, bar %d: expected NaN, got %v", window, i, v)
+			}
+		}
+	}
+
+	if len(WilliamsR(nil, 14)) != 0 {
+		t.Error("expected empty result for nil bars")
+	}
+}
@@ -0,0 +1,95 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// AuthMode controls how the client attaches the API key to each request.
+type AuthMode string
+
+// Supported auth modes. AuthQuery is Massive's native scheme and the
+// default; the header modes exist for gateways and proxies that expect the
+// key somewhere other than the query string.
+const (
+	// AuthQuery sends the key as the apiKey query parameter.
+	AuthQuery AuthMode = "query"
+
+	// AuthBearer sends the key as "Authorization: Bearer <key>".
+	AuthBearer AuthMode = "bearer"
+
+	// AuthHeader sends the key in a custom header, X-API-Key by default.
+	AuthHeader AuthMode = "header"
+)
+
+// DefaultAuthHeader is the header name used by AuthHeader mode unless
+// overridden with SetAuthHeader.
+const DefaultAuthHeader = "X-API-Key"
+
+// ParseAuthMode converts a flag value into an AuthMode, ignoring case.
+// An empty value selects AuthQuery.
+func ParseAuthMode(s string) (AuthMode, error) {
+	switch mode := AuthMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case "":
+		return AuthQuery, nil
+	case AuthQuery, AuthBearer, AuthHeader:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid auth mode %q: must be query, bearer, or header", s)
+	}
+}
+
+// SetAuthMode chooses how the API key is attached to requests. The zero
+// value behaves like AuthQuery.
+func (c *Client) SetAuthMode(mode AuthMode) {
+	c.authMode = mode
+}
+
+// SetAuthHeader sets the header name used in AuthHeader mode. An empty
+// name restores DefaultAuthHeader.
+func (c *Client) SetAuthHeader(name string) {
+	c.authHeader = name
+}
+
+// AuthMode returns the mode the client uses to attach the API key.
+func (c *Client) AuthMode() AuthMode {
+	if c.authMode == "" {
+		return AuthQuery
+	}
+	return c.authMode
+}
+
+// authorizeQuery adds the apiKey query parameter to q when the client is
+// in query mode. In the header modes the key is left out of the URL.
+func (c *Client) authorizeQuery(q url.Values) {
+	if c.AuthMode() == AuthQuery {
+		q.Set("apiKey", c.apiKey)
+	}
+}
+
+// authorizeRequest adds the API key header to req for the bearer and
+// header modes. Query mode needs nothing here since the key is already in
+// the URL.
+func (c *Client) authorizeRequest(req *http.Request) {
+	switch c.AuthMode() {
+	case AuthBearer:
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	case AuthHeader:
+		req.Header.Set(c.authHeaderName(), c.apiKey)
+	}
+}
+
+// authHeaderName is the header the key is sent in for the header mode.
+func (c *Client) authHeaderName() string {
+	if c.authHeader == "" {
+		return DefaultAuthHeader
+	}
+	return c.authHeader
+}
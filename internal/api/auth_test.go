@@ -0,0 +1,96 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestParseAuthMode verifies accepted spellings, the empty default, and
+// rejection of unknown modes.
+func TestParseAuthMode(t *testing.T) {
+	tests := []struct {
+		in   string
+		want AuthMode
+	}{
+		{"", AuthQuery},
+		{"query", AuthQuery},
+		{"Bearer", AuthBearer},
+		{" header ", AuthHeader},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAuthMode(tt.in)
+		if err != nil {
+			t.Errorf("ParseAuthMode(%q): unexpected error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseAuthMode(%q): expected %s, got %s", tt.in, tt.want, got)
+		}
+	}
+
+	if _, err := ParseAuthMode("cookie"); err == nil {
+		t.Error("expected error for unknown auth mode")
+	}
+}
+
+// TestAuthModes verifies where the API key ends up on the wire for each
+// auth mode, on both first-page and next_url requests.
+func TestAuthModes(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       AuthMode
+		header     string
+		wantQuery  string
+		wantHeader string
+		headerName string
+	}{
+		{"default", "", "", "k1", "", "Authorization"},
+		{"query", AuthQuery, "", "k1", "", "Authorization"},
+		{"bearer", AuthBearer, "", "", "Bearer k1", "Authorization"},
+		{"header", AuthHeader, "", "", "k1", DefaultAuthHeader},
+		{"custom header", AuthHeader, "X-Gateway-Key", "", "k1", "X-Gateway-Key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery, gotHeader []string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = append(gotQuery, r.URL.Query().Get("apiKey"))
+				gotHeader = append(gotHeader, r.Header.Get(tt.headerName))
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"status":"OK"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("k1")
+			client.SetBaseURL(server.URL)
+			client.SetAuthMode(tt.mode)
+			client.SetAuthHeader(tt.header)
+
+			var result map[string]interface{}
+			if err := client.get("/test", nil, &result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := client.getNext(server.URL+"/test?cursor=abc", &result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for i := range gotQuery {
+				if gotQuery[i] != tt.wantQuery {
+					t.Errorf("request %d: expected apiKey %q, got %q", i, tt.wantQuery, gotQuery[i])
+				}
+				if gotHeader[i] != tt.wantHeader {
+					t.Errorf("request %d: expected %s %q, got %q", i, tt.headerName, tt.wantHeader, gotHeader[i])
+				}
+			}
+		})
+	}
+}
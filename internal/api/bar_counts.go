@@ -0,0 +1,48 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SparseBarsRatio is how many times resultsCount a bars response's
+// queryCount must be before BarCounts.Sparse reports it.
+const SparseBarsRatio = 10
+
+// BarCounts holds the counts an aggregate bars response reports about
+// itself alongside the limit that was requested, for spotting responses
+// that are silently incomplete.
+type BarCounts struct {
+	QueryCount   int `json:"query_count"`
+	ResultsCount int `json:"results_count"`
+	Limit        int `json:"limit"`
+}
+
+// NewBarCounts builds BarCounts from a response's counts and the --limit
+// value that was sent. An empty or non-numeric limit is taken as the
+// aggregate endpoints' default page size.
+func NewBarCounts(queryCount, resultsCount int, limit string) BarCounts {
+	n, err := strconv.Atoi(strings.TrimSpace(limit))
+	if err != nil || n <= 0 {
+		n = endpointLimits[LimitAggs].Default
+	}
+
+	return BarCounts{QueryCount: queryCount, ResultsCount: resultsCount, Limit: n}
+}
+
+// Truncated reports whether the response returned as many bars as the
+// limit allowed, which usually means more bars exist in the range.
+func (c BarCounts) Truncated() bool {
+	return c.Limit > 0 && c.ResultsCount >= c.Limit
+}
+
+// Sparse reports whether the API queried far more base aggregates than it
+// returned bars, a sign of sparse data or the aggregate window cap.
+func (c BarCounts) Sparse() bool {
+	return c.QueryCount > 0 && c.QueryCount >= SparseBarsRatio*max(c.ResultsCount, 1)
+}
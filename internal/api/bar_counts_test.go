@@ -0,0 +1,62 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "testing"
+
+// TestNewBarCounts verifies the limit is parsed and falls back to the
+// aggregate default when empty or invalid.
+func TestNewBarCounts(t *testing.T) {
+	tests := []struct {
+		limit string
+		want  int
+	}{
+		{"120", 120},
+		{" 50000 ", 50000},
+		{"", 5000},
+		{"abc", 5000},
+		{"0", 5000},
+	}
+
+	for _, tt := range tests {
+		got := NewBarCounts(3, 2, tt.limit)
+		if got.Limit != tt.want {
+			t.Errorf("NewBarCounts(limit %q).Limit = %d, want %d", tt.limit, got.Limit, tt.want)
+		}
+		if got.QueryCount != 3 || got.ResultsCount != 2 {
+			t.Errorf("NewBarCounts(limit %q) counts = %d/%d, want 3/2", tt.limit, got.QueryCount, got.ResultsCount)
+		}
+	}
+}
+
+// TestBarCountsChecks verifies the truncation and sparseness checks.
+func TestBarCountsChecks(t *testing.T) {
+	tests := []struct {
+		name          string
+		counts        BarCounts
+		wantTruncated bool
+		wantSparse    bool
+	}{
+		{"complete", BarCounts{QueryCount: 20, ResultsCount: 20, Limit: 5000}, false, false},
+		{"at limit", BarCounts{QueryCount: 5000, ResultsCount: 5000, Limit: 5000}, true, false},
+		{"over limit", BarCounts{QueryCount: 120, ResultsCount: 120, Limit: 100}, true, false},
+		{"sparse", BarCounts{QueryCount: 500, ResultsCount: 50, Limit: 5000}, false, true},
+		{"just under ratio", BarCounts{QueryCount: 499, ResultsCount: 50, Limit: 5000}, false, false},
+		{"nothing returned", BarCounts{QueryCount: 10, ResultsCount: 0, Limit: 5000}, false, true},
+		{"empty", BarCounts{Limit: 5000}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.counts.Truncated(); got != tt.wantTruncated {
+				t.Errorf("Truncated() = %v, want %v", got, tt.wantTruncated)
+			}
+			if got := tt.counts.Sparse(); got != tt.wantSparse {
+				t.Errorf("Sparse() = %v, want %v", got, tt.wantSparse)
+			}
+		})
+	}
+}
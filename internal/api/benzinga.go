@@ -5,6 +5,8 @@
 
 package api
 
+import "encoding/json"
+
 // BenzingaNewsResponse represents the API response for Benzinga news articles.
 // It includes pagination support via NextURL and a list of news article results.
 type BenzingaNewsResponse struct {
@@ -134,32 +136,34 @@ type BenzingaEarningsResponse struct {
 
 // BenzingaEarnings represents a single earnings record from the Benzinga
 // API, including actual and estimated EPS/revenue figures, surprise metrics,
-// fiscal period information, and reporting metadata.
+// fiscal period information, and reporting metadata. Revenue figures are
+// json.Number so whole-dollar amounts of any size, fractions, and exponent
+// forms such as 1.2e+11 all decode and re-encode exactly as sent.
 type BenzingaEarnings struct {
-	BenzingaID             string  `json:"benzinga_id"`
-	Ticker                 string  `json:"ticker"`
-	CompanyName            string  `json:"company_name"`
-	Date                   string  `json:"date"`
-	Time                   string  `json:"time"`
-	DateStatus             string  `json:"date_status"`
-	ActualEPS              float64 `json:"actual_eps"`
-	EstimatedEPS           float64 `json:"estimated_eps"`
-	PreviousEPS            float64 `json:"previous_eps"`
-	EPSSurprise            float64 `json:"eps_surprise"`
-	EPSSurprisePercent     float64 `json:"eps_surprise_percent"`
-	ActualRevenue          float64 `json:"actual_revenue"`
-	EstimatedRevenue       float64 `json:"estimated_revenue"`
-	PreviousRevenue        float64 `json:"previous_revenue"`
-	RevenueSurprise        float64 `json:"revenue_surprise"`
-	RevenueSurprisePercent float64 `json:"revenue_surprise_percent"`
-	FiscalPeriod           string  `json:"fiscal_period"`
-	FiscalYear             int     `json:"fiscal_year"`
-	Importance             int     `json:"importance"`
-	Currency               string  `json:"currency"`
-	EPSMethod              string  `json:"eps_method"`
-	RevenueMethod          string  `json:"revenue_method"`
-	LastUpdated            string  `json:"last_updated"`
-	Notes                  string  `json:"notes"`
+	BenzingaID             string      `json:"benzinga_id"`
+	Ticker                 string      `json:"ticker"`
+	CompanyName            string      `json:"company_name"`
+	Date                   string      `json:"date"`
+	Time                   string      `json:"time"`
+	DateStatus             string      `json:"date_status"`
+	ActualEPS              float64     `json:"actual_eps"`
+	EstimatedEPS           float64     `json:"estimated_eps"`
+	PreviousEPS            float64     `json:"previous_eps"`
+	EPSSurprise            float64     `json:"eps_surprise"`
+	EPSSurprisePercent     float64     `json:"eps_surprise_percent"`
+	ActualRevenue          json.Number `json:"actual_revenue"`
+	EstimatedRevenue       json.Number `json:"estimated_revenue"`
+	PreviousRevenue        json.Number `json:"previous_revenue"`
+	RevenueSurprise        json.Number `json:"revenue_surprise"`
+	RevenueSurprisePercent float64     `json:"revenue_surprise_percent"`
+	FiscalPeriod           string      `json:"fiscal_period"`
+	FiscalYear             int         `json:"fiscal_year"`
+	Importance             int         `json:"importance"`
+	Currency               string      `json:"currency"`
+	EPSMethod              string      `json:"eps_method"`
+	RevenueMethod          string      `json:"revenue_method"`
+	LastUpdated            string      `json:"last_updated"`
+	Notes                  string      `json:"notes"`
 }
 
 // BenzingaEarningsParams holds the query parameters for fetching Benzinga
@@ -194,33 +198,34 @@ type BenzingaGuidanceResponse struct {
 
 // BenzingaGuidance represents a single corporate guidance record from the
 // Benzinga API, including projected EPS and revenue ranges, fiscal period
-// information, and company metadata.
+// information, and company metadata. Revenue guidance values are
+// json.Number for the same reason as BenzingaEarnings' revenue figures.
 type BenzingaGuidance struct {
-	BenzingaID                  string  `json:"benzinga_id"`
-	Ticker                      string  `json:"ticker"`
-	CompanyName                 string  `json:"company_name"`
-	Date                        string  `json:"date"`
-	Time                        string  `json:"time"`
-	Positioning                 string  `json:"positioning"`
-	EPSMethod                   string  `json:"eps_method"`
-	RevenueMethod               string  `json:"revenue_method"`
-	EstimatedEPSGuidance        float64 `json:"estimated_eps_guidance"`
-	EstimatedRevenueGuidance    float64 `json:"estimated_revenue_guidance"`
-	MinEPSGuidance              float64 `json:"min_eps_guidance"`
-	MaxEPSGuidance              float64 `json:"max_eps_guidance"`
-	MinRevenueGuidance          float64 `json:"min_revenue_guidance"`
-	MaxRevenueGuidance          float64 `json:"max_revenue_guidance"`
-	PreviousMinEPSGuidance      float64 `json:"previous_min_eps_guidance"`
-	PreviousMaxEPSGuidance      float64 `json:"previous_max_eps_guidance"`
-	PreviousMinRevenueGuidance  float64 `json:"previous_min_revenue_guidance"`
-	PreviousMaxRevenueGuidance  float64 `json:"previous_max_revenue_guidance"`
-	FiscalPeriod                string  `json:"fiscal_period"`
-	FiscalYear                  int     `json:"fiscal_year"`
-	Importance                  int     `json:"importance"`
-	Currency                    string  `json:"currency"`
-	ReleaseType                 string  `json:"release_type"`
-	LastUpdated                 string  `json:"last_updated"`
-	Notes                       string  `json:"notes"`
+	BenzingaID                 string      `json:"benzinga_id"`
+	Ticker                     string      `json:"ticker"`
+	CompanyName                string      `json:"company_name"`
+	Date                       string      `json:"date"`
+	Time                       string      `json:"time"`
+	Positioning                string      `json:"positioning"`
+	EPSMethod                  string      `json:"eps_method"`
+	RevenueMethod              string      `json:"revenue_method"`
+	EstimatedEPSGuidance       float64     `json:"estimated_eps_guidance"`
+	EstimatedRevenueGuidance   json.Number `json:"estimated_revenue_guidance"`
+	MinEPSGuidance             float64     `json:"min_eps_guidance"`
+	MaxEPSGuidance             float64     `json:"max_eps_guidance"`
+	MinRevenueGuidance         json.Number `json:"min_revenue_guidance"`
+	MaxRevenueGuidance         json.Number `json:"max_revenue_guidance"`
+	PreviousMinEPSGuidance     float64     `json:"previous_min_eps_guidance"`
+	PreviousMaxEPSGuidance     float64     `json:"previous_max_eps_guidance"`
+	PreviousMinRevenueGuidance json.Number `json:"previous_min_revenue_guidance"`
+	PreviousMaxRevenueGuidance json.Number `json:"previous_max_revenue_guidance"`
+	FiscalPeriod               string      `json:"fiscal_period"`
+	FiscalYear                 int         `json:"fiscal_year"`
+	Importance                 int         `json:"importance"`
+	Currency                   string      `json:"currency"`
+	ReleaseType                string      `json:"release_type"`
+	LastUpdated                string      `json:"last_updated"`
+	Notes                      string      `json:"notes"`
 }
 
 // BenzingaGuidanceParams holds the query parameters for fetching Benzinga
@@ -310,6 +315,17 @@ func (c *Client) GetBenzingaNews(p BenzingaNewsParams) (*BenzingaNewsResponse, e
 	return &result, nil
 }
 
+// GetBenzingaNewsNext retrieves the next page of Benzinga news articles
+// by following the next_url returned in a previous BenzingaNewsResponse.
+func (c *Client) GetBenzingaNewsNext(nextURL string) (*BenzingaNewsResponse, error) {
+	var result BenzingaNewsResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetBenzingaRatings retrieves Benzinga analyst ratings from the Massive API
 // with optional filtering by ticker, date range, rating action, price target
 // action, and importance level. Returns paginated results.
@@ -339,6 +355,18 @@ func (c *Client) GetBenzingaRatings(p BenzingaRatingsParams) (*BenzingaRatingsRe
 	return &result, nil
 }
 
+// GetBenzingaRatingsNext retrieves the next page of Benzinga analyst
+// ratings by following the next_url returned in a previous
+// BenzingaRatingsResponse.
+func (c *Client) GetBenzingaRatingsNext(nextURL string) (*BenzingaRatingsResponse, error) {
+	var result BenzingaRatingsResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetBenzingaEarnings retrieves Benzinga earnings data from the Massive API
 // with optional filtering by ticker, date range, fiscal period, date status,
 // and importance level. Returns paginated results with EPS and revenue details.
@@ -0,0 +1,92 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"sort"
+	"strings"
+)
+
+// BenzingaFirmSummary is the consensus of one research firm's analyst
+// ratings: how many ratings it issued, across how many tickers, how many
+// were upgrades or downgrades, and the average of the price targets it
+// set. UpgradeDowngradeRatio is upgrades per downgrade and is nil when
+// the firm issued no downgrades, since the ratio is then undefined.
+type BenzingaFirmSummary struct {
+	FirmID                string   `json:"benzinga_firm_id"`
+	Firm                  string   `json:"firm"`
+	Ratings               int      `json:"ratings"`
+	Tickers               int      `json:"tickers"`
+	Upgrades              int      `json:"upgrades"`
+	Downgrades            int      `json:"downgrades"`
+	PriceTargets          int      `json:"price_targets"`
+	AvgPriceTarget        float64  `json:"avg_price_target"`
+	UpgradeDowngradeRatio *float64 `json:"upgrade_downgrade_ratio"`
+}
+
+// SummarizeBenzingaFirms groups ratings by benzinga_firm_id, falling back
+// to the firm name (ignoring case) for ratings without an ID, and
+// summarizes each firm. Only positive price targets count toward the
+// average. Results are sorted by rating count, then firm name.
+func SummarizeBenzingaFirms(ratings []BenzingaRating) []BenzingaFirmSummary {
+	index := make(map[string]int)
+	tickers := make(map[string]map[string]bool)
+	var firms []BenzingaFirmSummary
+	var targetSums []float64
+
+	for _, r := range ratings {
+		key := r.BenzingaFirmID
+		if key == "" {
+			key = "name:" + strings.ToLower(strings.TrimSpace(r.Firm))
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(firms)
+			index[key] = i
+			tickers[key] = make(map[string]bool)
+			firms = append(firms, BenzingaFirmSummary{FirmID: r.BenzingaFirmID, Firm: strings.TrimSpace(r.Firm)})
+			targetSums = append(targetSums, 0)
+		}
+
+		f := &firms[i]
+		f.Ratings++
+		if r.Ticker != "" {
+			tickers[key][r.Ticker] = true
+		}
+		switch strings.ToLower(r.RatingAction) {
+		case "upgrades":
+			f.Upgrades++
+		case "downgrades":
+			f.Downgrades++
+		}
+		if r.PriceTarget > 0 {
+			f.PriceTargets++
+			targetSums[i] += r.PriceTarget
+		}
+	}
+
+	for key, i := range index {
+		f := &firms[i]
+		f.Tickers = len(tickers[key])
+		if f.PriceTargets > 0 {
+			f.AvgPriceTarget = targetSums[i] / float64(f.PriceTargets)
+		}
+		if f.Downgrades > 0 {
+			ratio := float64(f.Upgrades) / float64(f.Downgrades)
+			f.UpgradeDowngradeRatio = &ratio
+		}
+	}
+
+	sort.SliceStable(firms, func(i, j int) bool {
+		if firms[i].Ratings != firms[j].Ratings {
+			return firms[i].Ratings > firms[j].Ratings
+		}
+		return strings.ToLower(firms[i].Firm) < strings.ToLower(firms[j].Firm)
+	})
+
+	return firms
+}
@@ -0,0 +1,50 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "testing"
+
+// TestSummarizeBenzingaFirms verifies grouping by firm ID with a name
+// fallback, upgrade/downgrade counts and ratio, distinct tickers, the
+// average of positive price targets, and the sort order.
+func TestSummarizeBenzingaFirms(t *testing.T) {
+	ratings := []BenzingaRating{
+		{BenzingaFirmID: "f1", Firm: "Morgan Stanley", Ticker: "AAPL", RatingAction: "upgrades", PriceTarget: 200},
+		{BenzingaFirmID: "f1", Firm: "Morgan Stanley", Ticker: "MSFT", RatingAction: "Downgrades", PriceTarget: 400},
+		{BenzingaFirmID: "f1", Firm: "Morgan Stanley", Ticker: "AAPL", RatingAction: "upgrades"},
+		{BenzingaFirmID: "f2", Firm: "Wedbush", Ticker: "TSLA", RatingAction: "maintains", PriceTarget: 300},
+		{Firm: "Small Shop", Ticker: "NVDA", RatingAction: "upgrades", PriceTarget: 150},
+		{Firm: "small shop ", Ticker: "AMD", RatingAction: "initiates_coverage_on"},
+	}
+
+	firms := SummarizeBenzingaFirms(ratings)
+	if len(firms) != 3 {
+		t.Fatalf("expected 3 firms, got %+v", firms)
+	}
+
+	ms := firms[0]
+	if ms.FirmID != "f1" || ms.Ratings != 3 || ms.Tickers != 2 || ms.Upgrades != 2 || ms.Downgrades != 1 {
+		t.Errorf("unexpected Morgan Stanley summary: %+v", ms)
+	}
+	if ms.PriceTargets != 2 || ms.AvgPriceTarget != 300 {
+		t.Errorf("expected 2 price targets averaging 300, got %d averaging %v", ms.PriceTargets, ms.AvgPriceTarget)
+	}
+	if ms.UpgradeDowngradeRatio == nil || *ms.UpgradeDowngradeRatio != 2 {
+		t.Errorf("expected upgrade/downgrade ratio 2, got %v", ms.UpgradeDowngradeRatio)
+	}
+
+	shop := firms[1]
+	if shop.Firm != "Small Shop" || shop.Ratings != 2 || shop.Tickers != 2 || shop.AvgPriceTarget != 150 {
+		t.Errorf("unexpected Small Shop summary: %+v", shop)
+	}
+	if shop.UpgradeDowngradeRatio != nil {
+		t.Errorf("expected no ratio without downgrades, got %v", *shop.UpgradeDowngradeRatio)
+	}
+
+	if firms[2].Firm != "Wedbush" || firms[2].Ratings != 1 {
+		t.Errorf("expected Wedbush last with 1 rating, got %+v", firms[2])
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// BenzingaNewsSearch matches news articles against a search term in their
+// title, teaser, or body. Matching is always case-insensitive.
+type BenzingaNewsSearch struct {
+	re *regexp.Regexp
+}
+
+// NewBenzingaNewsSearch builds a search for term. When useRegex is false
+// the term is matched literally; otherwise it is compiled as a regular
+// expression and an invalid pattern is returned as an error.
+func NewBenzingaNewsSearch(term string, useRegex bool) (*BenzingaNewsSearch, error) {
+	pattern := regexp.QuoteMeta(term)
+	if useRegex {
+		pattern = term
+	}
+
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, fmt.Errorf("invalid search pattern: %w", err)
+	}
+
+	return &BenzingaNewsSearch{re: regexp.MustCompile("(?i)" + pattern)}, nil
+}
+
+// Match reports whether the article's title, teaser, or body matches.
+func (s *BenzingaNewsSearch) Match(a BenzingaNewsArticle) bool {
+	return s.re.MatchString(a.Title) || s.re.MatchString(a.Teaser) || s.re.MatchString(a.Body)
+}
+
+// Filter returns the articles that match, preserving their order.
+func (s *BenzingaNewsSearch) Filter(articles []BenzingaNewsArticle) []BenzingaNewsArticle {
+	var matched []BenzingaNewsArticle
+	for _, a := range articles {
+		if s.Match(a) {
+			matched = append(matched, a)
+		}
+	}
+	return matched
+}
+
+// BenzingaChannelCount is a news channel and the number of articles that
+// were tagged with it.
+type BenzingaChannelCount struct {
+	Channel  string `json:"channel"`
+	Articles int    `json:"articles"`
+}
+
+// CountBenzingaChannels tallies the distinct channels across articles,
+// ignoring case and surrounding space. Each channel keeps the spelling it
+// was first seen with. Results are sorted by article count, then name.
+func CountBenzingaChannels(articles []BenzingaNewsArticle) []BenzingaChannelCount {
+	index := make(map[string]int)
+	var counts []BenzingaChannelCount
+
+	for _, a := range articles {
+		for _, ch := range a.Channels {
+			ch = strings.TrimSpace(ch)
+			if ch == "" {
+				continue
+			}
+
+			key := strings.ToLower(ch)
+			if i, ok := index[key]; ok {
+				counts[i].Articles++
+				continue
+			}
+
+			index[key] = len(counts)
+			counts = append(counts, BenzingaChannelCount{Channel: ch, Articles: 1})
+		}
+	}
+
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].Articles != counts[j].Articles {
+			return counts[i].Articles > counts[j].Articles
+		}
+		return strings.ToLower(counts[i].Channel) < strings.ToLower(counts[j].Channel)
+	})
+
+	return counts
+}
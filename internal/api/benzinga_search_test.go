@@ -0,0 +1,79 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "testing"
+
+// searchArticles is a small set of news articles used by the search and
+// channel tests.
+var searchArticles = []BenzingaNewsArticle{
+	{Title: "Apple Beats Earnings", Teaser: "iPhone sales rise", Channels: []string{"Earnings", "Tech"}},
+	{Title: "Oil slides", Body: "Crude fell 3% on supply news (OPEC+)", Channels: []string{"Commodities", "tech"}},
+	{Title: "Fed holds rates", Teaser: "Powell signals patience", Channels: []string{"Economics"}},
+}
+
+// TestBenzingaNewsSearchLiteral verifies case-insensitive literal matching
+// across title, teaser, and body, with regex metacharacters taken
+// literally.
+func TestBenzingaNewsSearchLiteral(t *testing.T) {
+	tests := map[string]int{
+		"apple":   1, // title
+		"IPHONE":  1, // teaser
+		"opec+":   1, // body, "+" is literal
+		"s":       3,
+		"bitcoin": 0,
+	}
+
+	for term, want := range tests {
+		search, err := NewBenzingaNewsSearch(term, false)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", term, err)
+		}
+		if got := len(search.Filter(searchArticles)); got != want {
+			t.Errorf("%q: expected %d matches, got %d", term, want, got)
+		}
+	}
+}
+
+// TestBenzingaNewsSearchRegex verifies regex matching and that an invalid
+// pattern is rejected.
+func TestBenzingaNewsSearchRegex(t *testing.T) {
+	search, err := NewBenzingaNewsSearch(`^(apple|fed)\b`, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	matched := search.Filter(searchArticles)
+	if len(matched) != 2 || matched[0].Title != "Apple Beats Earnings" || matched[1].Title != "Fed holds rates" {
+		t.Errorf("unexpected matches: %+v", matched)
+	}
+
+	if _, err := NewBenzingaNewsSearch("(unclosed", true); err == nil {
+		t.Error("expected error for invalid regex, got nil")
+	}
+}
+
+// TestCountBenzingaChannels verifies case-insensitive channel counting and
+// ordering by count then name.
+func TestCountBenzingaChannels(t *testing.T) {
+	counts := CountBenzingaChannels(searchArticles)
+
+	want := []BenzingaChannelCount{
+		{Channel: "Tech", Articles: 2},
+		{Channel: "Commodities", Articles: 1},
+		{Channel: "Earnings", Articles: 1},
+		{Channel: "Economics", Articles: 1},
+	}
+
+	if len(counts) != len(want) {
+		t.Fatalf("expected %d channels, got %+v", len(want), counts)
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("position %d: expected %+v, got %+v", i, want[i], counts[i])
+		}
+	}
+}
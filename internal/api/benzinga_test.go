@@ -6,6 +6,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -759,6 +760,34 @@ func TestGetBenzingaRatingsEmptyResults(t *testing.T) {
 	}
 }
 
+// TestGetBenzingaRatingsNext verifies that GetBenzingaRatingsNext follows
+// the next_url path and cursor against the configured base URL.
+func TestGetBenzingaRatingsNext(t *testing.T) {
+	var receivedPath, receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(benzingaRatingsJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetBenzingaRatingsNext("https://api.massive.com/benzinga/v1/ratings?cursor=page2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedPath != "/benzinga/v1/ratings" || receivedCursor != "page2" {
+		t.Errorf("expected /benzinga/v1/ratings with cursor=page2, got %s cursor=%s", receivedPath, receivedCursor)
+	}
+
+	if len(result.Results) == 0 {
+		t.Error("expected ratings in the next page")
+	}
+}
+
 // TestGetBenzingaEarnings verifies that GetBenzingaEarnings correctly parses
 // the API response and returns the expected earnings data.
 func TestGetBenzingaEarnings(t *testing.T) {
@@ -843,16 +872,16 @@ func TestGetBenzingaEarningsFirstRecord(t *testing.T) {
 		t.Errorf("expected eps_surprise_percent 3.81, got %f", earn.EPSSurprisePercent)
 	}
 
-	if earn.ActualRevenue != 124500000000 {
-		t.Errorf("expected actual_revenue 124500000000, got %f", earn.ActualRevenue)
+	if earn.ActualRevenue != "124500000000" {
+		t.Errorf("expected actual_revenue 124500000000, got %s", earn.ActualRevenue)
 	}
 
-	if earn.EstimatedRevenue != 121000000000 {
-		t.Errorf("expected estimated_revenue 121000000000, got %f", earn.EstimatedRevenue)
+	if earn.EstimatedRevenue != "121000000000" {
+		t.Errorf("expected estimated_revenue 121000000000, got %s", earn.EstimatedRevenue)
 	}
 
-	if earn.RevenueSurprise != 3500000000 {
-		t.Errorf("expected revenue_surprise 3500000000, got %f", earn.RevenueSurprise)
+	if earn.RevenueSurprise != "3500000000" {
+		t.Errorf("expected revenue_surprise 3500000000, got %s", earn.RevenueSurprise)
 	}
 
 	if earn.FiscalPeriod != "Q1" {
@@ -876,6 +905,109 @@ func TestGetBenzingaEarningsFirstRecord(t *testing.T) {
 	}
 }
 
+// TestBenzingaEarningsRevenueRoundTrip verifies that large integer revenue
+// figures survive a decode/encode round trip exactly and encode as plain
+// integers rather than in scientific notation.
+func TestBenzingaEarningsRevenueRoundTrip(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/benzinga/v1/earnings": benzingaEarningsJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetBenzingaEarnings(BenzingaEarningsParams{Ticker: "AAPL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	earn := result.Results[0]
+
+	data, err := json.Marshal(earn)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	var decoded BenzingaEarnings
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+
+	if decoded.ActualRevenue != "124500000000" {
+		t.Errorf("expected actual_revenue 124500000000 after round trip, got %s", decoded.ActualRevenue)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+
+	if string(raw["actual_revenue"]) != "124500000000" {
+		t.Errorf("expected actual_revenue to encode as 124500000000, got %s", raw["actual_revenue"])
+	}
+}
+
+// benzingaEarningsFractionalJSON has revenue figures with a fraction, in
+// exponent form, and above 2^53, all of which the API can send.
+const benzingaEarningsFractionalJSON = `{
+	"results": [
+		{
+			"benzinga_id": "bz-earn-003",
+			"ticker": "NVDA",
+			"actual_revenue": 35082000000.5,
+			"estimated_revenue": 1.2e+11,
+			"previous_revenue": 9007199254740993,
+			"revenue_surprise": -84918000000.25
+		}
+	],
+	"status": "OK",
+	"request_id": "bz-earn-req-002"
+}`
+
+// TestGetBenzingaEarningsFractionalRevenue verifies revenue sent with a
+// fraction, in exponent form, or above 2^53 decodes instead of failing the
+// response and re-encodes exactly as sent.
+func TestGetBenzingaEarningsFractionalRevenue(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/benzinga/v1/earnings": benzingaEarningsFractionalJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetBenzingaEarnings(BenzingaEarningsParams{Ticker: "NVDA"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	earn := result.Results[0]
+	if earn.ActualRevenue != "35082000000.5" {
+		t.Errorf("expected actual_revenue 35082000000.5, got %s", earn.ActualRevenue)
+	}
+	if earn.EstimatedRevenue != "1.2e+11" {
+		t.Errorf("expected estimated_revenue 1.2e+11, got %s", earn.EstimatedRevenue)
+	}
+	if earn.RevenueSurprise != "-84918000000.25" {
+		t.Errorf("expected revenue_surprise -84918000000.25, got %s", earn.RevenueSurprise)
+	}
+
+	data, err := json.Marshal(earn)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	for field, want := range map[string]string{
+		"actual_revenue":    "35082000000.5",
+		"estimated_revenue": "1.2e+11",
+		"previous_revenue":  "9007199254740993",
+	} {
+		if string(raw[field]) != want {
+			t.Errorf("expected %s to encode as %s, got %s", field, want, raw[field])
+		}
+	}
+}
+
 // TestGetBenzingaEarningsSecondRecord verifies that the second earnings
 // record in the response is correctly parsed as a projected earnings entry.
 func TestGetBenzingaEarningsSecondRecord(t *testing.T) {
@@ -1100,16 +1232,16 @@ func TestGetBenzingaGuidanceFirstRecord(t *testing.T) {
 		t.Errorf("expected max_eps_guidance 2.35, got %f", guide.MaxEPSGuidance)
 	}
 
-	if guide.EstimatedRevenueGuidance != 128000000000 {
-		t.Errorf("expected estimated_revenue_guidance 128000000000, got %f", guide.EstimatedRevenueGuidance)
+	if guide.EstimatedRevenueGuidance != "128000000000" {
+		t.Errorf("expected estimated_revenue_guidance 128000000000, got %s", guide.EstimatedRevenueGuidance)
 	}
 
-	if guide.MinRevenueGuidance != 125000000000 {
-		t.Errorf("expected min_revenue_guidance 125000000000, got %f", guide.MinRevenueGuidance)
+	if guide.MinRevenueGuidance != "125000000000" {
+		t.Errorf("expected min_revenue_guidance 125000000000, got %s", guide.MinRevenueGuidance)
 	}
 
-	if guide.MaxRevenueGuidance != 131000000000 {
-		t.Errorf("expected max_revenue_guidance 131000000000, got %f", guide.MaxRevenueGuidance)
+	if guide.MaxRevenueGuidance != "131000000000" {
+		t.Errorf("expected max_revenue_guidance 131000000000, got %s", guide.MaxRevenueGuidance)
 	}
 
 	if guide.FiscalPeriod != "Q2" {
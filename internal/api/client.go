@@ -6,35 +6,212 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 const defaultBaseURL = "https://api.massive.com"
 
+// DefaultUserAgent is the User-Agent sent when none is configured. The CLI
+// appends its build version (massive-cli/v1.2.3) via SetUserAgent.
+const DefaultUserAgent = "massive-cli"
+
+// ErrRequestPrinted is returned in place of a response when the client is
+// in print-request mode. The request URL has been written out but the
+// request itself was never sent.
+var ErrRequestPrinted = errors.New("request printed, not sent")
+
 // Client is the HTTP client for interacting with the Massive API.
-// It handles authentication by appending the API key as a query parameter
-// to all requests.
+// It handles authentication by attaching the API key to all requests,
+// as a query parameter by default or in a header (see AuthMode).
 type Client struct {
 	baseURL    string
 	apiKey     string
+	authMode   AuthMode
+	authHeader string
+	userAgent  string
 	httpClient *http.Client
+
+	noCompression bool
+	noRedirects   bool
+	maxRedirects  int
+	redirectHosts []string
+
+	// IsRetryable decides whether a failed request is retried by the
+	// retry layer (AdaptiveFetcher.Do). statusCode is the HTTP status of
+	// an *APIError, or 0 for other errors such as network failures. When
+	// nil, DefaultIsRetryable is used. Embedders can replace it, for
+	// example to retry 404s while data settles after a corporate action.
+	IsRetryable func(statusCode int, err error) bool
+
+	archiveDir   string
+	cacheDir     string
+	printRequest io.Writer
+	dryRun       bool
+
+	mu           sync.Mutex
+	rateLimit    RateLimit
+	results      ResultCounts
+	lastResponse ResponseMeta
+	timings      Timings
+}
+
+// DefaultIsRetryable is the built-in retry policy: rate limiting (429)
+// and server errors (5xx) are retried; everything else, including network
+// errors and other 4xx responses, fails immediately.
+func DefaultIsRetryable(statusCode int, err error) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// RateLimit holds the rate limit state reported by the most recent API
+// response via the X-RateLimit-Limit and X-RateLimit-Remaining headers.
+// Known is false until a response carrying the headers has been seen.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Known     bool
+}
+
+// ResultCounts tallies the list responses a client has decoded. Lists is
+// the number of responses carrying a "results" array and Items is the
+// total number of entries across those arrays.
+type ResultCounts struct {
+	Lists int
+	Items int
+}
+
+// ResponseMeta describes the most recent successful response: its HTTP
+// status, the request_id from the response body, when it was received,
+// and how long the round trip took. ElapsedMS covers the whole exchange
+// from sending the request to reading the body; LatencyMS is the server's
+// share, from the request being written to the first response byte.
+// FetchedAt is the zero time until a response has been seen.
+type ResponseMeta struct {
+	StatusCode int       `json:"status_code"`
+	RequestID  string    `json:"request_id,omitempty"`
+	FetchedAt  time.Time `json:"fetched_at"`
+	ElapsedMS  float64   `json:"elapsed_ms"`
+	LatencyMS  float64   `json:"latency_ms"`
+}
+
+// Timings accumulates request timing across every HTTP round trip a
+// client has completed, including error responses and retried attempts.
+// Elapsed is the total time spent in round trips and ServerLatency the
+// part of it spent waiting for the server's first byte.
+type Timings struct {
+	RoundTrips    int
+	Elapsed       time.Duration
+	ServerLatency time.Duration
+}
+
+// AverageLatency returns the mean server latency per round trip, or zero
+// when no round trip has completed.
+func (t Timings) AverageLatency() time.Duration {
+	if t.RoundTrips == 0 {
+		return 0
+	}
+	return t.ServerLatency / time.Duration(t.RoundTrips)
+}
+
+// Add returns the sum of two Timings, for totalling several clients.
+func (t Timings) Add(o Timings) Timings {
+	return Timings{
+		RoundTrips:    t.RoundTrips + o.RoundTrips,
+		Elapsed:       t.Elapsed + o.Elapsed,
+		ServerLatency: t.ServerLatency + o.ServerLatency,
+	}
+}
+
+// Empty reports whether at least one list response was seen and every one
+// of them came back with no results.
+func (r ResultCounts) Empty() bool {
+	return r.Lists > 0 && r.Items == 0
+}
+
+// APIError is returned when the Massive API responds with a non-2xx status
+// code. It keeps the status code and any Retry-After hint so callers can
+// detect rate limiting and back off before retrying, and the request URL
+// (API key redacted) for error reports.
+type APIError struct {
+	StatusCode int
+	Body       string
+	RetryAfter time.Duration
+	URL        string
+}
+
+// Error formats the API error with its status code and response body.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// apiErrorBody is the JSON error envelope the API returns with non-200
+// responses. Some endpoints put the explanation in "message", others in
+// "error".
+type apiErrorBody struct {
+	Status    string `json:"status"`
+	RequestID string `json:"request_id"`
+	Message   string `json:"message"`
+	Error     string `json:"error"`
+}
+
+// parseBody decodes the JSON error envelope, returning the zero value for
+// bodies that are not JSON.
+func (e *APIError) parseBody() apiErrorBody {
+	var body apiErrorBody
+	_ = json.Unmarshal([]byte(e.Body), &body)
+	return body
+}
+
+// Message returns the human-readable explanation from the error body: the
+// "message" or "error" field of a JSON envelope, or the trimmed raw body
+// when it is not JSON.
+func (e *APIError) Message() string {
+	body := e.parseBody()
+	switch {
+	case body.Message != "":
+		return body.Message
+	case body.Error != "":
+		return body.Error
+	case body.Status != "":
+		return body.Status
+	}
+	return strings.TrimSpace(e.Body)
+}
+
+// RequestID returns the request_id from the error body, or "" when the
+// body carries none.
+func (e *APIError) RequestID() string {
+	return e.parseBody().RequestID
 }
 
 // NewClient creates a new Massive API client with the given API key.
-// It configures a default HTTP client with a 30-second timeout.
+// It configures a default HTTP client with a 30-second timeout that
+// follows up to DefaultMaxRedirects redirects (see SetRedirectPolicy).
 func NewClient(apiKey string) *Client {
-	return &Client{
-		baseURL: defaultBaseURL,
-		apiKey:  apiKey,
+	c := &Client{
+		baseURL:      defaultBaseURL,
+		apiKey:       apiKey,
+		userAgent:    DefaultUserAgent,
+		maxRedirects: DefaultMaxRedirects,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 	}
+	c.httpClient.CheckRedirect = c.checkRedirect
+	return c
 }
 
 // SetBaseURL overrides the API base URL. Used by tests to point
@@ -43,17 +220,118 @@ func (c *Client) SetBaseURL(url string) {
 	c.baseURL = url
 }
 
-// get performs an authenticated GET request to the given API path with
-// optional query parameters. It appends the API key to the request and
-// unmarshals the JSON response into the provided result interface.
-func (c *Client) get(path string, params map[string]string, result interface{}) error {
+// SetUserAgent sets the User-Agent header sent with every request so
+// support can correlate traffic and users can tag automated jobs. An empty
+// string falls back to DefaultUserAgent.
+func (c *Client) SetUserAgent(ua string) {
+	if ua == "" {
+		ua = DefaultUserAgent
+	}
+	c.userAgent = ua
+}
+
+// SetCompression controls whether responses are requested gzip-compressed.
+// Compression is on by default, which shrinks large paginated pulls
+// considerably; turning it off asks for identity encoding so raw bytes
+// can be inspected while debugging.
+func (c *Client) SetCompression(enabled bool) {
+	c.noCompression = !enabled
+}
+
+// SetArchiveDir enables archiving of raw API responses. When set, every
+// successful response body is written to dir alongside a metadata sidecar
+// recording the request URL. Pass an empty string to disable archiving.
+func (c *Client) SetArchiveDir(dir string) {
+	c.archiveDir = dir
+}
+
+// SetCacheDir sets the directory where on-disk indexes such as the
+// ticker cache are stored. An empty dir disables caching.
+func (c *Client) SetCacheDir(dir string) {
+	c.cacheDir = dir
+}
+
+// SetPrintRequest switches the client into print-request mode. Instead of
+// sending requests, the fully built URL (with the API key redacted) is
+// written to w and ErrRequestPrinted is returned. Pass nil to disable.
+func (c *Client) SetPrintRequest(w io.Writer) {
+	c.printRequest = w
+}
+
+// SetDryRun switches the client into dry-run mode. Like print-request mode
+// no request is sent, but the line written to w reads "OK GET <url>" to
+// confirm the command's params were built and validated without error.
+// ErrRequestPrinted is returned in place of a response. Pass nil to
+// disable.
+func (c *Client) SetDryRun(w io.Writer) {
+	c.printRequest = w
+	c.dryRun = w != nil
+}
+
+// retryable reports whether err should be retried under the client's
+// IsRetryable policy. A nil error and ErrRequestPrinted are never retried.
+func (c *Client) retryable(err error) bool {
+	if err == nil || errors.Is(err, ErrRequestPrinted) {
+		return false
+	}
+
+	statusCode := 0
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		statusCode = apiErr.StatusCode
+	}
+
+	policy := c.IsRetryable
+	if policy == nil {
+		policy = DefaultIsRetryable
+	}
+	return policy(statusCode, err)
+}
+
+// RateLimit returns the rate limit state observed on the most recent
+// response. It is safe to call from multiple goroutines.
+func (c *Client) RateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
+
+// ResultCounts returns the list response tallies recorded so far. Callers
+// use it to detect runs that returned no data across all pages.
+func (c *Client) ResultCounts() ResultCounts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.results
+}
+
+// LastResponse returns the metadata of the most recent successful
+// response. It is safe to call from multiple goroutines.
+func (c *Client) LastResponse() ResponseMeta {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastResponse
+}
+
+// Timings returns the request timing accumulated over every round trip
+// so far. It is safe to call from multiple goroutines.
+func (c *Client) Timings() Timings {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.timings
+}
+
+// BuildURL builds the full request URL for the given API path and query
+// parameters, including the API key when it travels in the query string.
+// Empty parameter values are omitted, matching exactly what get sends over
+// the wire.
+func (c *Client) BuildURL(path string, params map[string]string) (*url.URL, error) {
 	u, err := url.Parse(c.baseURL + path)
 	if err != nil {
-		return fmt.Errorf("invalid URL: %w", err)
+		return nil, fmt.Errorf("invalid URL: %w", err)
 	}
 
 	q := u.Query()
-	q.Set("apiKey", c.apiKey)
+	c.authorizeQuery(q)
 	for k, v := range params {
 		if v != "" {
 			q.Set(k, v)
@@ -61,24 +339,366 @@ func (c *Client) get(path string, params map[string]string, result interface{})
 	}
 	u.RawQuery = q.Encode()
 
-	resp, err := c.httpClient.Get(u.String())
+	return u, nil
+}
+
+// get performs an authenticated GET request to the given API path with
+// optional query parameters. It appends the API key to the request and
+// unmarshals the JSON response into the provided result interface.
+func (c *Client) get(path string, params map[string]string, result interface{}) error {
+	u, err := c.BuildURL(path, params)
+	if err != nil {
+		return err
+	}
+
+	return c.do(u, result)
+}
+
+// getNext follows a pagination next_url returned by a previous response.
+// The cursor and filters are already encoded in the URL, so only the API
+// key is added before the request is sent. The URL is resolved against
+// the configured base URL first (see resolveNextURL).
+func (c *Client) getNext(nextURL string, result interface{}) error {
+	u, err := c.resolveNextURL(nextURL)
+	if err != nil {
+		return err
+	}
+
+	q := u.Query()
+	c.authorizeQuery(q)
+	u.RawQuery = q.Encode()
+
+	return c.do(u, result)
+}
+
+// ResolveURL rewrites an absolute or relative URL returned by the API,
+// such as an indicator's underlying aggregates URL, onto the configured
+// base URL. The result carries no API key, so it is safe to display.
+func (c *Client) ResolveURL(rawURL string) (string, error) {
+	u, err := c.resolveNextURL(rawURL)
+	if err != nil {
+		return "", err
+	}
+	return redactURL(u), nil
+}
+
+// resolveNextURL rewrites a next_url so it targets the configured base URL.
+// The API returns absolute URLs on its public host and sometimes relative
+// ones; either way the scheme and host are replaced with the base URL's,
+// and any base path prefix (e.g. from a proxy) is kept, so pagination works
+// against sandbox, mock, and proxy environments.
+func (c *Client) resolveNextURL(nextURL string) (*url.URL, error) {
+	u, err := url.Parse(nextURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next URL: %w", err)
+	}
+
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	u.Scheme = base.Scheme
+	u.Host = base.Host
+	u.User = base.User
+
+	prefix := strings.TrimRight(base.Path, "/")
+	if !strings.HasPrefix(u.Path, "/") {
+		u.Path = "/" + u.Path
+	}
+	if prefix != "" && !strings.HasPrefix(u.Path, prefix+"/") {
+		u.Path = prefix + u.Path
+	}
+
+	return u, nil
+}
+
+// do sends the GET request for the fully built URL, records any rate limit
+// headers and timing, and unmarshals a successful JSON response into result;
+// a 2xx with an empty body, such as a 204, leaves result zero. The
+// User-Agent and, for the bearer and header auth modes, the API key header
+// are added here. Non-2xx
+// responses are returned as an *APIError. In print-request mode the URL
+// is written out instead and ErrRequestPrinted is returned; dry-run mode
+// prefixes it with "OK GET".
+func (c *Client) do(u *url.URL, result interface{}) error {
+	if c.printRequest != nil {
+		if c.dryRun {
+			fmt.Fprintf(c.printRequest, "OK GET %s\n", redactURL(u))
+		} else {
+			fmt.Fprintln(c.printRequest, redactURL(u))
+		}
+		return ErrRequestPrinted
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	// Setting Accept-Encoding explicitly turns off the transport's own
+	// transparent gzip handling, so readBody decompresses instead.
+	if c.noCompression {
+		req.Header.Set("Accept-Encoding", "identity")
+	} else {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	c.authorizeRequest(req)
+
+	// The trace marks when the request was fully written and when the
+	// first response byte arrived, separating server latency from
+	// connection setup and transfer time.
+	var wrote, firstByte time.Time
+	req = req.WithContext(httptrace.WithClientTrace(withAPIRequest(req.Context()), &httptrace.ClientTrace{
+		WroteRequest:         func(httptrace.WroteRequestInfo) { wrote = time.Now() },
+		GotFirstResponseByte: func() { firstByte = time.Now() },
+	}))
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	c.recordRateLimit(resp.Header)
+
+	body, err := readBody(resp)
+	trip := roundTrip{elapsed: time.Since(start)}
+	if !wrote.IsZero() && firstByte.After(wrote) {
+		trip.latency = firstByte.Sub(wrote)
+	}
+	c.recordTiming(trip)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, Body: string(body), URL: redactURL(u)}
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			apiErr.RetryAfter = time.Duration(secs) * time.Second
+		}
+		return apiErr
+	}
+
+	// A 204 or an empty 2xx body is how some endpoints say there is no
+	// data; leave result at its zero value rather than failing to decode.
+	empty := len(bytes.TrimSpace(body)) == 0
+	if !empty {
+		if err := json.Unmarshal(body, result); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+
+	// Archive only once the body has parsed, so failed attempts that a
+	// caller retries (errors, truncated bodies) never leave files behind.
+	if c.archiveDir != "" {
+		if err := c.archive(u, resp.StatusCode, body); err != nil {
+			return err
+		}
+	}
+
+	if empty {
+		c.recordEmptyList()
+	} else {
+		c.recordResults(body)
+	}
+	c.recordResponse(resp.StatusCode, body, trip)
+
+	return nil
+}
+
+// readBody reads the full response body, decompressing it when the server
+// sent it gzip-encoded.
+func readBody(resp *http.Response) ([]byte, error) {
+	reader := io.Reader(resp.Body)
+
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress response: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return body, nil
+}
+
+// archiveMeta is the sidecar metadata written next to each archived
+// response so the raw body can be traced back to the request that
+// produced it.
+type archiveMeta struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+	RequestID  string `json:"request_id,omitempty"`
+	FetchedAt  string `json:"fetched_at"`
+}
+
+// archive writes the raw response body to the archive directory using a
+// timestamped, request_id-based filename, plus a .meta.json sidecar with
+// the request URL. The API key is redacted from the recorded URL. Both
+// files are staged as .tmp files and renamed into place only once both
+// are fully written, so an interrupted run never leaves a partial archive.
+func (c *Client) archive(u *url.URL, statusCode int, body []byte) error {
+	if err := os.MkdirAll(c.archiveDir, 0755); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	var envelope struct {
+		RequestID string `json:"request_id"`
+	}
+	_ = json.Unmarshal(body, &envelope)
+
+	now := time.Now().UTC()
+	name := now.Format("20060102T150405.000000000Z")
+	if envelope.RequestID != "" {
+		name += "_" + envelope.RequestID
+	}
+
+	meta, err := json.MarshalIndent(archiveMeta{
+		URL:        redactURL(u),
+		StatusCode: statusCode,
+		RequestID:  envelope.RequestID,
+		FetchedAt:  now.Format(time.RFC3339Nano),
+	}, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return fmt.Errorf("failed to encode archive metadata: %w", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	base := filepath.Join(c.archiveDir, name)
+	bodyPath, metaPath := base+".json", base+".meta.json"
+	if err := os.WriteFile(bodyPath+".tmp", body, 0644); err != nil {
+		os.Remove(bodyPath + ".tmp")
+		return fmt.Errorf("failed to archive response: %w", err)
+	}
+	if err := os.WriteFile(metaPath+".tmp", meta, 0644); err != nil {
+		os.Remove(bodyPath + ".tmp")
+		os.Remove(metaPath + ".tmp")
+		return fmt.Errorf("failed to archive response metadata: %w", err)
 	}
 
-	if err := json.Unmarshal(body, result); err != nil {
-		return fmt.Errorf("failed to parse response: %w", err)
+	if err := os.Rename(bodyPath+".tmp", bodyPath); err != nil {
+		os.Remove(bodyPath + ".tmp")
+		os.Remove(metaPath + ".tmp")
+		return fmt.Errorf("failed to archive response: %w", err)
+	}
+	if err := os.Rename(metaPath+".tmp", metaPath); err != nil {
+		os.Remove(metaPath + ".tmp")
+		return fmt.Errorf("failed to archive response metadata: %w", err)
 	}
 
 	return nil
 }
+
+// redactURL returns the URL as a string with the apiKey query parameter
+// replaced by REDACTED so it can be safely logged or written to disk.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	q := redacted.Query()
+	if q.Has("apiKey") {
+		q.Set("apiKey", "REDACTED")
+	}
+	redacted.RawQuery = q.Encode()
+	return redacted.String()
+}
+
+// recordResults counts the entries in the response's top-level "results"
+// array. Responses whose results are missing or not an array, such as
+// single-object endpoints, are not counted as lists.
+func (c *Client) recordResults(body []byte) {
+	var envelope struct {
+		Results json.RawMessage `json:"results"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(envelope.Results, &items); err != nil || envelope.Results[0] != '[' {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results.Lists++
+	c.results.Items += len(items)
+}
+
+// recordEmptyList counts an empty-bodied success as a list with no
+// results, so --fail-on-empty treats it like an empty results array.
+func (c *Client) recordEmptyList() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results.Lists++
+}
+
+// roundTrip is the timing of a single request: the whole exchange and
+// the server latency within it (zero when the trace saw no first byte).
+type roundTrip struct {
+	elapsed time.Duration
+	latency time.Duration
+}
+
+// recordTiming adds one completed round trip to the client's Timings.
+func (c *Client) recordTiming(trip roundTrip) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.timings.RoundTrips++
+	c.timings.Elapsed += trip.elapsed
+	c.timings.ServerLatency += trip.latency
+}
+
+// recordResponse stores the status, request_id, receipt time, and timing
+// of a successful response for LastResponse.
+func (c *Client) recordResponse(statusCode int, body []byte, trip roundTrip) {
+	var envelope struct {
+		RequestID string `json:"request_id"`
+	}
+	_ = json.Unmarshal(body, &envelope)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastResponse = ResponseMeta{
+		StatusCode: statusCode,
+		RequestID:  envelope.RequestID,
+		FetchedAt:  time.Now().UTC(),
+		ElapsedMS:  durationMS(trip.elapsed),
+		LatencyMS:  durationMS(trip.latency),
+	}
+}
+
+// durationMS converts a duration to fractional milliseconds for JSON.
+func durationMS(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// recordRateLimit stores the X-RateLimit-Limit and X-RateLimit-Remaining
+// header values from a response. Responses without the headers leave the
+// previously observed state untouched.
+func (c *Client) recordRateLimit(h http.Header) {
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rateLimit.Remaining = remaining
+	c.rateLimit.Known = true
+	if limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit")); err == nil {
+		c.rateLimit.Limit = limit
+	}
+}
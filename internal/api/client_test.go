@@ -6,9 +6,17 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 // TestNewClient verifies that NewClient creates a client with the
@@ -40,6 +48,97 @@ func TestSetBaseURL(t *testing.T) {
 	}
 }
 
+// TestGetSendsUserAgent verifies the default User-Agent, an override set
+// with SetUserAgent, and that an empty override restores the default.
+func TestGetSendsUserAgent(t *testing.T) {
+	var received string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	var result map[string]interface{}
+
+	if err := client.get("/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received != DefaultUserAgent {
+		t.Errorf("expected default User-Agent %q, got %q", DefaultUserAgent, received)
+	}
+
+	client.SetUserAgent("massive-cli/v1.2.3 nightly-job")
+	if err := client.get("/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received != "massive-cli/v1.2.3 nightly-job" {
+		t.Errorf("expected overridden User-Agent, got %q", received)
+	}
+
+	client.SetUserAgent("")
+	if err := client.get("/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received != DefaultUserAgent {
+		t.Errorf("expected empty override to restore %q, got %q", DefaultUserAgent, received)
+	}
+}
+
+// TestGetDecodesGzipResponse verifies that the client asks for gzip,
+// decompresses a gzip-encoded body before decoding it, and asks for
+// identity encoding once compression is disabled.
+func TestGetDecodesGzipResponse(t *testing.T) {
+	var received string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("Accept-Encoding")
+		body := `{"status":"OK","results":[{"ticker":"AAPL"}]}`
+
+		if received != "gzip" {
+			w.Write([]byte(body))
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(body))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	var result struct {
+		Status  string `json:"status"`
+		Results []struct {
+			Ticker string `json:"ticker"`
+		} `json:"results"`
+	}
+	if err := client.get("/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received != "gzip" {
+		t.Errorf("expected Accept-Encoding gzip, got %q", received)
+	}
+	if result.Status != "OK" || len(result.Results) != 1 || result.Results[0].Ticker != "AAPL" {
+		t.Errorf("unexpected decoded result: %+v", result)
+	}
+
+	client.SetCompression(false)
+	result.Results = nil
+	if err := client.get("/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received != "identity" {
+		t.Errorf("expected Accept-Encoding identity, got %q", received)
+	}
+	if len(result.Results) != 1 || result.Results[0].Ticker != "AAPL" {
+		t.Errorf("unexpected decoded result without compression: %+v", result)
+	}
+}
+
 // TestGetAddsAPIKey verifies that the client appends the apiKey query
 // parameter to every outgoing request.
 func TestGetAddsAPIKey(t *testing.T) {
@@ -231,3 +330,587 @@ func TestGetSendsCorrectPath(t *testing.T) {
 		t.Errorf("expected path /v1/open-close/AAPL/2025-01-06, got %s", receivedPath)
 	}
 }
+
+// TestGetRecordsRateLimit verifies that the client records the rate limit
+// headers from each response.
+func TestGetRecordsRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+
+	if client.RateLimit().Known {
+		t.Error("expected rate limit to be unknown before any request")
+	}
+
+	var result map[string]interface{}
+	if err := client.get("/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rl := client.RateLimit()
+	if !rl.Known || rl.Limit != 100 || rl.Remaining != 42 {
+		t.Errorf("expected limit 100 remaining 42, got %+v", rl)
+	}
+}
+
+// TestGetRecordsResultCounts verifies that responses with a results array
+// are tallied as lists, while single-object and null results are ignored.
+func TestGetRecordsResultCounts(t *testing.T) {
+	bodies := map[string]string{
+		"/empty":  `{"status":"OK","results":[]}`,
+		"/two":    `{"status":"OK","results":[{"a":1},{"a":2}]}`,
+		"/object": `{"status":"OK","results":{"a":1}}`,
+		"/null":   `{"status":"OK","results":null}`,
+		"/none":   `{"status":"OK"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(bodies[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+
+	fetch := func(path string) {
+		var result map[string]interface{}
+		if err := client.get(path, nil, &result); err != nil {
+			t.Fatalf("unexpected error for %s: %v", path, err)
+		}
+	}
+
+	for _, path := range []string{"/object", "/null", "/none"} {
+		fetch(path)
+	}
+	if counts := client.ResultCounts(); counts.Lists != 0 || counts.Empty() {
+		t.Errorf("expected no lists recorded, got %+v", counts)
+	}
+
+	fetch("/empty")
+	if !client.ResultCounts().Empty() {
+		t.Errorf("expected empty after a list with no results, got %+v", client.ResultCounts())
+	}
+
+	fetch("/two")
+	counts := client.ResultCounts()
+	if counts.Lists != 2 || counts.Items != 2 || counts.Empty() {
+		t.Errorf("expected 2 lists with 2 items, got %+v", counts)
+	}
+}
+
+// TestGetEmptyBody verifies that a 200 with an empty body and a 204 are
+// successes that leave the result at its zero value, with no results,
+// and count as empty lists for --fail-on-empty.
+func TestGetEmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v3/reference/tickers" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte("  \n"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	bars, err := client.GetBars("AAPL", BarsParams{Multiplier: "1", Timespan: "day", From: "2025-01-01", To: "2025-01-31"})
+	if err != nil {
+		t.Fatalf("unexpected error for an empty 200: %v", err)
+	}
+	if len(bars.Results) != 0 || bars.Status != "" {
+		t.Errorf("expected a zero-value response, got %+v", bars)
+	}
+
+	tickers, err := client.GetTickers(TickerParams{})
+	if err != nil {
+		t.Fatalf("unexpected error for a 204: %v", err)
+	}
+	if len(tickers.Results) != 0 {
+		t.Errorf("expected no results, got %d", len(tickers.Results))
+	}
+
+	if counts := client.ResultCounts(); counts.Lists != 2 || !counts.Empty() {
+		t.Errorf("expected 2 empty lists, got %+v", counts)
+	}
+	if meta := client.LastResponse(); meta.StatusCode != http.StatusNoContent {
+		t.Errorf("expected last status 204, got %d", meta.StatusCode)
+	}
+}
+
+// TestGetRecordsLastResponse verifies that a successful response records
+// its status, request_id, and receipt time, and that a failed one leaves
+// the previous metadata in place.
+func TestGetRecordsLastResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"status":"OK","request_id":"req-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+
+	if meta := client.LastResponse(); !meta.FetchedAt.IsZero() {
+		t.Fatalf("expected no metadata before a request, got %+v", meta)
+	}
+
+	var result map[string]interface{}
+	before := time.Now()
+	if err := client.get("/ok", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	meta := client.LastResponse()
+	if meta.StatusCode != http.StatusOK || meta.RequestID != "req-1" || meta.FetchedAt.Before(before.Add(-time.Second)) {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+
+	if err := client.get("/fail", nil, &result); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := client.LastResponse(); got != meta {
+		t.Errorf("expected metadata to be unchanged after a failure, got %+v", got)
+	}
+}
+
+// TestGetRecordsTimings verifies that every round trip, including failed
+// ones, is counted in Timings with its server latency, and that the last
+// successful response carries its own elapsed and latency times.
+func TestGetRecordsTimings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Millisecond)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+
+	if got := client.Timings(); got.RoundTrips != 0 || got.AverageLatency() != 0 {
+		t.Fatalf("expected no timings before a request, got %+v", got)
+	}
+
+	var result map[string]interface{}
+	if err := client.get("/ok", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.get("/fail", nil, &result); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	timings := client.Timings()
+	if timings.RoundTrips != 2 {
+		t.Errorf("expected 2 round trips, got %d", timings.RoundTrips)
+	}
+	if timings.AverageLatency() < 5*time.Millisecond || timings.Elapsed < timings.ServerLatency {
+		t.Errorf("unexpected timings: %+v", timings)
+	}
+
+	meta := client.LastResponse()
+	if meta.LatencyMS < 5 || meta.ElapsedMS < meta.LatencyMS {
+		t.Errorf("unexpected response timing: %+v", meta)
+	}
+
+	sum := timings.Add(timings)
+	if sum.RoundTrips != 4 || sum.Elapsed != 2*timings.Elapsed {
+		t.Errorf("unexpected sum: %+v", sum)
+	}
+}
+
+// TestGetReturnsAPIError verifies that non-200 responses are returned as
+// an *APIError carrying the status code and Retry-After hint.
+func TestGetReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":"ERROR"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+
+	var result map[string]interface{}
+	err := client.get("/test", nil, &result)
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", apiErr.StatusCode)
+	}
+
+	if apiErr.RetryAfter != 3*time.Second {
+		t.Errorf("expected Retry-After 3s, got %v", apiErr.RetryAfter)
+	}
+}
+
+// TestAPIErrorDetails verifies that an API error records the redacted
+// request URL and exposes the message and request_id from the body.
+func TestAPIErrorDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"status":"NOT_AUTHORIZED","request_id":"req-403","message":"You are not entitled to this data."}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("secret-key")
+	client.SetBaseURL(server.URL)
+
+	var result map[string]interface{}
+	err := client.get("/v2/test", map[string]string{"limit": "5"}, &result)
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.Message() != "You are not entitled to this data." {
+		t.Errorf("unexpected message %q", apiErr.Message())
+	}
+	if apiErr.RequestID() != "req-403" {
+		t.Errorf("expected request_id req-403, got %q", apiErr.RequestID())
+	}
+	if !strings.Contains(apiErr.URL, "/v2/test") || !strings.Contains(apiErr.URL, "limit=5") {
+		t.Errorf("expected URL with path and params, got %s", apiErr.URL)
+	}
+	if strings.Contains(apiErr.URL, "secret-key") {
+		t.Errorf("expected API key to be redacted, got %s", apiErr.URL)
+	}
+}
+
+// TestAPIErrorMessageFallbacks verifies the message is taken from the
+// "error" field, then the status, then the raw body.
+func TestAPIErrorMessageFallbacks(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"status":"ERROR","error":"Unknown API Key"}`, "Unknown API Key"},
+		{`{"status":"NOT_FOUND"}`, "NOT_FOUND"},
+		{"  upstream timeout\n", "upstream timeout"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		apiErr := &APIError{StatusCode: 500, Body: tt.body}
+		if got := apiErr.Message(); got != tt.want {
+			t.Errorf("body %q: expected %q, got %q", tt.body, tt.want, got)
+		}
+	}
+}
+
+// TestGetNextAddsAPIKey verifies that following a next_url keeps its
+// cursor query and adds the API key.
+func TestGetNextAddsAPIKey(t *testing.T) {
+	var receivedKey, receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKey = r.URL.Query().Get("apiKey")
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("next-key")
+	client.SetBaseURL(server.URL)
+
+	var result map[string]interface{}
+	if err := client.getNext(server.URL+"/v3/trades/AAPL?cursor=abc123", &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedKey != "next-key" {
+		t.Errorf("expected apiKey=next-key, got %s", receivedKey)
+	}
+
+	if receivedCursor != "abc123" {
+		t.Errorf("expected cursor=abc123, got %s", receivedCursor)
+	}
+}
+
+// TestResolveNextURL verifies that absolute next_url values on any host and
+// relative ones are both rewritten onto the configured base URL, keeping
+// the base path prefix without doubling it.
+func TestResolveNextURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		nextURL string
+		want    string
+	}{
+		{"http://127.0.0.1:8080", "https://api.massive.com/v3/trades/AAPL?cursor=abc", "http://127.0.0.1:8080/v3/trades/AAPL?cursor=abc"},
+		{"http://127.0.0.1:8080", "/v3/trades/AAPL?cursor=abc", "http://127.0.0.1:8080/v3/trades/AAPL?cursor=abc"},
+		{"https://proxy.example.com/massive/", "https://api.massive.com/v3/trades/AAPL?cursor=abc", "https://proxy.example.com/massive/v3/trades/AAPL?cursor=abc"},
+		{"https://proxy.example.com/massive", "https://proxy.example.com/massive/v3/trades/AAPL?cursor=abc", "https://proxy.example.com/massive/v3/trades/AAPL?cursor=abc"},
+	}
+
+	for _, tt := range tests {
+		client := NewClient("key")
+		client.SetBaseURL(tt.baseURL)
+
+		u, err := client.resolveNextURL(tt.nextURL)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", tt.nextURL, err)
+		}
+
+		if got := u.String(); got != tt.want {
+			t.Errorf("resolveNextURL(%s) with base %s = %s, want %s", tt.nextURL, tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+// TestGetArchivesRawResponse verifies that when an archive directory is
+// set, the raw body and a metadata sidecar with the redacted request URL
+// are written for each successful response.
+func TestGetArchivesRawResponse(t *testing.T) {
+	const body = `{"status":"OK","request_id":"req-archive-1","results":[]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClient("secret-key")
+	client.SetBaseURL(server.URL)
+	client.SetArchiveDir(dir)
+
+	var result map[string]interface{}
+	if err := client.get("/v3/test", map[string]string{"limit": "5"}, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bodies, _ := filepath.Glob(filepath.Join(dir, "*_req-archive-1.json"))
+	if len(bodies) != 1 {
+		t.Fatalf("expected 1 archived body, got %d", len(bodies))
+	}
+
+	data, err := os.ReadFile(bodies[0])
+	if err != nil {
+		t.Fatalf("failed to read archived body: %v", err)
+	}
+
+	if string(data) != body {
+		t.Errorf("expected archived body to match raw response, got %s", data)
+	}
+
+	metaPath := strings.TrimSuffix(bodies[0], ".json") + ".meta.json"
+	metaData, err := os.ReadFile(metaPath)
+	if err != nil {
+		t.Fatalf("failed to read archive metadata: %v", err)
+	}
+
+	var meta archiveMeta
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		t.Fatalf("failed to parse archive metadata: %v", err)
+	}
+
+	if !strings.Contains(meta.URL, "/v3/test") || !strings.Contains(meta.URL, "limit=5") {
+		t.Errorf("expected metadata URL to include path and params, got %s", meta.URL)
+	}
+
+	if strings.Contains(meta.URL, "secret-key") {
+		t.Errorf("expected API key to be redacted, got %s", meta.URL)
+	}
+
+	if meta.RequestID != "req-archive-1" || meta.StatusCode != http.StatusOK {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+}
+
+// TestGetArchivesOnlyFinalSuccess simulates a caller retrying through a
+// 500 and a truncated 200 before a good response, and verifies that
+// exactly one archived body and sidecar are produced, with no temporary
+// files left behind.
+func TestGetArchivesOnlyFinalSuccess(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status":"ERROR"}`))
+		case 2:
+			w.Write([]byte(`{"status":"OK","request_id":"req-retry"`))
+		default:
+			w.Write([]byte(`{"status":"OK","request_id":"req-retry","results":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+	client.SetArchiveDir(dir)
+
+	var result map[string]interface{}
+	for attempt := 1; attempt <= 3; attempt++ {
+		err := client.get("/v3/test", nil, &result)
+		if attempt < 3 && err == nil {
+			t.Fatalf("attempt %d: expected error, got nil", attempt)
+		}
+		if attempt == 3 && err != nil {
+			t.Fatalf("attempt %d: unexpected error: %v", attempt, err)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read archive directory: %v", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	if len(names) != 2 {
+		t.Fatalf("expected one body and one sidecar, got %v", names)
+	}
+	for _, name := range names {
+		if !strings.HasSuffix(name, "_req-retry.json") && !strings.HasSuffix(name, "_req-retry.meta.json") {
+			t.Errorf("unexpected archive file %s", name)
+		}
+	}
+}
+
+// TestGetSkipsArchiveOnError verifies that failed responses are not
+// written to the archive directory.
+func TestGetSkipsArchiveOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":"NOT_FOUND"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClient("key")
+	client.SetBaseURL(server.URL)
+	client.SetArchiveDir(dir)
+
+	var result map[string]interface{}
+	if err := client.get("/v3/test", nil, &result); err == nil {
+		t.Fatal("expected error for 404 response, got nil")
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("expected no archived files, got %d", len(entries))
+	}
+}
+
+// TestBuildURL verifies that BuildURL includes the path, the API key, and
+// every non-empty parameter while dropping empty ones.
+func TestBuildURL(t *testing.T) {
+	client := NewClient("key")
+	client.SetBaseURL("https://example.test")
+
+	u, err := client.BuildURL("/v3/trades/AAPL", map[string]string{
+		"timestamp.gte": "2025-01-06",
+		"limit":         "",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if u.Path != "/v3/trades/AAPL" {
+		t.Errorf("expected path /v3/trades/AAPL, got %s", u.Path)
+	}
+
+	q := u.Query()
+	if q.Get("apiKey") != "key" {
+		t.Errorf("expected apiKey=key, got %s", q.Get("apiKey"))
+	}
+
+	if q.Get("timestamp.gte") != "2025-01-06" {
+		t.Errorf("expected timestamp.gte=2025-01-06, got %s", q.Get("timestamp.gte"))
+	}
+
+	if q.Has("limit") {
+		t.Error("expected empty limit param to be omitted")
+	}
+}
+
+// TestGetPrintRequest verifies that in print-request mode the client writes
+// the redacted URL, returns ErrRequestPrinted, and never contacts the server.
+func TestGetPrintRequest(t *testing.T) {
+	hit := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient("secret-key")
+	client.SetBaseURL(server.URL)
+	client.SetPrintRequest(&buf)
+
+	var result map[string]interface{}
+	err := client.get("/v3/test", map[string]string{"limit": "5"}, &result)
+	if !errors.Is(err, ErrRequestPrinted) {
+		t.Fatalf("expected ErrRequestPrinted, got %v", err)
+	}
+
+	if hit {
+		t.Error("expected no request to reach the server")
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "/v3/test") || !strings.Contains(out, "limit=5") {
+		t.Errorf("expected printed URL to include path and params, got %s", out)
+	}
+
+	if strings.Contains(out, "secret-key") || !strings.Contains(out, "apiKey=REDACTED") {
+		t.Errorf("expected API key to be redacted, got %s", out)
+	}
+}
+
+// TestGetDryRun verifies that in dry-run mode the client confirms the
+// request with an "OK GET" line, returns ErrRequestPrinted, and never
+// contacts the server.
+func TestGetDryRun(t *testing.T) {
+	hit := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient("secret-key")
+	client.SetBaseURL(server.URL)
+	client.SetDryRun(&buf)
+
+	var result map[string]interface{}
+	err := client.get("/v3/test", map[string]string{"limit": "5"}, &result)
+	if !errors.Is(err, ErrRequestPrinted) {
+		t.Fatalf("expected ErrRequestPrinted, got %v", err)
+	}
+
+	if hit {
+		t.Error("expected no request to reach the server")
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "OK GET "+server.URL+"/v3/test?") || !strings.Contains(out, "limit=5") {
+		t.Errorf("expected OK line with the resolved URL, got %s", out)
+	}
+
+	if strings.Contains(out, "secret-key") {
+		t.Errorf("expected API key to be redacted, got %s", out)
+	}
+}
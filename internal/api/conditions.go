@@ -0,0 +1,59 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "sort"
+
+// GetConditions retrieves the condition codes from the
+// /v3/reference/conditions endpoint, filtered by asset class and data
+// type (trade, quote) when set. The page size is raised to the endpoint's
+// maximum so one request returns the whole reference list.
+func (c *Client) GetConditions(p ConditionsParams) (*ConditionsResponse, error) {
+	path := "/v3/reference/conditions"
+
+	params := map[string]string{
+		"asset_class": p.AssetClass,
+		"data_type":   p.DataType,
+		"limit":       "1000",
+	}
+
+	var result ConditionsResponse
+	if err := c.get(path, params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Names maps each condition code ID in the response to its name. When an
+// ID appears more than once (the same code listed for several data types)
+// the first name wins.
+func (r *ConditionsResponse) Names() map[int]string {
+	names := make(map[int]string, len(r.Results))
+	for _, c := range r.Results {
+		if _, ok := names[c.ID]; !ok {
+			names[c.ID] = c.Name
+		}
+	}
+	return names
+}
+
+// DistinctConditions returns every condition code that appears in lists,
+// once each and in ascending order.
+func DistinctConditions(lists [][]int) []int {
+	seen := make(map[int]bool)
+	var codes []int
+	for _, list := range lists {
+		for _, code := range list {
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Ints(codes)
+	return codes
+}
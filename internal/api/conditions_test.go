@@ -0,0 +1,86 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestGetConditions verifies the filters and page size are sent and the
+// response decodes into a code to name map.
+func TestGetConditions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/v3/reference/conditions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if q.Get("asset_class") != "stocks" || q.Get("data_type") != "trade" || q.Get("limit") != "1000" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"status":"OK","count":3,"results":[
+			{"id":12,"name":"Form T","type":"sale_condition"},
+			{"id":37,"name":"Odd Lot Trade","type":"sale_condition"},
+			{"id":12,"name":"Form T Quote","type":"quote_condition"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetConditions(ConditionsParams{AssetClass: "stocks", DataType: "trade"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[int]string{12: "Form T", 37: "Odd Lot Trade"}
+	if got := result.Names(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+}
+
+// TestGetConditionsSIPMapping verifies stocks conditions decode with
+// their per-SIP symbol mapping, which the API sends as an object.
+func TestGetConditionsSIPMapping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"OK","count":1,"results":[
+			{"id":2,"name":"Average Price Trade","asset_class":"stocks","data_types":["trade"],
+			 "sip_mapping":{"CTA":"B","UTP":"W"},"update_rules":{"consolidated":{"updates_high_low":false}}}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetConditions(ConditionsParams{AssetClass: "stocks"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{"CTA": "B", "UTP": "W"}
+	if got := result.Results[0].SIPMapping; !reflect.DeepEqual(got, want) {
+		t.Errorf("SIPMapping = %v, want %v", got, want)
+	}
+}
+
+// TestDistinctConditions verifies codes are de-duplicated and sorted.
+func TestDistinctConditions(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists [][]int
+		want  []int
+	}{
+		{"none", nil, nil},
+		{"empty lists", [][]int{nil, {}}, nil},
+		{"merged", [][]int{{37, 12}, {2}, {12, 37, 41}}, []int{2, 12, 37, 41}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistinctConditions(tt.lists); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DistinctConditions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -51,9 +51,31 @@ type CryptoSnapshotLastTrade struct {
 	Timestamp  int64   `json:"timestamp"`
 }
 
+// IsZero reports whether the last trade carries no data, which is how a
+// "lastTrade" object omitted from the response decodes.
+func (t CryptoSnapshotLastTrade) IsZero() bool {
+	return t.Price == 0 && t.Size == 0 && t.Timestamp == 0 && t.Exchange == 0 && len(t.Conditions) == 0
+}
+
+// CryptoSnapshotLastQuote holds the best bid and ask in a crypto snapshot,
+// with the exchange and timestamp of the quote.
+type CryptoSnapshotLastQuote struct {
+	Ask       float64 `json:"a"`
+	Bid       float64 `json:"b"`
+	Exchange  int     `json:"x"`
+	Timestamp int64   `json:"t"`
+}
+
+// IsZero reports whether the quote carries no data, which is how a
+// "lastQuote" object omitted from the response decodes.
+func (q CryptoSnapshotLastQuote) IsZero() bool {
+	return q == CryptoSnapshotLastQuote{}
+}
+
 // CryptoSnapshotTicker represents a single ticker's snapshot data in the
 // crypto market. It contains the current day's bar, previous day's bar,
-// latest minute bar, the last trade, fair market value, and change values.
+// latest minute bar, the last trade and quote, fair market value, and
+// change values.
 type CryptoSnapshotTicker struct {
 	Ticker          string                  `json:"ticker"`
 	TodaysChange    float64                 `json:"todaysChange"`
@@ -63,6 +85,7 @@ type CryptoSnapshotTicker struct {
 	PrevDay         SnapshotBar             `json:"prevDay"`
 	Min             SnapshotMinBar          `json:"min"`
 	LastTrade       CryptoSnapshotLastTrade `json:"lastTrade"`
+	LastQuote       CryptoSnapshotLastQuote `json:"lastQuote"`
 	FMV             float64                 `json:"fmv"`
 }
 
@@ -88,6 +111,49 @@ type CryptoSnapshotParams struct {
 	Tickers string
 }
 
+// -------------------------------------------------------------------
+// Crypto Order Book Types
+// -------------------------------------------------------------------
+
+// CryptoBookLevel represents a single price level in a crypto L2 order
+// book. Exchanges maps each exchange ID to the size it shows at this
+// price; Size returns the aggregated total across all exchanges.
+type CryptoBookLevel struct {
+	Price     float64            `json:"p"`
+	Exchanges map[string]float64 `json:"x"`
+}
+
+// Size returns the total quantity available at this price level summed
+// across every exchange quoting it.
+func (l CryptoBookLevel) Size() float64 {
+	var total float64
+	for _, size := range l.Exchanges {
+		total += size
+	}
+	return total
+}
+
+// CryptoOrderBook represents the aggregated L2 order book for a crypto
+// pair with bids sorted best (highest) first and asks sorted best
+// (lowest) first, along with level counts and the current spread.
+type CryptoOrderBook struct {
+	Ticker   string            `json:"ticker"`
+	Bids     []CryptoBookLevel `json:"bids"`
+	Asks     []CryptoBookLevel `json:"asks"`
+	BidCount float64           `json:"bidCount"`
+	AskCount float64           `json:"askCount"`
+	Spread   float64           `json:"spread"`
+	Updated  int64             `json:"updated"`
+}
+
+// CryptoOrderBookResponse represents the API response for the crypto L2
+// book snapshot endpoint. The book is nested under the "data" key.
+type CryptoOrderBookResponse struct {
+	Status    string          `json:"status"`
+	RequestID string          `json:"request_id"`
+	Data      CryptoOrderBook `json:"data"`
+}
+
 // -------------------------------------------------------------------
 // Crypto Unified Snapshot Types
 // -------------------------------------------------------------------
@@ -172,6 +238,7 @@ type CryptoTradesParams struct {
 	Order        string
 	Limit        string
 	Sort         string
+	Cursor       string
 }
 
 // -------------------------------------------------------------------
@@ -205,16 +272,16 @@ type CryptoLastTradeResponse struct {
 // ConditionCode represents a single condition code with its ID, type,
 // name, asset class, and the data types it applies to.
 type ConditionCode struct {
-	ID            int      `json:"id"`
-	Type          string   `json:"type"`
-	Name          string   `json:"name"`
-	AssetClass    string   `json:"asset_class"`
-	DataTypes     []string `json:"data_types"`
-	Legacy        bool     `json:"legacy"`
-	Abbreviation  string   `json:"abbreviation,omitempty"`
-	Description   string   `json:"description,omitempty"`
-	ExchangeID    int      `json:"exchange_id,omitempty"`
-	SIPMapping    string   `json:"sip_mapping,omitempty"`
+	ID           int               `json:"id"`
+	Type         string            `json:"type"`
+	Name         string            `json:"name"`
+	AssetClass   string            `json:"asset_class"`
+	DataTypes    []string          `json:"data_types"`
+	Legacy       bool              `json:"legacy"`
+	Abbreviation string            `json:"abbreviation,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	ExchangeID   int               `json:"exchange_id,omitempty"`
+	SIPMapping   map[string]string `json:"sip_mapping,omitempty"`
 }
 
 // ConditionsResponse represents the API response for the reference
@@ -269,6 +336,7 @@ type CryptoTickerOverviewResponse struct {
 type CryptoTickersParams struct {
 	Search string
 	Active string
+	Date   string
 	Limit  string
 	Sort   string
 	Order  string
@@ -300,10 +368,15 @@ func (c *Client) GetCryptoBars(ticker string, p BarsParams) (*BarsResponse, erro
 }
 
 // GetCryptoDailyMarketSummary retrieves the grouped daily OHLC summary
-// for all crypto tickers on the specified date. This reuses the
+// for all crypto tickers on the specified date. An empty locale uses
+// global, the only locale crypto is grouped under. This reuses the
 // MarketSummaryResponse type from stocks.
-func (c *Client) GetCryptoDailyMarketSummary(date string, adjusted string) (*MarketSummaryResponse, error) {
-	path := fmt.Sprintf("/v2/aggs/grouped/locale/global/market/crypto/%s", date)
+func (c *Client) GetCryptoDailyMarketSummary(date string, adjusted string, locale string) (*MarketSummaryResponse, error) {
+	locale, err := groupedLocale("crypto", locale)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v2/aggs/grouped/locale/%s/market/crypto/%s", locale, date)
 
 	params := map[string]string{
 		"adjusted": adjusted,
@@ -359,18 +432,7 @@ func (c *Client) GetCryptoPreviousDayBar(ticker string, adjusted string) (*BarsR
 // GetCryptoConditions retrieves the list of condition codes for the
 // crypto asset class from the /v3/reference/conditions endpoint.
 func (c *Client) GetCryptoConditions() (*ConditionsResponse, error) {
-	path := "/v3/reference/conditions"
-
-	params := map[string]string{
-		"asset_class": "crypto",
-	}
-
-	var result ConditionsResponse
-	if err := c.get(path, params, &result); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return c.GetConditions(ConditionsParams{AssetClass: "crypto"})
 }
 
 // GetCryptoExchanges retrieves the list of known exchanges for the
@@ -427,6 +489,20 @@ func (c *Client) GetCryptoSnapshotSingleTicker(ticker string) (*CryptoSingleSnap
 	return &result, nil
 }
 
+// GetCryptoOrderBook retrieves the current L2 order book snapshot for a
+// crypto pair, including every bid and ask price level with the size
+// quoted by each exchange.
+func (c *Client) GetCryptoOrderBook(ticker string) (*CryptoOrderBookResponse, error) {
+	path := fmt.Sprintf("/v2/snapshot/locale/global/markets/crypto/tickers/%s/book", ticker)
+
+	var result CryptoOrderBookResponse
+	if err := c.get(path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetCryptoSnapshotTopMovers retrieves the current top crypto gainers or
 // losers. The direction parameter must be either "gainers" or "losers".
 func (c *Client) GetCryptoSnapshotTopMovers(direction string) (*CryptoSnapshotResponse, error) {
@@ -532,10 +608,19 @@ func (c *Client) GetCryptoMACD(ticker string, p MACDParams) (*MACDResponse, erro
 func (c *Client) GetCryptoTickers(p CryptoTickersParams) (*TickersResponse, error) {
 	path := "/v3/reference/tickers"
 
+	if err := ValidateTickerFilters("crypto", p.Date, false); err != nil {
+		return nil, err
+	}
+	active, err := activeFilter(p.Active)
+	if err != nil {
+		return nil, err
+	}
+
 	params := map[string]string{
 		"market": "crypto",
 		"search": p.Search,
-		"active": p.Active,
+		"active": active,
+		"date":   p.Date,
 		"limit":  p.Limit,
 		"sort":   p.Sort,
 		"order":  p.Order,
@@ -580,6 +665,11 @@ func (c *Client) GetCryptoTrades(ticker string, p CryptoTradesParams) (*CryptoTr
 		"order":         p.Order,
 		"limit":         p.Limit,
 		"sort":          p.Sort,
+		"cursor":        p.Cursor,
+	}
+
+	if err := normalizeTimestampParams(params); err != nil {
+		return nil, err
 	}
 
 	var result CryptoTradesResponse
@@ -590,6 +680,17 @@ func (c *Client) GetCryptoTrades(ticker string, p CryptoTradesParams) (*CryptoTr
 	return &result, nil
 }
 
+// GetCryptoTradesNext retrieves the next page of crypto trades by following
+// the next_url returned in a previous CryptoTradesResponse.
+func (c *Client) GetCryptoTradesNext(nextURL string) (*CryptoTradesResponse, error) {
+	var result CryptoTradesResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetCryptoLastTrade retrieves the most recent trade for a specific
 // crypto pair (from/to currencies) from the /v1/last/crypto endpoint.
 func (c *Client) GetCryptoLastTrade(from, to string) (*CryptoLastTradeResponse, error) {
@@ -266,6 +266,20 @@ const cryptoSnapshotFullMarketJSON = `{
 	]
 }`
 
+const cryptoSnapshotSparseJSON = `{
+	"status": "OK",
+	"request_id": "crypto-snap-sparse-123",
+	"ticker": {
+		"ticker": "X:ILLQUSD",
+		"todaysChange": 0,
+		"todaysChangePerc": 0,
+		"updated": 1736225999000,
+		"day": {"o": 0.012, "h": 0.013, "l": 0.011, "c": 0.012, "v": 50, "vw": 0.012},
+		"prevDay": {"o": 0.011, "h": 0.012, "l": 0.011, "c": 0.012, "v": 40, "vw": 0.0115},
+		"fmv": 0.012
+	}
+}`
+
 const cryptoSnapshotSingleTickerJSON = `{
 	"status": "OK",
 	"request_id": "crypto-snap-single-123",
@@ -308,6 +322,12 @@ const cryptoSnapshotSingleTickerJSON = `{
 			"size": 0.5,
 			"timestamp": 1736225999000
 		},
+		"lastQuote": {
+			"a": 43501.00,
+			"b": 43499.00,
+			"x": 1,
+			"t": 1736225998000
+		},
 		"fmv": 43495.00
 	}
 }`
@@ -551,6 +571,26 @@ const cryptoLastTradeJSON = `{
 	}
 }`
 
+const cryptoOrderBookJSON = `{
+	"status": "OK",
+	"request_id": "crypto-book-123",
+	"data": {
+		"ticker": "X:BTCUSD",
+		"bids": [
+			{"p": 97250.5, "x": {"1": 0.5, "2": 1.25}},
+			{"p": 97250.0, "x": {"1": 2.0}}
+		],
+		"asks": [
+			{"p": 97251.0, "x": {"2": 0.75}},
+			{"p": 97252.5, "x": {"1": 1.0, "3": 0.5}}
+		],
+		"bidCount": 3.75,
+		"askCount": 2.25,
+		"spread": 0.5,
+		"updated": 1736139600000000000
+	}
+}`
+
 // -------------------------------------------------------------------
 // Aggregates Tests
 // -------------------------------------------------------------------
@@ -762,7 +802,7 @@ func TestGetCryptoDailyMarketSummary(t *testing.T) {
 	defer server.Close()
 
 	client := newTestClient(server.URL)
-	result, err := client.GetCryptoDailyMarketSummary("2025-01-06", "true")
+	result, err := client.GetCryptoDailyMarketSummary("2025-01-06", "true", "")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -819,7 +859,7 @@ func TestGetCryptoDailyMarketSummaryRequestPath(t *testing.T) {
 	defer server.Close()
 
 	client := newTestClient(server.URL)
-	client.GetCryptoDailyMarketSummary("2025-06-15", "true")
+	client.GetCryptoDailyMarketSummary("2025-06-15", "true", "")
 
 	expected := "/v2/aggs/grouped/locale/global/market/crypto/2025-06-15"
 	if receivedPath != expected {
@@ -841,7 +881,7 @@ func TestGetCryptoDailyMarketSummaryQueryParams(t *testing.T) {
 	defer server.Close()
 
 	client := newTestClient(server.URL)
-	client.GetCryptoDailyMarketSummary("2025-01-06", "false")
+	client.GetCryptoDailyMarketSummary("2025-01-06", "false", "")
 }
 
 // TestGetCryptoDailyTickerSummary verifies that GetCryptoDailyTickerSummary
@@ -1350,11 +1390,68 @@ func TestGetCryptoSnapshotSingleTicker(t *testing.T) {
 		t.Errorf("expected fmv 43495.00, got %f", result.Ticker.FMV)
 	}
 
+	if result.Ticker.LastQuote.Ask != 43501.00 || result.Ticker.LastQuote.Bid != 43499.00 {
+		t.Errorf("expected lastQuote 43499.00/43501.00, got %+v", result.Ticker.LastQuote)
+	}
+
 	if result.Ticker.Min.NumTransactions != 25 {
 		t.Errorf("expected min numTransactions 25, got %d", result.Ticker.Min.NumTransactions)
 	}
 }
 
+// TestGetCryptoSnapshotMissingNestedObjects verifies that a snapshot for an
+// illiquid ticker with no "min" or "lastTrade" objects decodes cleanly and
+// reports those sections as zero so renderers can show "-".
+func TestGetCryptoSnapshotMissingNestedObjects(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v2/snapshot/locale/global/markets/crypto/tickers/X:ILLQUSD": cryptoSnapshotSparseJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetCryptoSnapshotSingleTicker("X:ILLQUSD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tk := result.Ticker
+	if !tk.Min.IsZero() {
+		t.Errorf("expected missing min bar to be zero, got %+v", tk.Min)
+	}
+
+	if !tk.LastTrade.IsZero() {
+		t.Errorf("expected missing last trade to be zero, got %+v", tk.LastTrade)
+	}
+
+	if !tk.LastQuote.IsZero() {
+		t.Errorf("expected missing last quote to be zero, got %+v", tk.LastQuote)
+	}
+
+	if tk.Day.IsZero() {
+		t.Error("expected day bar to be present")
+	}
+}
+
+// TestCryptoSnapshotIsZeroWithData verifies that fully populated nested
+// snapshot objects are not reported as zero.
+func TestCryptoSnapshotIsZeroWithData(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v2/snapshot/locale/global/markets/crypto/tickers/X:BTCUSD": cryptoSnapshotSingleTickerJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetCryptoSnapshotSingleTicker("X:BTCUSD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tk := result.Ticker
+	if tk.Day.IsZero() || tk.PrevDay.IsZero() || tk.Min.IsZero() || tk.LastTrade.IsZero() || tk.LastQuote.IsZero() {
+		t.Errorf("expected all nested objects to be present, got %+v", tk)
+	}
+}
+
 // TestGetCryptoSnapshotSingleTickerRequestPath verifies the correct
 // API path is constructed with the crypto ticker.
 func TestGetCryptoSnapshotSingleTickerRequestPath(t *testing.T) {
@@ -1376,6 +1473,86 @@ func TestGetCryptoSnapshotSingleTickerRequestPath(t *testing.T) {
 	}
 }
 
+// TestGetCryptoOrderBook verifies that GetCryptoOrderBook parses the bid
+// and ask levels with their per-exchange sizes from the nested data object.
+func TestGetCryptoOrderBook(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v2/snapshot/locale/global/markets/crypto/tickers/X:BTCUSD/book": cryptoOrderBookJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetCryptoOrderBook("X:BTCUSD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	book := result.Data
+	if book.Ticker != "X:BTCUSD" {
+		t.Errorf("expected ticker X:BTCUSD, got %s", book.Ticker)
+	}
+
+	if len(book.Bids) != 2 || len(book.Asks) != 2 {
+		t.Fatalf("expected 2 bids and 2 asks, got %d and %d", len(book.Bids), len(book.Asks))
+	}
+
+	if book.Bids[0].Price != 97250.5 {
+		t.Errorf("expected best bid 97250.5, got %f", book.Bids[0].Price)
+	}
+
+	if book.Bids[0].Exchanges["2"] != 1.25 {
+		t.Errorf("expected exchange 2 size 1.25, got %f", book.Bids[0].Exchanges["2"])
+	}
+
+	if book.Spread != 0.5 {
+		t.Errorf("expected spread 0.5, got %f", book.Spread)
+	}
+
+	if book.Updated != 1736139600000000000 {
+		t.Errorf("expected updated 1736139600000000000, got %d", book.Updated)
+	}
+}
+
+// TestCryptoBookLevelSize verifies that Size sums the quantities quoted
+// by every exchange at a price level.
+func TestCryptoBookLevelSize(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v2/snapshot/locale/global/markets/crypto/tickers/X:BTCUSD/book": cryptoOrderBookJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetCryptoOrderBook("X:BTCUSD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if size := result.Data.Bids[0].Size(); size != 1.75 {
+		t.Errorf("expected aggregated bid size 1.75, got %f", size)
+	}
+
+	if size := result.Data.Asks[1].Size(); size != 1.5 {
+		t.Errorf("expected aggregated ask size 1.5, got %f", size)
+	}
+
+	if size := (CryptoBookLevel{}).Size(); size != 0 {
+		t.Errorf("expected empty level size 0, got %f", size)
+	}
+}
+
+// TestGetCryptoOrderBookAPIError verifies that GetCryptoOrderBook returns
+// an error when the API responds with a non-200 status code.
+func TestGetCryptoOrderBookAPIError(t *testing.T) {
+	server := mockServer(t, map[string]string{})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.GetCryptoOrderBook("X:BTCUSD")
+	if err == nil {
+		t.Fatal("expected error for 404 response, got nil")
+	}
+}
+
 // TestGetCryptoSnapshotTopMoversGainers verifies that GetCryptoSnapshotTopMovers
 // correctly retrieves and parses the top gainers snapshot.
 func TestGetCryptoSnapshotTopMoversGainers(t *testing.T) {
@@ -2194,6 +2371,34 @@ func TestGetCryptoTradesAPIError(t *testing.T) {
 	}
 }
 
+// TestGetCryptoTradesNext verifies that GetCryptoTradesNext follows the
+// cursor in a next_url and decodes the page of trades.
+func TestGetCryptoTradesNext(t *testing.T) {
+	var receivedPath, receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(cryptoTradesJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetCryptoTradesNext("https://api.massive.com/v3/trades/X:BTCUSD?cursor=page2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedPath != "/v3/trades/X:BTCUSD" || receivedCursor != "page2" {
+		t.Errorf("expected /v3/trades/X:BTCUSD with cursor=page2, got %s cursor=%s", receivedPath, receivedCursor)
+	}
+
+	if len(result.Results) != 2 {
+		t.Errorf("expected 2 trades, got %d", len(result.Results))
+	}
+}
+
 // TestGetCryptoLastTrade verifies that GetCryptoLastTrade correctly
 // parses the most recent trade response for a crypto pair.
 func TestGetCryptoLastTrade(t *testing.T) {
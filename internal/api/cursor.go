@@ -0,0 +1,27 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/url"
+)
+
+// NextCursor extracts the cursor query parameter from a next_url so a
+// later request can resume pagination by passing it as the Cursor field
+// of the matching params struct. It returns an empty string when nextURL
+// is empty, unparsable, or carries no cursor.
+func NextCursor(nextURL string) string {
+	if nextURL == "" {
+		return ""
+	}
+
+	u, err := url.Parse(nextURL)
+	if err != nil {
+		return ""
+	}
+
+	return u.Query().Get("cursor")
+}
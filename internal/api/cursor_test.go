@@ -0,0 +1,94 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNextCursor verifies cursor extraction from absolute and relative
+// next_url values and the empty cases.
+func TestNextCursor(t *testing.T) {
+	tests := []struct {
+		nextURL string
+		want    string
+	}{
+		{"https://api.massive.com/v3/trades/AAPL?cursor=YWJjMTIz", "YWJjMTIz"},
+		{"/futures/vX/trades/ESM5?cursor=abc%3D%3D&limit=10", "abc=="},
+		{"https://api.massive.com/v3/trades/AAPL", ""},
+		{"", ""},
+		{"://bad", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NextCursor(tt.nextURL); got != tt.want {
+			t.Errorf("NextCursor(%q): expected %q, got %q", tt.nextURL, tt.want, got)
+		}
+	}
+}
+
+// TestCursorParamForwarded verifies that every trade and quote method
+// sends its Cursor field as the cursor query parameter.
+func TestCursorParamForwarded(t *testing.T) {
+	var received string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK","results":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	calls := map[string]func() error{
+		"GetTrades": func() error {
+			_, err := client.GetTrades("AAPL", TradesParams{Cursor: "c1"})
+			return err
+		},
+		"GetQuotes": func() error {
+			_, err := client.GetQuotes("AAPL", QuotesParams{Cursor: "c1"})
+			return err
+		},
+		"GetCryptoTrades": func() error {
+			_, err := client.GetCryptoTrades("X:BTCUSD", CryptoTradesParams{Cursor: "c1"})
+			return err
+		},
+		"GetForexQuotes": func() error {
+			_, err := client.GetForexQuotes("C:EURUSD", ForexQuotesParams{Cursor: "c1"})
+			return err
+		},
+		"GetOptionsTrades": func() error {
+			_, err := client.GetOptionsTrades("O:SPY251219C00650000", OptionsTradesParams{Cursor: "c1"})
+			return err
+		},
+		"GetOptionsQuotes": func() error {
+			_, err := client.GetOptionsQuotes("O:SPY251219C00650000", OptionsQuotesParams{Cursor: "c1"})
+			return err
+		},
+		"GetFuturesTrades": func() error {
+			_, err := client.GetFuturesTrades("ESM5", FuturesTradesParams{Cursor: "c1"})
+			return err
+		},
+		"GetFuturesQuotes": func() error {
+			_, err := client.GetFuturesQuotes("ESM5", FuturesQuotesParams{Cursor: "c1"})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		received = ""
+		if err := call(); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if received != "c1" {
+			t.Errorf("%s: expected cursor=c1, got %q", name, received)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLowWatermark = 5
+	defaultBackoff      = 500 * time.Millisecond
+	defaultMaxRetries   = 5
+)
+
+// AdaptiveFetcher runs batches of API requests concurrently while watching
+// the X-RateLimit-Remaining header reported by the client. Concurrency is
+// halved whenever the remaining budget drops to the low watermark or the
+// API answers 429, and grows back one step at a time while the budget is
+// healthy. Failed requests the client considers retryable (rate limits
+// and server errors by default) are retried with exponential backoff.
+type AdaptiveFetcher struct {
+	client         *Client
+	minConcurrency int
+	maxConcurrency int
+	lowWatermark   int
+	backoff        time.Duration
+	maxRetries     int
+
+	mu        sync.Mutex
+	cond      *sync.Cond
+	limit     int
+	active    int
+	throttled int
+}
+
+// NewAdaptiveFetcher creates a fetcher for the given client that starts at
+// maxConcurrency parallel requests and never drops below one.
+func NewAdaptiveFetcher(client *Client, maxConcurrency int) *AdaptiveFetcher {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	f := &AdaptiveFetcher{
+		client:         client,
+		minConcurrency: 1,
+		maxConcurrency: maxConcurrency,
+		lowWatermark:   defaultLowWatermark,
+		backoff:        defaultBackoff,
+		maxRetries:     defaultMaxRetries,
+		limit:          maxConcurrency,
+	}
+	f.cond = sync.NewCond(&f.mu)
+
+	return f
+}
+
+// SetLowWatermark sets the remaining-request threshold at or below which
+// the fetcher reduces its concurrency.
+func (f *AdaptiveFetcher) SetLowWatermark(n int) {
+	f.lowWatermark = n
+}
+
+// SetBackoff sets the base delay used before retrying a rate-limited
+// request. The delay doubles on each consecutive retry. Used by tests to
+// keep retries fast.
+func (f *AdaptiveFetcher) SetBackoff(d time.Duration) {
+	f.backoff = d
+}
+
+// Concurrency returns the current number of requests the fetcher allows
+// to run in parallel.
+func (f *AdaptiveFetcher) Concurrency() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.limit
+}
+
+// Throttled returns how many times a request was rejected with HTTP 429
+// and retried.
+func (f *AdaptiveFetcher) Throttled() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.throttled
+}
+
+// Run calls fn for each index in [0, n) with adaptive concurrency. Each
+// call goes through Do, so rate-limited requests are retried. Returns the
+// error from the lowest failing index, or nil if every call succeeds.
+func (f *AdaptiveFetcher) Run(n int, fn func(i int) error) error {
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			f.acquire()
+			defer f.release()
+			errs[i] = f.Do(func() error { return fn(i) })
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Do calls fn, retrying with exponential backoff while it fails with an
+// error the client's IsRetryable policy accepts (by default HTTP 429 and
+// 5xx), up to the retry limit. A Retry-After hint from the API takes
+// precedence over the computed delay. Only 429s count as throttling and
+// halve the concurrency limit. After a successful call the concurrency
+// limit is adjusted from the client's rate limit state.
+func (f *AdaptiveFetcher) Do(fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+
+		if attempt < f.maxRetries && f.client.retryable(err) {
+			delay := f.backoff << attempt
+			var apiErr *APIError
+			if errors.As(err, &apiErr) {
+				if apiErr.StatusCode == http.StatusTooManyRequests {
+					f.throttle()
+				}
+				if apiErr.RetryAfter > 0 {
+					delay = apiErr.RetryAfter
+				}
+			}
+			time.Sleep(delay)
+			continue
+		}
+
+		if err == nil {
+			f.adjust()
+		}
+
+		return err
+	}
+}
+
+// acquire blocks until a concurrency slot is available under the current
+// limit and then claims it.
+func (f *AdaptiveFetcher) acquire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for f.active >= f.limit {
+		f.cond.Wait()
+	}
+	f.active++
+}
+
+// release frees a concurrency slot and wakes any waiting requests.
+func (f *AdaptiveFetcher) release() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.active--
+	f.cond.Broadcast()
+}
+
+// throttle records a 429 response and halves the concurrency limit.
+func (f *AdaptiveFetcher) throttle() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.throttled++
+	f.limit = max(f.minConcurrency, f.limit/2)
+}
+
+// adjust tunes the concurrency limit from the last observed rate limit:
+// halving it when the remaining budget is at or below the low watermark
+// and raising it by one when the budget is comfortably above it.
+func (f *AdaptiveFetcher) adjust() {
+	rl := f.client.RateLimit()
+	if !rl.Known {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case rl.Remaining <= f.lowWatermark:
+		f.limit = max(f.minConcurrency, f.limit/2)
+	case rl.Remaining > f.lowWatermark*2 && f.limit < f.maxConcurrency:
+		f.limit++
+		f.cond.Broadcast()
+	}
+}
@@ -0,0 +1,260 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRateLimitedServer starts a stub server that allows budget requests per
+// refill interval. Each request decrements the remaining counter reported
+// in X-RateLimit-Remaining, and requests made once it reaches zero receive
+// a 429 until the next refill.
+func newRateLimitedServer(t *testing.T, budget int, refill time.Duration, rejected *int32) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	remaining := budget
+	lastRefill := time.Now()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if time.Since(lastRefill) >= refill {
+			remaining = budget
+			lastRefill = time.Now()
+		}
+
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(budget))
+		if remaining == 0 {
+			mu.Unlock()
+			atomic.AddInt32(rejected, 1)
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"status":"ERROR","error":"rate limit exceeded"}`))
+			return
+		}
+		remaining--
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+}
+
+// TestAdaptiveFetcherBacksOff verifies that the fetcher completes every job
+// against a rate-limited server by reducing concurrency and retrying 429s.
+func TestAdaptiveFetcherBacksOff(t *testing.T) {
+	var rejected int32
+	server := newRateLimitedServer(t, 4, 20*time.Millisecond, &rejected)
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	fetcher := NewAdaptiveFetcher(client, 8)
+	fetcher.SetLowWatermark(2)
+	fetcher.SetBackoff(5 * time.Millisecond)
+
+	var completed int32
+	err := fetcher.Run(20, func(i int) error {
+		var result map[string]interface{}
+		if err := client.get("/test", nil, &result); err != nil {
+			return err
+		}
+		atomic.AddInt32(&completed, 1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if completed != 20 {
+		t.Errorf("expected 20 completed jobs, got %d", completed)
+	}
+
+	if fetcher.Concurrency() >= 8 {
+		t.Errorf("expected concurrency to drop below 8, got %d", fetcher.Concurrency())
+	}
+
+	if int32(fetcher.Throttled()) != atomic.LoadInt32(&rejected) {
+		t.Errorf("expected throttled count %d to match rejected requests, got %d", rejected, fetcher.Throttled())
+	}
+}
+
+// TestAdaptiveFetcherDoRetries verifies that Do retries a request that is
+// rejected with 429 and succeeds once the server allows it.
+func TestAdaptiveFetcherDoRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"status":"ERROR"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	fetcher := NewAdaptiveFetcher(client, 4)
+	fetcher.SetBackoff(time.Millisecond)
+
+	var result map[string]interface{}
+	err := fetcher.Do(func() error { return client.get("/test", nil, &result) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+
+	if fetcher.Throttled() != 2 {
+		t.Errorf("expected 2 throttled retries, got %d", fetcher.Throttled())
+	}
+
+	if fetcher.Concurrency() != 1 {
+		t.Errorf("expected concurrency halved twice to 1, got %d", fetcher.Concurrency())
+	}
+}
+
+// TestAdaptiveFetcherGivesUp verifies that Do returns the 429 error once
+// the retry limit is exhausted.
+func TestAdaptiveFetcherGivesUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":"ERROR"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	fetcher := NewAdaptiveFetcher(client, 2)
+	fetcher.SetBackoff(time.Millisecond)
+
+	var result map[string]interface{}
+	err := fetcher.Do(func() error { return client.get("/test", nil, &result) })
+	if err == nil {
+		t.Fatal("expected error after exhausting retries, got nil")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected 429 APIError, got %v", err)
+	}
+}
+
+// TestAdaptiveFetcherRetriesServerErrors verifies that the default policy
+// retries 5xx responses without counting them as throttling.
+func TestAdaptiveFetcherRetriesServerErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	fetcher := NewAdaptiveFetcher(client, 4)
+	fetcher.SetBackoff(time.Millisecond)
+
+	var result map[string]interface{}
+	if err := fetcher.Do(func() error { return client.get("/test", nil, &result) }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+	if fetcher.Throttled() != 0 || fetcher.Concurrency() != 4 {
+		t.Errorf("expected no throttling, got %d throttled at concurrency %d", fetcher.Throttled(), fetcher.Concurrency())
+	}
+}
+
+// TestAdaptiveFetcherCustomRetryPolicy verifies that a client's
+// IsRetryable policy replaces the default: 404s are retried until the
+// resource appears, and 500s are no longer retried.
+func TestAdaptiveFetcherCustomRetryPolicy(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.IsRetryable = func(statusCode int, err error) bool {
+		return statusCode == http.StatusNotFound
+	}
+	fetcher := NewAdaptiveFetcher(client, 2)
+	fetcher.SetBackoff(time.Millisecond)
+
+	var result map[string]interface{}
+	if err := fetcher.Do(func() error { return client.get("/settling", nil, &result) }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 404s to be retried until success after 3 calls, got %d", calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	err := fetcher.Do(func() error { return client.get("/broken", nil, &result) })
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected 500 APIError, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the 500 not to be retried, got %d calls", calls)
+	}
+}
+
+// TestAdaptiveFetcherRampsUp verifies that concurrency grows back toward
+// the maximum while the remaining budget stays well above the watermark.
+func TestAdaptiveFetcherRampsUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "1000")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	fetcher := NewAdaptiveFetcher(client, 4)
+	fetcher.throttle()
+	fetcher.throttle()
+
+	if fetcher.Concurrency() != 1 {
+		t.Fatalf("expected concurrency 1 after throttling, got %d", fetcher.Concurrency())
+	}
+
+	err := fetcher.Run(5, func(i int) error {
+		var result map[string]interface{}
+		return client.get("/test", nil, &result)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fetcher.Concurrency() != 4 {
+		t.Errorf("expected concurrency to ramp back to 4, got %d", fetcher.Concurrency())
+	}
+}
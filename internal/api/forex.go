@@ -27,6 +27,7 @@ type ForexBarsParams struct {
 // grouped forex market summary.
 type ForexMarketSummaryParams struct {
 	Adjusted string
+	Locale   string
 }
 
 // --- Currency Conversion ---
@@ -64,7 +65,8 @@ type ForexConversionParams struct {
 // --- Quotes ---
 
 // ForexQuote represents a single forex quote record containing ask and bid
-// prices, exchange identifiers, and a participant timestamp.
+// prices, exchange identifiers, and a participant timestamp in Unix
+// nanoseconds.
 type ForexQuote struct {
 	AskExchange          int     `json:"ask_exchange"`
 	AskPrice             float64 `json:"ask_price"`
@@ -94,6 +96,7 @@ type ForexQuotesParams struct {
 	Order        string
 	Limit        string
 	Sort         string
+	Cursor       string
 }
 
 // ForexLastQuoteLast holds the last quote data within a forex last quote
@@ -124,6 +127,12 @@ type ForexSnapshotDay struct {
 	Close float64 `json:"c"`
 }
 
+// IsZero reports whether the bar carries no data, which is how a "day" or
+// "prevDay" object omitted from the response decodes.
+func (d ForexSnapshotDay) IsZero() bool {
+	return d == ForexSnapshotDay{}
+}
+
 // ForexSnapshotLastQuote holds the last quote data within a forex snapshot,
 // including ask, bid, exchange, and timestamp values.
 type ForexSnapshotLastQuote struct {
@@ -133,6 +142,12 @@ type ForexSnapshotLastQuote struct {
 	Timestamp int64   `json:"t"`
 }
 
+// IsZero reports whether the quote carries no data, which is how a
+// "lastQuote" object omitted from the response decodes.
+func (q ForexSnapshotLastQuote) IsZero() bool {
+	return q == ForexSnapshotLastQuote{}
+}
+
 // ForexSnapshotTicker represents a single forex ticker's snapshot data
 // containing the ticker symbol, day bar, last quote, previous day bar,
 // and the calculated change values.
@@ -257,7 +272,11 @@ func (c *Client) GetForexBars(ticker string, p ForexBarsParams) (*BarsResponse,
 // all forex tickers on the specified date. The response uses the shared
 // MarketSummaryResponse type since the format is identical to stocks.
 func (c *Client) GetForexDailyMarketSummary(date string, p ForexMarketSummaryParams) (*MarketSummaryResponse, error) {
-	path := fmt.Sprintf("/v2/aggs/grouped/locale/global/market/fx/%s", date)
+	locale, err := groupedLocale("fx", p.Locale)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v2/aggs/grouped/locale/%s/market/fx/%s", locale, date)
 
 	params := map[string]string{
 		"adjusted": p.Adjusted,
@@ -343,6 +362,11 @@ func (c *Client) GetForexQuotes(ticker string, p ForexQuotesParams) (*ForexQuote
 		"order":         p.Order,
 		"limit":         p.Limit,
 		"sort":          p.Sort,
+		"cursor":        p.Cursor,
+	}
+
+	if err := normalizeTimestampParams(params); err != nil {
+		return nil, err
 	}
 
 	var result ForexQuotesResponse
@@ -353,6 +377,17 @@ func (c *Client) GetForexQuotes(ticker string, p ForexQuotesParams) (*ForexQuote
 	return &result, nil
 }
 
+// GetForexQuotesNext retrieves the next page of forex quotes by following
+// the next_url returned in a previous ForexQuotesResponse.
+func (c *Client) GetForexQuotesNext(nextURL string) (*ForexQuotesResponse, error) {
+	var result ForexQuotesResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetForexLastQuote retrieves the most recent forex quote for a currency pair
 // specified by the from and to currency codes. Returns the last available
 // ask/bid prices with exchange and timestamp information.
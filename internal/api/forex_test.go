@@ -123,14 +123,14 @@ const forexQuotesJSON = `{
 			"ask_price": 1.0855,
 			"bid_exchange": 48,
 			"bid_price": 1.0850,
-			"participant_timestamp": 1736139600000
+			"participant_timestamp": 1736139600000000000
 		},
 		{
 			"ask_exchange": 48,
 			"ask_price": 1.0860,
 			"bid_exchange": 48,
 			"bid_price": 1.0852,
-			"participant_timestamp": 1736139601000
+			"participant_timestamp": 1736139601000000000
 		}
 	]
 }`
@@ -173,6 +173,18 @@ const forexSnapshotAllJSON = `{
 	]
 }`
 
+const forexSnapshotSparseJSON = `{
+	"status": "OK",
+	"request_id": "forex_snap_sparse_001",
+	"ticker": {
+		"ticker": "C:USDXAF",
+		"todaysChange": 0,
+		"todaysChangePerc": 0,
+		"updated": 1736139600000,
+		"prevDay": {"o": 605.1, "h": 606.2, "l": 604.8, "c": 605.5}
+	}
+}`
+
 const forexSnapshotSingleJSON = `{
 	"status": "OK",
 	"request_id": "forex_snap_single_001",
@@ -939,8 +951,8 @@ func TestGetForexQuotes(t *testing.T) {
 		t.Errorf("expected ask_exchange 48, got %d", first.AskExchange)
 	}
 
-	if first.ParticipantTimestamp != 1736139600000 {
-		t.Errorf("expected participant_timestamp 1736139600000, got %d", first.ParticipantTimestamp)
+	if first.ParticipantTimestamp != 1736139600000000000 {
+		t.Errorf("expected participant_timestamp 1736139600000000000, got %d", first.ParticipantTimestamp)
 	}
 
 	second := result.Results[1]
@@ -1009,6 +1021,34 @@ func TestGetForexQuotesQueryParams(t *testing.T) {
 	})
 }
 
+// TestGetForexQuotesNext verifies that GetForexQuotesNext follows the
+// next_url path and cursor against the configured base URL.
+func TestGetForexQuotesNext(t *testing.T) {
+	var receivedPath, receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(forexQuotesJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetForexQuotesNext("https://api.massive.com/v3/quotes/C:EURUSD?cursor=page2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedPath != "/v3/quotes/C:EURUSD" || receivedCursor != "page2" {
+		t.Errorf("expected /v3/quotes/C:EURUSD with cursor=page2, got %s cursor=%s", receivedPath, receivedCursor)
+	}
+
+	if len(result.Results) != 2 {
+		t.Errorf("expected 2 quotes, got %d", len(result.Results))
+	}
+}
+
 // --- Last Quote Tests ---
 
 // TestGetForexLastQuote verifies that GetForexLastQuote correctly parses
@@ -1191,6 +1231,35 @@ func TestGetForexSnapshotTicker(t *testing.T) {
 	}
 }
 
+// TestGetForexSnapshotMissingNestedObjects verifies that a snapshot with no
+// "day" or "lastQuote" objects decodes cleanly and reports those sections
+// as zero so renderers can show "-".
+func TestGetForexSnapshotMissingNestedObjects(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v2/snapshot/locale/global/markets/forex/tickers/C:USDXAF": forexSnapshotSparseJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetForexSnapshotTicker("C:USDXAF")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tk := result.Ticker
+	if !tk.Day.IsZero() {
+		t.Errorf("expected missing day bar to be zero, got %+v", tk.Day)
+	}
+
+	if !tk.LastQuote.IsZero() {
+		t.Errorf("expected missing last quote to be zero, got %+v", tk.LastQuote)
+	}
+
+	if tk.PrevDay.IsZero() {
+		t.Error("expected prev day bar to be present")
+	}
+}
+
 // TestGetForexSnapshotTickerRequestPath verifies that GetForexSnapshotTicker
 // constructs the correct API path with the forex ticker.
 func TestGetForexSnapshotTickerRequestPath(t *testing.T) {
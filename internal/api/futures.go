@@ -7,6 +7,7 @@ package api
 
 import (
 	"fmt"
+	"sort"
 )
 
 // --- Aggregate Bars ---
@@ -21,12 +22,15 @@ type FuturesAggResponse struct {
 
 // FuturesBar represents a single futures OHLC aggregate bar with
 // settlement price, volume, dollar volume, and nanosecond window start.
+// OpenInterest is the end-of-session open interest when the API reports it
+// for the bar, and nil otherwise.
 type FuturesBar struct {
 	Close           float64 `json:"close"`
 	DollarVolume    float64 `json:"dollar_volume"`
 	High            float64 `json:"high"`
 	Low             float64 `json:"low"`
 	Open            float64 `json:"open"`
+	OpenInterest    *int64  `json:"open_interest,omitempty"`
 	SessionEndDate  string  `json:"session_end_date"`
 	SettlementPrice float64 `json:"settlement_price"`
 	Ticker          string  `json:"ticker"`
@@ -144,6 +148,17 @@ func (c *Client) GetFuturesContracts(p FuturesContractsParams) (*FuturesContract
 	return &result, nil
 }
 
+// GetFuturesContractsNext retrieves the next page of futures contracts by
+// following the next_url returned in a previous FuturesContractsResponse.
+func (c *Client) GetFuturesContractsNext(nextURL string) (*FuturesContractsResponse, error) {
+	var result FuturesContractsResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // --- Products ---
 
 // FuturesProductsResponse represents the API response for listing futures
@@ -152,6 +167,7 @@ type FuturesProductsResponse struct {
 	RequestID string           `json:"request_id"`
 	Status    string           `json:"status"`
 	Results   []FuturesProduct `json:"results"`
+	NextURL   string           `json:"next_url,omitempty"`
 }
 
 // FuturesProduct represents a single futures product definition with
@@ -219,6 +235,17 @@ func (c *Client) GetFuturesProducts(p FuturesProductsParams) (*FuturesProductsRe
 	return &result, nil
 }
 
+// GetFuturesProductsNext retrieves the next page of futures products by
+// following the next_url returned in a previous FuturesProductsResponse.
+func (c *Client) GetFuturesProductsNext(nextURL string) (*FuturesProductsResponse, error) {
+	var result FuturesProductsResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // --- Schedules ---
 
 // FuturesSchedulesResponse represents the API response for listing futures
@@ -293,6 +320,27 @@ type FuturesExchange struct {
 	URL          string `json:"url"`
 }
 
+// Exchanges converts the futures exchanges to the shared Exchange type
+// with AssetClass set to "futures", so they can be listed alongside the
+// exchanges of other asset classes.
+func (r *FuturesExchangesResponse) Exchanges() []Exchange {
+	exchanges := make([]Exchange, 0, len(r.Results))
+	for _, e := range r.Results {
+		exchanges = append(exchanges, Exchange{
+			ID:           e.ID,
+			Type:         e.Type,
+			AssetClass:   "futures",
+			Locale:       e.Locale,
+			Name:         e.Name,
+			Acronym:      e.Acronym,
+			MIC:          e.MIC,
+			OperatingMIC: e.OperatingMIC,
+			URL:          e.URL,
+		})
+	}
+	return exchanges
+}
+
 // FuturesExchangesParams holds the query parameters for limiting the
 // number of futures exchanges returned.
 type FuturesExchangesParams struct {
@@ -365,6 +413,12 @@ type FuturesSnapshotLastQuote struct {
 	BidTimestamp int64   `json:"bid_timestamp"`
 }
 
+// IsZero reports whether the quote carries no data, which is how a
+// "last_quote" object omitted from the response decodes.
+func (q FuturesSnapshotLastQuote) IsZero() bool {
+	return q == FuturesSnapshotLastQuote{}
+}
+
 // FuturesSnapshotLastTrade holds the most recent trade data within a
 // futures snapshot including price, size, and nanosecond timestamp.
 type FuturesSnapshotLastTrade struct {
@@ -373,6 +427,12 @@ type FuturesSnapshotLastTrade struct {
 	Timestamp int64   `json:"timestamp"`
 }
 
+// IsZero reports whether the trade carries no data, which is how a
+// "last_trade" object omitted from the response decodes.
+func (t FuturesSnapshotLastTrade) IsZero() bool {
+	return t == FuturesSnapshotLastTrade{}
+}
+
 // FuturesSnapshotSession holds the current trading session data within
 // a futures snapshot including OHLC, settlement price, change, and volume.
 type FuturesSnapshotSession struct {
@@ -385,6 +445,12 @@ type FuturesSnapshotSession struct {
 	Volume          float64 `json:"volume"`
 }
 
+// IsZero reports whether the session carries no data, which is how a
+// "session" object omitted from the response decodes.
+func (s FuturesSnapshotSession) IsZero() bool {
+	return s == FuturesSnapshotSession{}
+}
+
 // FuturesSnapshotParams holds the query parameters for filtering futures
 // contract snapshots by product code, ticker, limit, and sort order.
 type FuturesSnapshotParams struct {
@@ -417,8 +483,10 @@ func (c *Client) GetFuturesSnapshot(p FuturesSnapshotParams) (*FuturesSnapshotRe
 // --- Trades ---
 
 // FuturesTradesResponse represents the API response for futures trade data
-// with request metadata and an array of trade results.
+// with request metadata, a next_url cursor link, and an array of trade
+// results.
 type FuturesTradesResponse struct {
+	NextURL   string         `json:"next_url"`
 	RequestID string         `json:"request_id"`
 	Status    string         `json:"status"`
 	Results   []FuturesTrade `json:"results"`
@@ -447,6 +515,7 @@ type FuturesTradesParams struct {
 	SessionEndDate string
 	Limit          string
 	Sort           string
+	Cursor         string
 }
 
 // GetFuturesTrades retrieves tick-level trade data for a specific futures
@@ -463,6 +532,11 @@ func (c *Client) GetFuturesTrades(ticker string, p FuturesTradesParams) (*Future
 		"session_end_date": p.SessionEndDate,
 		"limit":            p.Limit,
 		"sort":             p.Sort,
+		"cursor":           p.Cursor,
+	}
+
+	if err := normalizeTimestampParams(params); err != nil {
+		return nil, err
 	}
 
 	var result FuturesTradesResponse
@@ -473,11 +547,38 @@ func (c *Client) GetFuturesTrades(ticker string, p FuturesTradesParams) (*Future
 	return &result, nil
 }
 
+// MergeFuturesTrades combines per-contract trade lists into a single tape
+// sorted by timestamp, breaking ties by sequence number. tickers[i] names
+// the contract that trades[i] came from and fills in Ticker on any trade
+// the API returned without one, so every row can be attributed.
+func MergeFuturesTrades(tickers []string, trades [][]FuturesTrade) []FuturesTrade {
+	var merged []FuturesTrade
+	for i, list := range trades {
+		for _, trade := range list {
+			if trade.Ticker == "" && i < len(tickers) {
+				trade.Ticker = tickers[i]
+			}
+			merged = append(merged, trade)
+		}
+	}
+
+	sort.SliceStable(merged, func(a, b int) bool {
+		if merged[a].Timestamp != merged[b].Timestamp {
+			return merged[a].Timestamp < merged[b].Timestamp
+		}
+		return merged[a].SequenceNumber < merged[b].SequenceNumber
+	})
+
+	return merged
+}
+
 // --- Quotes ---
 
 // FuturesQuotesResponse represents the API response for futures quote data
-// with request metadata and an array of quote results.
+// with request metadata, a next_url cursor link, and an array of quote
+// results.
 type FuturesQuotesResponse struct {
+	NextURL   string         `json:"next_url"`
 	RequestID string         `json:"request_id"`
 	Status    string         `json:"status"`
 	Results   []FuturesQuote `json:"results"`
@@ -510,6 +611,7 @@ type FuturesQuotesParams struct {
 	SessionEndDate string
 	Limit          string
 	Sort           string
+	Cursor         string
 }
 
 // GetFuturesQuotes retrieves tick-level quote data for a specific futures
@@ -526,6 +628,11 @@ func (c *Client) GetFuturesQuotes(ticker string, p FuturesQuotesParams) (*Future
 		"session_end_date": p.SessionEndDate,
 		"limit":            p.Limit,
 		"sort":             p.Sort,
+		"cursor":           p.Cursor,
+	}
+
+	if err := normalizeTimestampParams(params); err != nil {
+		return nil, err
 	}
 
 	var result FuturesQuotesResponse
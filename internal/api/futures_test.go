@@ -36,6 +36,7 @@ const futuresAggsJSON = `{
 			"high": 4180.25,
 			"low": 4135.00,
 			"open": 4150.25,
+			"open_interest": 2650000,
 			"session_end_date": "2025-03-16",
 			"settlement_price": 4153.00,
 			"ticker": "ESH5",
@@ -220,6 +221,20 @@ const futuresSnapshotJSON = `{
 	]
 }`
 
+const futuresSnapshotSparseJSON = `{
+	"count": 1,
+	"results": [
+		{
+			"details": {
+				"open_interest": 12,
+				"settlement_date": "2029-12-21"
+			},
+			"product_code": "ES",
+			"ticker": "ESZ9"
+		}
+	]
+}`
+
 // --- Futures Trades Test Data ---
 
 const futuresTradesJSON = `{
@@ -390,6 +405,14 @@ func TestGetFuturesAggsSecondBar(t *testing.T) {
 	if bar.SessionEndDate != "2025-03-16" {
 		t.Errorf("expected session_end_date 2025-03-16, got %s", bar.SessionEndDate)
 	}
+
+	if bar.OpenInterest == nil || *bar.OpenInterest != 2650000 {
+		t.Errorf("expected open_interest 2650000, got %v", bar.OpenInterest)
+	}
+
+	if result.Results[0].OpenInterest != nil {
+		t.Errorf("expected nil open_interest on first bar, got %d", *result.Results[0].OpenInterest)
+	}
 }
 
 // TestGetFuturesAggsRequestPath verifies that GetFuturesAggs constructs the
@@ -1136,6 +1159,55 @@ func TestGetFuturesSnapshot(t *testing.T) {
 	}
 }
 
+// TestGetFuturesSnapshotMissingNestedObjects verifies that a contract with
+// no "last_trade", "last_quote", or "session" objects decodes cleanly and
+// reports those sections as zero so renderers can show "-".
+func TestGetFuturesSnapshotMissingNestedObjects(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/futures/vX/snapshot": futuresSnapshotSparseJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetFuturesSnapshot(FuturesSnapshotParams{Ticker: "ESZ9"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(result.Results))
+	}
+
+	snap := result.Results[0]
+	if !snap.LastTrade.IsZero() || !snap.LastQuote.IsZero() || !snap.Session.IsZero() {
+		t.Errorf("expected missing nested objects to be zero, got %+v", snap)
+	}
+
+	if snap.Details.OpenInterest != 12 {
+		t.Errorf("expected open interest 12, got %d", snap.Details.OpenInterest)
+	}
+}
+
+// TestFuturesSnapshotIsZeroWithData verifies that populated nested futures
+// snapshot objects are not reported as zero.
+func TestFuturesSnapshotIsZeroWithData(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/futures/vX/snapshot": futuresSnapshotJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetFuturesSnapshot(FuturesSnapshotParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	snap := result.Results[0]
+	if snap.LastTrade.IsZero() || snap.LastQuote.IsZero() || snap.Session.IsZero() {
+		t.Errorf("expected nested objects to be present, got %+v", snap)
+	}
+}
+
 // TestGetFuturesSnapshotQueryParams verifies that all query parameters are
 // correctly sent to the API endpoint for futures snapshots.
 func TestGetFuturesSnapshotQueryParams(t *testing.T) {
@@ -1578,3 +1650,96 @@ func TestGetFuturesTradesEmptyResults(t *testing.T) {
 		t.Errorf("expected 0 results, got %d", len(result.Results))
 	}
 }
+
+// TestGetFuturesContractsNext verifies that GetFuturesContractsNext follows
+// the cursor in a next_url and decodes the page.
+func TestGetFuturesContractsNext(t *testing.T) {
+	var receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(futuresContractsJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetFuturesContractsNext(server.URL + "/futures/vX/contracts?cursor=page2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedCursor != "page2" {
+		t.Errorf("expected cursor=page2, got %s", receivedCursor)
+	}
+
+	if len(result.Results) == 0 {
+		t.Error("expected contracts in the next page")
+	}
+}
+
+// TestGetFuturesProductsNext verifies that GetFuturesProductsNext follows
+// the next_url cursor and parses the page of products.
+func TestGetFuturesProductsNext(t *testing.T) {
+	var receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(futuresProductsJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetFuturesProductsNext(server.URL + "/futures/vX/products?cursor=page2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedCursor != "page2" {
+		t.Errorf("expected cursor=page2, got %s", receivedCursor)
+	}
+
+	if len(result.Results) == 0 {
+		t.Error("expected products in the next page")
+	}
+}
+
+// TestMergeFuturesTrades verifies that trades from several contracts are
+// interleaved by timestamp, ties are ordered by sequence number, and
+// missing tickers are filled from the contract list.
+func TestMergeFuturesTrades(t *testing.T) {
+	tickers := []string{"ESH5", "ESM5"}
+	trades := [][]FuturesTrade{
+		{
+			{Timestamp: 100, SequenceNumber: 1, Price: 1},
+			{Timestamp: 300, SequenceNumber: 5, Price: 3},
+		},
+		{
+			{Ticker: "ESM5", Timestamp: 200, SequenceNumber: 2, Price: 2},
+			{Ticker: "ESM5", Timestamp: 300, SequenceNumber: 4, Price: 4},
+		},
+	}
+
+	merged := MergeFuturesTrades(tickers, trades)
+
+	want := []struct {
+		ticker string
+		price  float64
+	}{
+		{"ESH5", 1},
+		{"ESM5", 2},
+		{"ESM5", 4},
+		{"ESH5", 3},
+	}
+
+	if len(merged) != len(want) {
+		t.Fatalf("expected %d trades, got %d", len(want), len(merged))
+	}
+
+	for i, w := range want {
+		if merged[i].Ticker != w.ticker || merged[i].Price != w.price {
+			t.Errorf("trade %d: expected %s @ %v, got %s @ %v", i, w.ticker, w.price, merged[i].Ticker, merged[i].Price)
+		}
+	}
+}
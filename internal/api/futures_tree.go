@@ -0,0 +1,54 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "sort"
+
+// FuturesAssetClassGroup is one branch of the futures product tree: an
+// asset class and its products grouped by sector.
+type FuturesAssetClassGroup struct {
+	AssetClass string               `json:"asset_class"`
+	Products   int                  `json:"products"`
+	Sectors    []FuturesSectorGroup `json:"sectors"`
+}
+
+// FuturesSectorGroup holds the products of one sector within an asset
+// class.
+type FuturesSectorGroup struct {
+	Sector   string           `json:"sector"`
+	Products []FuturesProduct `json:"products"`
+}
+
+// GroupFuturesProducts arranges products into an asset class -> sector ->
+// product tree. Asset classes and sectors are sorted by name, with
+// products missing either value grouped under an empty name, and products
+// within a sector are sorted by product code.
+func GroupFuturesProducts(products []FuturesProduct) []FuturesAssetClassGroup {
+	bySector := make(map[string]map[string][]FuturesProduct)
+	for _, p := range products {
+		sectors, ok := bySector[p.AssetClass]
+		if !ok {
+			sectors = make(map[string][]FuturesProduct)
+			bySector[p.AssetClass] = sectors
+		}
+		sectors[p.Sector] = append(sectors[p.Sector], p)
+	}
+
+	groups := make([]FuturesAssetClassGroup, 0, len(bySector))
+	for assetClass, sectors := range bySector {
+		group := FuturesAssetClassGroup{AssetClass: assetClass}
+		for sector, items := range sectors {
+			sort.SliceStable(items, func(i, j int) bool { return items[i].ProductCode < items[j].ProductCode })
+			group.Sectors = append(group.Sectors, FuturesSectorGroup{Sector: sector, Products: items})
+			group.Products += len(items)
+		}
+		sort.Slice(group.Sectors, func(i, j int) bool { return group.Sectors[i].Sector < group.Sectors[j].Sector })
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].AssetClass < groups[j].AssetClass })
+
+	return groups
+}
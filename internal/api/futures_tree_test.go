@@ -0,0 +1,43 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "testing"
+
+// TestGroupFuturesProducts verifies that products are grouped by asset
+// class and sector in name order, with products sorted by code and
+// unclassified products kept under an empty name.
+func TestGroupFuturesProducts(t *testing.T) {
+	products := []FuturesProduct{
+		{ProductCode: "NQ", AssetClass: "equity", Sector: "index"},
+		{ProductCode: "CL", AssetClass: "commodity", Sector: "energy"},
+		{ProductCode: "ES", AssetClass: "equity", Sector: "index"},
+		{ProductCode: "GC", AssetClass: "commodity", Sector: "metals"},
+		{ProductCode: "XX"},
+	}
+
+	groups := GroupFuturesProducts(products)
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 asset classes, got %+v", groups)
+	}
+
+	if groups[0].AssetClass != "" || groups[0].Products != 1 {
+		t.Errorf("expected the unclassified group first, got %+v", groups[0])
+	}
+
+	commodity := groups[1]
+	if commodity.AssetClass != "commodity" || commodity.Products != 2 || len(commodity.Sectors) != 2 {
+		t.Fatalf("unexpected commodity group: %+v", commodity)
+	}
+	if commodity.Sectors[0].Sector != "energy" || commodity.Sectors[1].Sector != "metals" {
+		t.Errorf("expected sectors energy, metals; got %+v", commodity.Sectors)
+	}
+
+	index := groups[2].Sectors[0]
+	if index.Sector != "index" || len(index.Products) != 2 || index.Products[0].ProductCode != "ES" || index.Products[1].ProductCode != "NQ" {
+		t.Errorf("expected ES then NQ under equity/index, got %+v", index)
+	}
+}
@@ -0,0 +1,137 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CryptoHistoryStart is the earliest date crypto history searches begin
+// from: the bitcoin genesis block. No crypto bars predate it.
+var CryptoHistoryStart = time.Date(2009, 1, 3, 0, 0, 0, 0, time.UTC)
+
+// DateRange is an inclusive span of YYYY-MM-DD dates for a range request.
+type DateRange struct {
+	From string
+	To   string
+}
+
+// SplitDateRange splits the inclusive span from..to into consecutive
+// ranges of at most days days each, so a long history can be fetched in
+// requests that each stay under the per-request bar limit. The ranges do
+// not overlap. It returns nil when to is before from or days is not
+// positive.
+func SplitDateRange(from, to time.Time, days int) []DateRange {
+	if days <= 0 {
+		return nil
+	}
+
+	from, to = truncateDay(from), truncateDay(to)
+	var ranges []DateRange
+	for start := from; !start.After(to); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days-1)
+		if end.After(to) {
+			end = to
+		}
+		ranges = append(ranges, DateRange{From: start.Format("2006-01-02"), To: end.Format("2006-01-02")})
+	}
+
+	return ranges
+}
+
+// EarliestBar finds the first daily bar for ticker on or after floor.
+// A single ascending request normally answers it. When the API refuses
+// floor with 403, as plans with a limited lookback do for dates before
+// their window, the earliest accepted date is found by binary search and
+// returned as available, so callers can tell the history was cut short.
+// available is the zero time when floor itself was accepted. Returns a
+// nil bar when the ticker has no bars at all.
+func (c *Client) EarliestBar(ticker string, floor, now time.Time) (bar *Bar, available time.Time, err error) {
+	probe := func(from time.Time) (*BarsResponse, error) {
+		return c.GetBars(ticker, BarsParams{
+			Multiplier: "1",
+			Timespan:   "day",
+			From:       from.Format("2006-01-02"),
+			To:         now.Format("2006-01-02"),
+			Sort:       "asc",
+			Limit:      "1",
+		})
+	}
+
+	result, err := probe(floor)
+	if err != nil {
+		if !isForbidden(err) {
+			return nil, time.Time{}, err
+		}
+
+		available, err = firstAcceptedDate(truncateDay(floor), truncateDay(now), func(d time.Time) (bool, error) {
+			_, err := probe(d)
+			if isForbidden(err) {
+				return false, nil
+			}
+			return err == nil, err
+		})
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if result, err = probe(available); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	if len(result.Results) == 0 {
+		return nil, available, nil
+	}
+	return &result.Results[0], available, nil
+}
+
+// firstAcceptedDate binary-searches the days from lo to hi for the first
+// one accepted, assuming every day before it is refused and every day
+// after it accepted. It returns accepted's error as soon as one occurs,
+// and an error when even hi is refused.
+func firstAcceptedDate(lo, hi time.Time, accepted func(time.Time) (bool, error)) (time.Time, error) {
+	ok, err := accepted(hi)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, fmt.Errorf("no date up to %s is available on this plan", hi.Format("2006-01-02"))
+	}
+
+	// Invariant: lo is refused (or untested at the start) and hi accepted.
+	days := int(hi.Sub(lo).Hours() / 24)
+	left, right := 0, days
+	for left < right {
+		mid := left + (right-left)/2
+		ok, err := accepted(lo.AddDate(0, 0, mid))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			right = mid
+		} else {
+			left = mid + 1
+		}
+	}
+
+	return lo.AddDate(0, 0, left), nil
+}
+
+// isForbidden reports whether err is an API 403, the status the API uses
+// for data outside the plan's entitlements.
+func isForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
+// truncateDay drops the time of day from t, keeping its calendar date.
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,170 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// day parses a YYYY-MM-DD date for the history tests.
+func day(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// TestSplitDateRange verifies spans are split into consecutive,
+// non-overlapping inclusive ranges.
+func TestSplitDateRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		days     int
+		want     []DateRange
+	}{
+		{"single chunk", "2024-01-01", "2024-01-10", 30, []DateRange{{"2024-01-01", "2024-01-10"}}},
+		{"exact split", "2024-01-01", "2024-01-06", 3, []DateRange{{"2024-01-01", "2024-01-03"}, {"2024-01-04", "2024-01-06"}}},
+		{"remainder", "2024-02-27", "2024-03-02", 2, []DateRange{{"2024-02-27", "2024-02-28"}, {"2024-02-29", "2024-03-01"}, {"2024-03-02", "2024-03-02"}}},
+		{"one day", "2024-01-01", "2024-01-01", 5, []DateRange{{"2024-01-01", "2024-01-01"}}},
+		{"reversed", "2024-01-02", "2024-01-01", 5, nil},
+		{"no days", "2024-01-01", "2024-01-10", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitDateRange(day(t, tt.from), day(t, tt.to), tt.days)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitDateRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFirstAcceptedDate verifies the binary search finds the boundary
+// and fails when nothing is accepted.
+func TestFirstAcceptedDate(t *testing.T) {
+	lo, hi := day(t, "2020-01-01"), day(t, "2024-12-31")
+
+	for _, cutoff := range []string{"2020-01-01", "2020-01-02", "2022-07-15", "2024-12-31"} {
+		c := day(t, cutoff)
+		calls := 0
+		got, err := firstAcceptedDate(lo, hi, func(d time.Time) (bool, error) {
+			calls++
+			return !d.Before(c), nil
+		})
+		if err != nil || !got.Equal(c) {
+			t.Errorf("cutoff %s: got %s (err %v)", cutoff, got.Format("2006-01-02"), err)
+		}
+		if calls > 13 {
+			t.Errorf("cutoff %s: %d probes, want a binary search", cutoff, calls)
+		}
+	}
+
+	_, err := firstAcceptedDate(lo, hi, func(time.Time) (bool, error) { return false, nil })
+	if err == nil || !strings.Contains(err.Error(), "2024-12-31") {
+		t.Errorf("expected an error naming the last date, got %v", err)
+	}
+
+	_, err = firstAcceptedDate(lo, hi, func(time.Time) (bool, error) { return false, fmt.Errorf("boom") })
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("expected the probe error, got %v", err)
+	}
+}
+
+// TestEarliestBar verifies the earliest bar comes from one ascending
+// probe, and that a plan refusing early dates with 403 is searched past.
+func TestEarliestBar(t *testing.T) {
+	const barJSON = `{"status":"OK","resultsCount":1,"results":[{"o":1,"h":2,"l":0.5,"c":1.5,"v":10,"t":%d}]}`
+	now := day(t, "2025-06-30")
+
+	for _, tt := range []struct {
+		name          string
+		planStart     string
+		wantAvailable string
+	}{
+		{"full history", "", ""},
+		{"limited plan", "2023-06-30", "2023-06-30"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var probes int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				probes++
+				if r.URL.Query().Get("sort") != "asc" || r.URL.Query().Get("limit") != "1" {
+					t.Errorf("expected an ascending limit 1 probe, got %s", r.URL.RawQuery)
+				}
+				parts := strings.Split(r.URL.Path, "/")
+				from := parts[len(parts)-2]
+				if tt.planStart != "" && from < tt.planStart {
+					w.WriteHeader(http.StatusForbidden)
+					w.Write([]byte(`{"status":"NOT_AUTHORIZED","message":"Your plan doesn't include this data timeframe."}`))
+					return
+				}
+				first := max(from, "2013-04-28")
+				fmt.Fprintf(w, barJSON, day(t, first).UnixMilli())
+			}))
+			defer server.Close()
+
+			client := newTestClient(server.URL)
+			bar, available, err := client.EarliestBar("X:BTCUSD", CryptoHistoryStart, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			wantFirst := "2013-04-28"
+			if tt.planStart != "" {
+				wantFirst = tt.planStart
+			}
+			if bar == nil || time.UnixMilli(bar.Timestamp).UTC().Format("2006-01-02") != wantFirst {
+				t.Errorf("expected the first bar on %s, got %+v", wantFirst, bar)
+			}
+
+			if tt.wantAvailable == "" {
+				if !available.IsZero() || probes != 1 {
+					t.Errorf("expected one probe and no cutoff, got %d probes and %s", probes, available)
+				}
+				return
+			}
+			if got := available.Format("2006-01-02"); got != tt.wantAvailable {
+				t.Errorf("expected available %s, got %s", tt.wantAvailable, got)
+			}
+		})
+	}
+}
+
+// TestEarliestBarNoData verifies a ticker without bars returns a nil bar
+// and other API errors are passed through.
+func TestEarliestBarNoData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "X:NONE") {
+			w.Write([]byte(`{"status":"OK","resultsCount":0}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":"NOT_FOUND"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	now := day(t, "2025-06-30")
+
+	bar, _, err := client.EarliestBar("X:NONE", CryptoHistoryStart, now)
+	if err != nil || bar != nil {
+		t.Errorf("expected no bar and no error, got %+v (err %v)", bar, err)
+	}
+
+	if _, _, err := client.EarliestBar("X:MISSING", CryptoHistoryStart, now); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected the 404 to pass through, got %v", err)
+	}
+}
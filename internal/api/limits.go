@@ -0,0 +1,68 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Endpoint families that share a page size limit. Commands pass these to
+// ClampLimit so oversized limits are caught before the API rejects them.
+const (
+	LimitAggs       = "aggs"
+	LimitTrades     = "trades"
+	LimitQuotes     = "quotes"
+	LimitIndicators = "indicators"
+	LimitTickers    = "tickers"
+	LimitContracts  = "contracts"
+	LimitNews       = "news"
+	LimitSnapshots  = "snapshots"
+)
+
+// LimitSpec is the default and maximum page size for an endpoint family.
+type LimitSpec struct {
+	Default int
+	Max     int
+}
+
+// endpointLimits holds the documented page size limits for each endpoint
+// family. Default is the --limit flag default for the family's commands.
+var endpointLimits = map[string]LimitSpec{
+	LimitAggs:       {Default: 5000, Max: 50000},
+	LimitTrades:     {Default: 1000, Max: 50000},
+	LimitQuotes:     {Default: 1000, Max: 50000},
+	LimitIndicators: {Default: 10, Max: 5000},
+	LimitTickers:    {Default: 20, Max: 1000},
+	LimitContracts:  {Default: 20, Max: 1000},
+	LimitNews:       {Default: 10, Max: 1000},
+	LimitSnapshots:  {Default: 10, Max: 250},
+}
+
+// EndpointLimit returns the page size limits for an endpoint family and
+// whether the family is known.
+func EndpointLimit(endpoint string) (LimitSpec, bool) {
+	spec, ok := endpointLimits[endpoint]
+	return spec, ok
+}
+
+// ClampLimit caps a requested limit at the endpoint family's maximum. It
+// returns the limit to send and whether it was lowered. Empty, non-numeric,
+// and unknown-endpoint values pass through untouched so the API stays the
+// authority on anything this table does not cover.
+func ClampLimit(endpoint, requested string) (string, bool) {
+	spec, ok := endpointLimits[endpoint]
+	if !ok {
+		return requested, false
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(requested))
+	if err != nil || n <= spec.Max {
+		return requested, false
+	}
+
+	return strconv.Itoa(spec.Max), true
+}
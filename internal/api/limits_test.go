@@ -0,0 +1,51 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import "testing"
+
+// TestClampLimit verifies that oversized limits are capped at the
+// endpoint maximum and everything else passes through unchanged.
+func TestClampLimit(t *testing.T) {
+	tests := []struct {
+		endpoint  string
+		requested string
+		want      string
+		clamped   bool
+	}{
+		{LimitAggs, "50000", "50000", false},
+		{LimitAggs, "60000", "50000", true},
+		{LimitIndicators, "10000", "5000", true},
+		{LimitSnapshots, "251", "250", true},
+		{LimitTickers, "20", "20", false},
+		{LimitTickers, "", "", false},
+		{LimitTickers, "lots", "lots", false},
+		{"unknown", "999999", "999999", false},
+	}
+
+	for _, tt := range tests {
+		got, clamped := ClampLimit(tt.endpoint, tt.requested)
+		if got != tt.want || clamped != tt.clamped {
+			t.Errorf("ClampLimit(%q, %q) = %q, %v; want %q, %v",
+				tt.endpoint, tt.requested, got, clamped, tt.want, tt.clamped)
+		}
+	}
+}
+
+// TestEndpointLimit verifies the defaults sit within the maximum for
+// every endpoint family and unknown families report false.
+func TestEndpointLimit(t *testing.T) {
+	for endpoint := range endpointLimits {
+		spec, ok := EndpointLimit(endpoint)
+		if !ok || spec.Default <= 0 || spec.Default > spec.Max {
+			t.Errorf("unexpected limits for %s: %+v", endpoint, spec)
+		}
+	}
+
+	if _, ok := EndpointLimit("unknown"); ok {
+		t.Error("expected unknown endpoint to be reported missing")
+	}
+}
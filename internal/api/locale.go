@@ -0,0 +1,73 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Locales the API groups markets under. US equities, options, and indices
+// are "us"; crypto and currencies trade globally.
+const (
+	LocaleUS     = "us"
+	LocaleGlobal = "global"
+)
+
+// assetClassLocales lists the locales each asset class or market is
+// available in. Keys cover both the asset_class values of the exchanges
+// and ticker types endpoints and the market values of the tickers
+// endpoint.
+var assetClassLocales = map[string][]string{
+	"stocks":  {LocaleUS},
+	"otc":     {LocaleUS},
+	"options": {LocaleUS},
+	"indices": {LocaleUS},
+	"crypto":  {LocaleGlobal},
+	"fx":      {LocaleGlobal},
+}
+
+// DefaultLocale returns the locale an asset class is served from, or ""
+// for an unknown asset class.
+func DefaultLocale(assetClass string) string {
+	if locales := assetClassLocales[strings.ToLower(assetClass)]; len(locales) > 0 {
+		return locales[0]
+	}
+	return ""
+}
+
+// ValidateLocale checks that locale is one the API knows and, when an
+// asset class is given, that the asset class is available there. An empty
+// locale is always valid and leaves the API's default in place.
+func ValidateLocale(assetClass, locale string) error {
+	if locale == "" {
+		return nil
+	}
+	if locale != LocaleUS && locale != LocaleGlobal {
+		return fmt.Errorf("invalid locale %q: must be %s or %s", locale, LocaleUS, LocaleGlobal)
+	}
+
+	locales, ok := assetClassLocales[strings.ToLower(assetClass)]
+	if ok && !slices.Contains(locales, locale) {
+		return fmt.Errorf("locale %q is not available for %s: use %s", locale, assetClass, strings.Join(locales, " or "))
+	}
+
+	return nil
+}
+
+// groupedLocale validates locale for a grouped daily summary of the given
+// market and returns the locale to put in the path, falling back to the
+// market's default when none was given.
+func groupedLocale(market, locale string) (string, error) {
+	if err := ValidateLocale(market, locale); err != nil {
+		return "", err
+	}
+	if locale == "" {
+		return DefaultLocale(market), nil
+	}
+	return locale, nil
+}
@@ -0,0 +1,95 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestValidateLocale verifies that unknown locales and locales an asset
+// class is not available in are rejected, while empty locales and
+// unknown asset classes pass through.
+func TestValidateLocale(t *testing.T) {
+	tests := []struct {
+		assetClass, locale string
+		wantErr            bool
+	}{
+		{"stocks", "", false},
+		{"stocks", "us", false},
+		{"stocks", "global", true},
+		{"crypto", "global", false},
+		{"fx", "us", true},
+		{"", "global", false},
+		{"", "eu", true},
+		{"futures", "us", false},
+		{"Options", "us", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateLocale(tt.assetClass, tt.locale)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateLocale(%q, %q): expected error %v, got %v", tt.assetClass, tt.locale, tt.wantErr, err)
+		}
+	}
+}
+
+// TestGroupedSummaryLocale verifies that grouped summaries put an explicit
+// locale in the path and reject one the market is not grouped under
+// without sending a request.
+func TestGroupedSummaryLocale(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK","results":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if _, err := client.GetMarketSummary("2025-01-06", MarketSummaryParams{Locale: "us"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetForexDailyMarketSummary("2025-01-06", ForexMarketSummaryParams{Locale: "us"}); err == nil {
+		t.Error("expected an error for forex in the us locale")
+	}
+	if _, err := client.GetCryptoDailyMarketSummary("2025-01-06", "", "global"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"/v2/aggs/grouped/locale/us/market/stocks/2025-01-06", "/v2/aggs/grouped/locale/global/market/crypto/2025-01-06"}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("expected paths %v, got %v", want, paths)
+	}
+}
+
+// TestGetTickersLocale verifies that GetTickers sends the locale filter
+// and rejects a locale the requested market is not available in.
+func TestGetTickersLocale(t *testing.T) {
+	var locale string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale = r.URL.Query().Get("locale")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK","results":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if _, err := client.GetTickers(TickerParams{Locale: "global"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if locale != "global" {
+		t.Errorf("expected locale=global, got %q", locale)
+	}
+
+	if _, err := client.GetTickers(TickerParams{Market: "crypto", Locale: "us"}); err == nil {
+		t.Error("expected an error for crypto tickers in the us locale")
+	}
+	if _, err := client.GetExchanges(ExchangesParams{AssetClass: "stocks", Locale: "global"}); err == nil {
+		t.Error("expected an error for stock exchanges in the global locale")
+	}
+}
@@ -0,0 +1,68 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// optionSymbolPattern matches an OCC option symbol after the O: prefix:
+// a 1-6 character root (adjusted contracts append a digit, as in AAPL1),
+// the expiration as YYMMDD, C or P, and the strike times 1000 as eight
+// digits.
+var optionSymbolPattern = regexp.MustCompile(`^([A-Z0-9]{1,6})(\d{6})([CP])(\d{8})$`)
+
+// OptionSymbol is an options contract ticker split into its OCC parts.
+type OptionSymbol struct {
+	Root       string
+	Expiration time.Time
+	Type       string // call or put
+	Strike     float64
+}
+
+// ParseOptionSymbol validates an OCC option symbol such as
+// O:AAPL250620C00150000 and splits it into its parts. The O: prefix is
+// optional and letters may be lower case. The error says which part is
+// wrong so a mistyped contract is caught before any request is sent.
+func ParseOptionSymbol(ticker string) (*OptionSymbol, error) {
+	body := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(ticker)), "O:")
+
+	m := optionSymbolPattern.FindStringSubmatch(body)
+	if m == nil {
+		return nil, fmt.Errorf("invalid option symbol %q: expected O:<root><YYMMDD><C|P><strike x1000, 8 digits>, e.g. O:AAPL250620C00150000", ticker)
+	}
+
+	expiration, err := time.Parse("060102", m[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid option symbol %q: %s is not a valid YYMMDD expiration", ticker, m[2])
+	}
+
+	strike, _ := strconv.Atoi(m[4])
+	optionType := "call"
+	if m[3] == "P" {
+		optionType = "put"
+	}
+
+	return &OptionSymbol{
+		Root:       m[1],
+		Expiration: expiration,
+		Type:       optionType,
+		Strike:     float64(strike) / 1000,
+	}, nil
+}
+
+// Ticker returns the symbol in the API's canonical O:-prefixed form.
+func (s *OptionSymbol) Ticker() string {
+	cp := "C"
+	if s.Type == "put" {
+		cp = "P"
+	}
+	return fmt.Sprintf("O:%s%s%s%08d", s.Root, s.Expiration.Format("060102"), cp, int64(s.Strike*1000+0.5))
+}
@@ -0,0 +1,82 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseOptionSymbol verifies OCC symbols are split into their parts
+// and written back in canonical form.
+func TestParseOptionSymbol(t *testing.T) {
+	tests := []struct {
+		in         string
+		root       string
+		expiration string
+		optionType string
+		strike     float64
+		ticker     string
+	}{
+		{"O:AAPL250620C00150000", "AAPL", "2025-06-20", "call", 150, "O:AAPL250620C00150000"},
+		{"spy250221p00500500", "SPY", "2025-02-21", "put", 500.5, "O:SPY250221P00500500"},
+		{"O:AAPL1251219C00002500", "AAPL1", "2025-12-19", "call", 2.5, "O:AAPL1251219C00002500"},
+		{" O:F270115P00012000 ", "F", "2027-01-15", "put", 12, "O:F270115P00012000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			s, err := ParseOptionSymbol(tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s.Root != tt.root || s.Expiration.Format("2006-01-02") != tt.expiration || s.Type != tt.optionType || s.Strike != tt.strike {
+				t.Errorf("got %+v", s)
+			}
+			if got := s.Ticker(); got != tt.ticker {
+				t.Errorf("Ticker() = %s, want %s", got, tt.ticker)
+			}
+		})
+	}
+}
+
+// TestParseOptionSymbolInvalid verifies malformed symbols are rejected
+// with an error naming the problem.
+func TestParseOptionSymbolInvalid(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"AAPL", "expected O:<root>"},
+		{"O:AAPL250620X00150000", "expected O:<root>"},
+		{"O:AAPL250620C150000", "expected O:<root>"},
+		{"O:TOOLONGX250620C00150000", "expected O:<root>"},
+		{"X:BTCUSD", "expected O:<root>"},
+		{"O:AAPL251332C00150000", "not a valid YYMMDD expiration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := ParseOptionSymbol(tt.in)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// TestGetOptionsLastTradeInvalidSymbol verifies a malformed symbol fails
+// before any request is sent.
+func TestGetOptionsLastTradeInvalidSymbol(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+
+	if _, err := client.GetOptionsLastTrade("AAPL"); err == nil || !strings.Contains(err.Error(), "invalid option symbol") {
+		t.Errorf("expected an invalid symbol error, got %v", err)
+	}
+	if _, err := client.GetOptionsLastQuote("O:AAPL250620C0015"); err == nil || !strings.Contains(err.Error(), "invalid option symbol") {
+		t.Errorf("expected an invalid symbol error, got %v", err)
+	}
+}
@@ -45,6 +45,7 @@ type OptionsTradesParams struct {
 	Order        string
 	Limit        string
 	Sort         string
+	Cursor       string
 }
 
 // OptionsLastTradeResponse represents the API response for the most recent trade
@@ -110,6 +111,7 @@ type OptionsQuotesParams struct {
 	Order        string
 	Limit        string
 	Sort         string
+	Cursor       string
 }
 
 // OptionsLastQuoteResponse represents the API response for the most recent
@@ -156,6 +158,7 @@ func (c *Client) GetOptionsTrades(ticker string, p OptionsTradesParams) (*Option
 		"order":         p.Order,
 		"limit":         p.Limit,
 		"sort":          p.Sort,
+		"cursor":        p.Cursor,
 	}
 
 	var result OptionsTradesResponse
@@ -169,8 +172,13 @@ func (c *Client) GetOptionsTrades(ticker string, p OptionsTradesParams) (*Option
 // GetOptionsLastTrade retrieves the most recent trade for a specific options
 // contract ticker. Returns the last available trade with price, size, exchange,
 // and timestamp information useful for monitoring current options market activity.
+// The ticker must be a valid OCC option symbol (see ParseOptionSymbol).
 func (c *Client) GetOptionsLastTrade(ticker string) (*OptionsLastTradeResponse, error) {
-	path := fmt.Sprintf("/v2/last/trade/%s", ticker)
+	symbol, err := ParseOptionSymbol(ticker)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v2/last/trade/%s", symbol.Ticker())
 
 	var result OptionsLastTradeResponse
 	if err := c.get(path, nil, &result); err != nil {
@@ -196,6 +204,7 @@ func (c *Client) GetOptionsQuotes(ticker string, p OptionsQuotesParams) (*Option
 		"order":         p.Order,
 		"limit":         p.Limit,
 		"sort":          p.Sort,
+		"cursor":        p.Cursor,
 	}
 
 	var result OptionsQuotesResponse
@@ -209,8 +218,13 @@ func (c *Client) GetOptionsQuotes(ticker string, p OptionsQuotesParams) (*Option
 // GetOptionsLastQuote retrieves the most recent NBBO quote for a specific
 // options contract ticker. Returns the last available bid/ask prices, sizes,
 // and exchange information for real-time options market monitoring.
+// The ticker must be a valid OCC option symbol (see ParseOptionSymbol).
 func (c *Client) GetOptionsLastQuote(ticker string) (*OptionsLastQuoteResponse, error) {
-	path := fmt.Sprintf("/v2/last/nbbo/%s", ticker)
+	symbol, err := ParseOptionSymbol(ticker)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v2/last/nbbo/%s", symbol.Ticker())
 
 	var result OptionsLastQuoteResponse
 	if err := c.get(path, nil, &result); err != nil {
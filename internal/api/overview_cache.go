@@ -0,0 +1,127 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// OverviewCacheTTL is how long a cached ticker overview is reused before
+// it is fetched again. Names and currency details rarely change.
+const OverviewCacheTTL = 7 * 24 * time.Hour
+
+// cachedCryptoOverview is one entry of the on-disk crypto overview cache.
+type cachedCryptoOverview struct {
+	FetchedAt time.Time            `json:"fetched_at"`
+	Overview  CryptoTickerOverview `json:"overview"`
+}
+
+// cryptoOverviewCachePath returns the crypto overview cache file in dir.
+func cryptoOverviewCachePath(dir string) string {
+	return filepath.Join(dir, "overviews-crypto.json")
+}
+
+// loadCryptoOverviewCache reads the crypto overview cache from dir. A
+// missing or unreadable cache yields an empty map, since the entries can
+// always be fetched again.
+func loadCryptoOverviewCache(dir string) map[string]cachedCryptoOverview {
+	cache := map[string]cachedCryptoOverview{}
+
+	data, err := os.ReadFile(cryptoOverviewCachePath(dir))
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return map[string]cachedCryptoOverview{}
+	}
+
+	return cache
+}
+
+// writeCryptoOverviewCache saves cache to dir via a temp file and rename.
+func writeCryptoOverviewCache(dir string, cache map[string]cachedCryptoOverview) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	path := cryptoOverviewCachePath(dir)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// GetCryptoTickerOverviews returns the overview of each ticker, keyed by
+// upper-cased ticker. Entries younger than OverviewCacheTTL in the
+// client's cache directory are reused; the rest are fetched concurrently
+// with an AdaptiveFetcher of at most concurrency requests and saved back
+// to the cache. A ticker that cannot be fetched is reported in the
+// returned error map instead of failing the batch. Saving the cache is
+// best effort.
+func (c *Client) GetCryptoTickerOverviews(tickers []string, concurrency int) (map[string]CryptoTickerOverview, map[string]error) {
+	cache := map[string]cachedCryptoOverview{}
+	if c.cacheDir != "" {
+		cache = loadCryptoOverviewCache(c.cacheDir)
+	}
+
+	overviews := map[string]CryptoTickerOverview{}
+	seen := map[string]bool{}
+	var missing []string
+	for _, ticker := range tickers {
+		ticker = strings.ToUpper(ticker)
+		if seen[ticker] {
+			continue
+		}
+		seen[ticker] = true
+
+		if entry, ok := cache[ticker]; ok && time.Since(entry.FetchedAt) < OverviewCacheTTL {
+			overviews[ticker] = entry.Overview
+			continue
+		}
+		missing = append(missing, ticker)
+	}
+
+	errs := map[string]error{}
+	if len(missing) == 0 {
+		return overviews, errs
+	}
+
+	fetched := make([]*CryptoTickerOverviewResponse, len(missing))
+	failures := make([]error, len(missing))
+
+	fetcher := NewAdaptiveFetcher(c, concurrency)
+	_ = fetcher.Run(len(missing), func(i int) error {
+		resp, err := c.GetCryptoTickerOverview(missing[i])
+		fetched[i], failures[i] = resp, err
+		return err
+	})
+
+	now := time.Now().UTC()
+	for i, ticker := range missing {
+		if failures[i] != nil {
+			errs[ticker] = failures[i]
+			continue
+		}
+		overviews[ticker] = fetched[i].Results
+		cache[ticker] = cachedCryptoOverview{FetchedAt: now, Overview: fetched[i].Results}
+	}
+
+	if c.cacheDir != "" && len(errs) < len(missing) {
+		_ = writeCryptoOverviewCache(c.cacheDir, cache)
+	}
+
+	return overviews, errs
+}
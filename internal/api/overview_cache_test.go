@@ -0,0 +1,63 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestGetCryptoTickerOverviews verifies that overviews are fetched once per
+// unique ticker, that a failing ticker is reported without failing the
+// batch, and that a second call is served from the on-disk cache.
+func TestGetCryptoTickerOverviews(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ticker := strings.TrimPrefix(r.URL.Path, "/v3/reference/tickers/")
+		mu.Lock()
+		requests[ticker]++
+		mu.Unlock()
+
+		switch ticker {
+		case "X:BTCUSD":
+			w.Write([]byte(`{"status":"OK","results":{"ticker":"X:BTCUSD","name":"Bitcoin - United States dollar","base_currency_name":"Bitcoin"}}`))
+		case "X:ETHUSD":
+			w.Write([]byte(`{"status":"OK","results":{"ticker":"X:ETHUSD","name":"Ethereum - United States dollar"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"NOT_FOUND"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.SetCacheDir(t.TempDir())
+
+	overviews, errs := client.GetCryptoTickerOverviews([]string{"X:BTCUSD", "x:ethusd", "X:BTCUSD", "X:NOPE"}, 4)
+
+	if len(overviews) != 2 || overviews["X:BTCUSD"].BaseCurrencyName != "Bitcoin" || overviews["X:ETHUSD"].Name != "Ethereum - United States dollar" {
+		t.Errorf("unexpected overviews: %+v", overviews)
+	}
+	if len(errs) != 1 || errs["X:NOPE"] == nil {
+		t.Errorf("expected one error for X:NOPE, got %v", errs)
+	}
+	if requests["X:BTCUSD"] != 1 {
+		t.Errorf("expected one request for the duplicated ticker, got %d", requests["X:BTCUSD"])
+	}
+
+	overviews, errs = client.GetCryptoTickerOverviews([]string{"X:BTCUSD", "X:ETHUSD"}, 4)
+	if len(overviews) != 2 || len(errs) != 0 {
+		t.Errorf("expected two cached overviews, got %+v (errors %v)", overviews, errs)
+	}
+	if requests["X:BTCUSD"] != 1 || requests["X:ETHUSD"] != 1 {
+		t.Errorf("expected cached overviews to skip the network, got %v", requests)
+	}
+}
@@ -0,0 +1,52 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// RawRequest is an endpoint request GetRaw can send: the params type of an
+// endpoint that supports number-preserving output.
+type RawRequest interface {
+	request() (path string, params map[string]string)
+}
+
+// RawDocument is a decoded JSON response whose numbers are kept as
+// json.Number, so they encode back to exactly the digits the API sent.
+// Decoding into the typed structs goes through float64, which rounds
+// integers above 2^53 (large revenue or share counts) and rewrites values
+// such as 1.50 as 1.5. Objects re-encode with their keys sorted.
+type RawDocument map[string]any
+
+// UnmarshalJSON decodes data with UseNumber so no number passes through
+// float64.
+func (d *RawDocument) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+	*d = doc
+	return nil
+}
+
+// GetRaw sends the request for r and returns the response as a
+// RawDocument instead of the endpoint's typed struct, for output that must
+// reproduce the API's numbers exactly.
+func (c *Client) GetRaw(r RawRequest) (RawDocument, error) {
+	path, params := r.request()
+
+	var result RawDocument
+	if err := c.get(path, params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestGetRawPreservesNumbers verifies large integers and formatted
+// decimals re-encode exactly as the API sent them, where the typed
+// response rounds them through float64.
+func TestGetRawPreservesNumbers(t *testing.T) {
+	const body = `{"request_id":"abc","results":[{"free_float":9007199254740993,"free_float_percent":81.50,"ticker":"AAPL"}],"status":"OK"}`
+	server := mockServer(t, map[string]string{"/stocks/vX/float": body})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	params := FloatParams{Ticker: "AAPL"}
+
+	raw, err := client.GetRaw(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != body {
+		t.Errorf("GetRaw re-encoded as\n%s\nwant\n%s", out, body)
+	}
+
+	typed, err := client.GetFloat(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	typedOut, _ := json.Marshal(typed)
+	if strings.Contains(string(typedOut), "81.50") {
+		t.Errorf("expected the typed decode to normalize 81.50, got %s", typedOut)
+	}
+}
+
+// TestGetRawRequest verifies GetRaw sends the same path and query as the
+// typed method.
+func TestGetRawRequest(t *testing.T) {
+	server := mockServer(t, map[string]string{"/stocks/financials/v1/balance-sheets": `{"status":"OK","results":[]}`})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	var printed strings.Builder
+	client.SetPrintRequest(&printed)
+	params := BalanceSheetsParams{Tickers: "AAPL", Timeframe: "annual", Limit: "2"}
+	client.GetRaw(params)
+	client.GetBalanceSheets(params)
+
+	lines := strings.Split(strings.TrimSpace(printed.String()), "\n")
+	if len(lines) != 2 || lines[0] != lines[1] {
+		t.Errorf("expected identical requests, got %q", lines)
+	}
+	if !strings.Contains(lines[0], "/stocks/financials/v1/balance-sheets?") || !strings.Contains(lines[0], "timeframe=annual") {
+		t.Errorf("unexpected request %s", lines[0])
+	}
+}
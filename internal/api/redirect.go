@@ -0,0 +1,105 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// DefaultMaxRedirects is how many redirects a request follows before
+// failing, unless changed with SetRedirectPolicy.
+const DefaultMaxRedirects = 10
+
+// apiRequestKey marks the context of requests that carry the API key,
+// so redirects re-attach it only to those and never to webhook posts,
+// which share the same http.Client.
+type apiRequestKey struct{}
+
+// SetRedirectPolicy controls how 3xx responses are handled. With follow
+// false, or a max of 0 or less, the redirect response itself is returned,
+// surfacing as an *APIError with the 3xx status. Otherwise up to max
+// redirects are followed.
+func (c *Client) SetRedirectPolicy(follow bool, max int) {
+	c.noRedirects = !follow || max <= 0
+	c.maxRedirects = max
+}
+
+// SetRedirectHosts lists hosts, besides the base URL's, that followed
+// redirects may carry the API key to, such as a CDN the API hands large
+// payloads off to. Hosts are compared as host[:port], ignoring case.
+func (c *Client) SetRedirectHosts(hosts []string) {
+	c.redirectHosts = nil
+	for _, h := range hosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			c.redirectHosts = append(c.redirectHosts, h)
+		}
+	}
+}
+
+// trustedRedirectHost reports whether the API key may be sent to u: its
+// host is the base URL's or one listed with SetRedirectHosts.
+func (c *Client) trustedRedirectHost(u *url.URL) bool {
+	host := strings.ToLower(u.Host)
+	if base, err := url.Parse(c.baseURL); err == nil && strings.ToLower(base.Host) == host {
+		return true
+	}
+	return slices.Contains(c.redirectHosts, host)
+}
+
+// checkRedirect is the http.Client's CheckRedirect hook. net/http drops
+// the Authorization header when a redirect leaves the original host, and
+// a Location without the apiKey parameter loses query auth, so the key is
+// attached again to followed API redirects to trusted hosts (see
+// trustedRedirectHost). Redirects anywhere else are followed with the key
+// stripped from the query and headers, and a redirect from https down to
+// plain http is refused rather than risk sending the key in the clear.
+func (c *Client) checkRedirect(req *http.Request, via []*http.Request) error {
+	if c.noRedirects {
+		return http.ErrUseLastResponse
+	}
+
+	if len(via) > c.maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", c.maxRedirects)
+	}
+
+	if req.Context().Value(apiRequestKey{}) == nil {
+		return nil
+	}
+
+	if via[0].URL.Scheme == "https" && req.URL.Scheme != "https" {
+		return fmt.Errorf("refusing to follow redirect from https to %s://%s with the API key", req.URL.Scheme, req.URL.Host)
+	}
+
+	q := req.URL.Query()
+	if !c.trustedRedirectHost(req.URL) {
+		if q.Has("apiKey") {
+			q.Del("apiKey")
+			req.URL.RawQuery = q.Encode()
+		}
+		req.Header.Del("Authorization")
+		req.Header.Del(c.authHeaderName())
+		return nil
+	}
+
+	if c.AuthMode() == AuthQuery && q.Get("apiKey") == "" {
+		c.authorizeQuery(q)
+		req.URL.RawQuery = q.Encode()
+	}
+	c.authorizeRequest(req)
+
+	return nil
+}
+
+// withAPIRequest marks ctx as belonging to a request that carries the API
+// key; see checkRedirect.
+func withAPIRequest(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiRequestKey{}, true)
+}
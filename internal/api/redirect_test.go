@@ -0,0 +1,229 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// redirectStub serves a final endpoint on one server that records the
+// auth it received, and a redirecting endpoint on a second server (a
+// different host:port, so net/http treats it as cross-host) pointing at it.
+func redirectStub(t *testing.T) (origin, target *httptest.Server, gotQuery, gotAuth *string) {
+	t.Helper()
+	var query, auth string
+
+	target = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("apiKey")
+		auth = r.Header.Get("Authorization") + r.Header.Get(DefaultAuthHeader)
+		w.Write([]byte(`{"status":"OK"}`))
+	}))
+	t.Cleanup(target.Close)
+
+	origin = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL+"/cdn/payload", http.StatusFound)
+	}))
+	t.Cleanup(origin.Close)
+
+	return origin, target, &query, &auth
+}
+
+// TestRedirectKeepsAuth verifies the API key survives a cross-host
+// redirect to an allowed host in every auth mode.
+func TestRedirectKeepsAuth(t *testing.T) {
+	tests := []struct {
+		mode      AuthMode
+		wantQuery string
+		wantAuth  string
+	}{
+		{AuthQuery, "secret", ""},
+		{AuthBearer, "", "Bearer secret"},
+		{AuthHeader, "", "secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			origin, target, gotQuery, gotAuth := redirectStub(t)
+			client := NewClient("secret")
+			client.SetBaseURL(origin.URL)
+			client.SetRedirectHosts([]string{strings.TrimPrefix(target.URL, "http://")})
+			client.SetAuthMode(tt.mode)
+
+			var result map[string]any
+			if err := client.get("/v2/aggs/ticker/AAPL", nil, &result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *gotQuery != tt.wantQuery || *gotAuth != tt.wantAuth {
+				t.Errorf("target saw apiKey %q and auth %q, want %q and %q", *gotQuery, *gotAuth, tt.wantQuery, tt.wantAuth)
+			}
+		})
+	}
+}
+
+// TestRedirectStripsKeyForOtherHosts verifies a redirect to a host that
+// is neither the base URL's nor allowed arrives without the key in any
+// auth mode, even when the Location itself carries an apiKey.
+func TestRedirectStripsKeyForOtherHosts(t *testing.T) {
+	for _, mode := range []AuthMode{AuthQuery, AuthBearer, AuthHeader} {
+		t.Run(string(mode), func(t *testing.T) {
+			var query, auth, custom string
+			other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query().Get("apiKey")
+				auth = r.Header.Get("Authorization")
+				custom = r.Header.Get(DefaultAuthHeader)
+				w.Write([]byte(`{"status":"OK"}`))
+			}))
+			defer other.Close()
+			origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, other.URL+"/elsewhere?"+r.URL.RawQuery, http.StatusFound)
+			}))
+			defer origin.Close()
+
+			client := NewClient("secret")
+			client.SetBaseURL(origin.URL)
+			client.SetAuthMode(mode)
+
+			var result map[string]any
+			if err := client.get("/v2/aggs/ticker/AAPL", nil, &result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query != "" || auth != "" || custom != "" {
+				t.Errorf("other host saw apiKey %q, Authorization %q, %s %q; want no key", query, auth, DefaultAuthHeader, custom)
+			}
+		})
+	}
+}
+
+// TestRedirectSameHostKeepsAuth verifies a redirect within the base URL's
+// host keeps the key without any allowlist.
+func TestRedirectSameHostKeepsAuth(t *testing.T) {
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			auth = r.Header.Get("Authorization")
+			w.Write([]byte(`{"status":"OK"}`))
+			return
+		}
+		http.Redirect(w, r, "/moved", http.StatusFound)
+	}))
+	defer server.Close()
+
+	client := NewClient("secret")
+	client.SetBaseURL(server.URL)
+	client.SetAuthMode(AuthBearer)
+
+	var result map[string]any
+	if err := client.get("/v2/aggs/ticker/AAPL", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth != "Bearer secret" {
+		t.Errorf("same-host redirect saw auth %q, want Bearer secret", auth)
+	}
+}
+
+// TestRedirectPolicyOff verifies that with redirects disabled the 3xx is
+// returned as an APIError and the target is never contacted.
+func TestRedirectPolicyOff(t *testing.T) {
+	origin, _, gotQuery, _ := redirectStub(t)
+	client := newTestClient(origin.URL)
+	client.SetRedirectPolicy(false, 0)
+
+	var result map[string]any
+	err := client.get("/v2/aggs/ticker/AAPL", nil, &result)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusFound {
+		t.Fatalf("expected a 302 APIError, got %v", err)
+	}
+	if *gotQuery != "" {
+		t.Errorf("expected the redirect not to be followed")
+	}
+}
+
+// TestRedirectPolicyZeroMax verifies --max-redirects 0 follows no
+// redirects instead of falling back to the default limit.
+func TestRedirectPolicyZeroMax(t *testing.T) {
+	origin, _, gotQuery, _ := redirectStub(t)
+	client := newTestClient(origin.URL)
+	client.SetRedirectPolicy(true, 0)
+
+	var result map[string]any
+	err := client.get("/v2/aggs/ticker/AAPL", nil, &result)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusFound {
+		t.Fatalf("expected a 302 APIError, got %v", err)
+	}
+	if *gotQuery != "" {
+		t.Errorf("expected the redirect not to be followed")
+	}
+}
+
+// TestRedirectLimit verifies a redirect loop stops at the configured
+// maximum.
+func TestRedirectLimit(t *testing.T) {
+	hops := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hops++
+		http.Redirect(w, r, r.URL.Path, http.StatusFound)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.SetRedirectPolicy(true, 3)
+
+	var result map[string]any
+	err := client.get("/loop", nil, &result)
+	if err == nil || !strings.Contains(err.Error(), "stopped after 3 redirects") {
+		t.Fatalf("expected the redirect limit error, got %v", err)
+	}
+	if hops != 4 {
+		t.Errorf("expected the original request and 3 redirects, got %d requests", hops)
+	}
+}
+
+// TestRedirectRefusesDowngrade verifies the key is not sent when an https
+// request is redirected to plain http.
+func TestRedirectRefusesDowngrade(t *testing.T) {
+	plain, _, gotQuery, _ := redirectStub(t)
+	secure := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, plain.URL, http.StatusFound)
+	}))
+	defer secure.Close()
+
+	client := newTestClient(secure.URL)
+	client.httpClient.Transport = secure.Client().Transport
+
+	var result map[string]any
+	err := client.get("/v2/aggs/ticker/AAPL", nil, &result)
+	if err == nil || !strings.Contains(err.Error(), "refusing to follow redirect from https") {
+		t.Fatalf("expected the downgrade to be refused, got %v", err)
+	}
+	if *gotQuery != "" {
+		t.Errorf("expected the key not to reach the http server")
+	}
+}
+
+// TestRedirectWebhookNoKey verifies redirects of requests that never
+// carried the key, such as webhook posts, do not gain it.
+func TestRedirectWebhookNoKey(t *testing.T) {
+	origin, _, gotQuery, gotAuth := redirectStub(t)
+	client := newTestClient(origin.URL)
+	client.SetAuthMode(AuthBearer)
+
+	req, _ := http.NewRequest(http.MethodGet, origin.URL, nil)
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if *gotQuery != "" || *gotAuth != "" {
+		t.Errorf("expected no key on the redirected request, got apiKey %q auth %q", *gotQuery, *gotAuth)
+	}
+}
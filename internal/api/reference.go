@@ -0,0 +1,49 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+// TickerType represents a single ticker type code (e.g. CS, ETF, ADRC)
+// with its human-readable description, asset class, and locale. These
+// codes decode the "type" field returned by the tickers endpoints.
+type TickerType struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	AssetClass  string `json:"asset_class"`
+	Locale      string `json:"locale"`
+}
+
+// TickerTypesResponse represents the API response from the reference
+// ticker types endpoint (/v3/reference/tickers/types).
+type TickerTypesResponse struct {
+	Status    string       `json:"status"`
+	RequestID string       `json:"request_id"`
+	Count     int          `json:"count"`
+	Results   []TickerType `json:"results"`
+}
+
+// GetTickerTypes retrieves the list of ticker type codes supported by the
+// API, optionally filtered by asset class (stocks, options, crypto, fx,
+// indices) and locale (us, global). A locale the asset class is not
+// available in is rejected before the request is sent.
+func (c *Client) GetTickerTypes(assetClass, locale string) (*TickerTypesResponse, error) {
+	path := "/v3/reference/tickers/types"
+
+	if err := ValidateLocale(assetClass, locale); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"asset_class": assetClass,
+		"locale":      locale,
+	}
+
+	var result TickerTypesResponse
+	if err := c.get(path, params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
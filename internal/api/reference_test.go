@@ -0,0 +1,126 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const tickerTypesJSON = `{
+	"status": "OK",
+	"request_id": "ticker-types-123",
+	"count": 3,
+	"results": [
+		{
+			"code": "CS",
+			"description": "Common Stock",
+			"asset_class": "stocks",
+			"locale": "us"
+		},
+		{
+			"code": "ETF",
+			"description": "Exchange Traded Fund",
+			"asset_class": "stocks",
+			"locale": "us"
+		},
+		{
+			"code": "ADRC",
+			"description": "American Depository Receipt Common",
+			"asset_class": "stocks",
+			"locale": "us"
+		}
+	]
+}`
+
+// TestGetTickerTypes verifies that GetTickerTypes correctly parses the
+// list of ticker type codes and descriptions.
+func TestGetTickerTypes(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v3/reference/tickers/types": tickerTypesJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetTickerTypes("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Status != "OK" {
+		t.Errorf("expected status OK, got %s", result.Status)
+	}
+
+	if result.Count != 3 {
+		t.Errorf("expected count 3, got %d", result.Count)
+	}
+
+	if len(result.Results) != 3 {
+		t.Fatalf("expected 3 ticker types, got %d", len(result.Results))
+	}
+
+	first := result.Results[0]
+	if first.Code != "CS" {
+		t.Errorf("expected code CS, got %s", first.Code)
+	}
+
+	if first.Description != "Common Stock" {
+		t.Errorf("expected description Common Stock, got %s", first.Description)
+	}
+
+	if first.AssetClass != "stocks" {
+		t.Errorf("expected asset_class stocks, got %s", first.AssetClass)
+	}
+
+	if first.Locale != "us" {
+		t.Errorf("expected locale us, got %s", first.Locale)
+	}
+
+	if result.Results[2].Code != "ADRC" {
+		t.Errorf("expected third code ADRC, got %s", result.Results[2].Code)
+	}
+}
+
+// TestGetTickerTypesQueryParams verifies that the asset class and locale
+// filters are sent as query parameters.
+func TestGetTickerTypesQueryParams(t *testing.T) {
+	var assetClass, locale string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assetClass = r.URL.Query().Get("asset_class")
+		locale = r.URL.Query().Get("locale")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(tickerTypesJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if _, err := client.GetTickerTypes("stocks", "us"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if assetClass != "stocks" {
+		t.Errorf("expected asset_class=stocks, got %s", assetClass)
+	}
+
+	if locale != "us" {
+		t.Errorf("expected locale=us, got %s", locale)
+	}
+}
+
+// TestGetTickerTypesAPIError verifies that GetTickerTypes returns an
+// error when the API responds with a non-200 status code.
+func TestGetTickerTypesAPIError(t *testing.T) {
+	server := mockServer(t, map[string]string{})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.GetTickerTypes("stocks", "us")
+	if err == nil {
+		t.Fatal("expected error for 404 response, got nil")
+	}
+}
@@ -0,0 +1,117 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"strings"
+)
+
+// UniversalSnapshotSession represents the current trading session data for
+// a ticker in a unified snapshot. Fields that do not apply to a given asset
+// type (such as volume for indices) are left at their zero values.
+type UniversalSnapshotSession struct {
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"change_percent"`
+	Open          float64 `json:"open"`
+	High          float64 `json:"high"`
+	Low           float64 `json:"low"`
+	Close         float64 `json:"close"`
+	PreviousClose float64 `json:"previous_close"`
+	Volume        float64 `json:"volume"`
+}
+
+// UniversalSnapshotLastTrade represents the most recent trade reported for
+// a ticker in a unified snapshot. Only present for trade-based assets such
+// as stocks, options, and crypto.
+type UniversalSnapshotLastTrade struct {
+	Price           float64 `json:"price"`
+	Size            float64 `json:"size"`
+	Exchange        int     `json:"exchange"`
+	Conditions      []int   `json:"conditions,omitempty"`
+	SipTimestamp    int64   `json:"sip_timestamp"`
+	ParticipantTime int64   `json:"participant_timestamp"`
+	Timeframe       string  `json:"timeframe"`
+}
+
+// UniversalSnapshotLastQuote represents the most recent quote reported for
+// a ticker in a unified snapshot. Only present for quote-based assets such
+// as stocks, options, and forex.
+type UniversalSnapshotLastQuote struct {
+	Bid         float64 `json:"bid"`
+	BidSize     float64 `json:"bid_size"`
+	Ask         float64 `json:"ask"`
+	AskSize     float64 `json:"ask_size"`
+	Midpoint    float64 `json:"midpoint"`
+	LastUpdated int64   `json:"last_updated"`
+	Timeframe   string  `json:"timeframe"`
+}
+
+// UniversalSnapshot represents a single ticker's result from the unified
+// /v3/snapshot endpoint. The Type field is the discriminator that tells
+// callers which asset class the result belongs to ("stocks", "options",
+// "indices", "fx", or "crypto"), since the endpoint accepts a mixed list.
+type UniversalSnapshot struct {
+	Ticker       string                      `json:"ticker"`
+	Type         string                      `json:"type"`
+	Name         string                      `json:"name"`
+	MarketStatus string                      `json:"market_status"`
+	Timeframe    string                      `json:"timeframe"`
+	Value        float64                     `json:"value,omitempty"`
+	FMV          float64                     `json:"fmv,omitempty"`
+	LastUpdated  int64                       `json:"last_updated,omitempty"`
+	Session      *UniversalSnapshotSession   `json:"session,omitempty"`
+	LastTrade    *UniversalSnapshotLastTrade `json:"last_trade,omitempty"`
+	LastQuote    *UniversalSnapshotLastQuote `json:"last_quote,omitempty"`
+	Error        string                      `json:"error,omitempty"`
+	Message      string                      `json:"message,omitempty"`
+}
+
+// UniversalSnapshotResponse represents the API response from the unified
+// /v3/snapshot endpoint when queried with tickers from mixed asset classes.
+type UniversalSnapshotResponse struct {
+	Status    string              `json:"status"`
+	RequestID string              `json:"request_id"`
+	NextURL   string              `json:"next_url,omitempty"`
+	Results   []UniversalSnapshot `json:"results"`
+}
+
+// Price returns the best available current price for the snapshot. Index
+// results report a Value, trade-based assets use the last trade price, and
+// quote-only assets fall back to the quote midpoint, then the session close.
+func (s UniversalSnapshot) Price() float64 {
+	if s.Value != 0 {
+		return s.Value
+	}
+	if s.LastTrade != nil && s.LastTrade.Price != 0 {
+		return s.LastTrade.Price
+	}
+	if s.LastQuote != nil && s.LastQuote.Midpoint != 0 {
+		return s.LastQuote.Midpoint
+	}
+	if s.Session != nil {
+		return s.Session.Close
+	}
+	return 0
+}
+
+// GetUnifiedSnapshot retrieves snapshot data for a mixed list of tickers
+// from the unified /v3/snapshot endpoint. Tickers may span asset classes
+// using their usual prefixes (e.g. "AAPL", "X:BTCUSD", "C:EURUSD", "I:SPX",
+// "O:AAPL250117C00150000") and each result carries a Type discriminator.
+func (c *Client) GetUnifiedSnapshot(tickers []string) (*UniversalSnapshotResponse, error) {
+	path := "/v3/snapshot"
+
+	params := map[string]string{
+		"ticker.any_of": strings.Join(tickers, ","),
+	}
+
+	var result UniversalSnapshotResponse
+	if err := c.get(path, params, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
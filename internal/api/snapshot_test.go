@@ -0,0 +1,218 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const universalSnapshotJSON = `{
+	"status": "OK",
+	"request_id": "universal-snap-123",
+	"results": [
+		{
+			"ticker": "X:BTCUSD",
+			"type": "crypto",
+			"name": "Bitcoin - United States Dollar",
+			"market_status": "open",
+			"timeframe": "REAL-TIME",
+			"last_trade": {
+				"price": 97250.5,
+				"size": 0.25,
+				"exchange": 1,
+				"sip_timestamp": 1736139600000000000,
+				"timeframe": "REAL-TIME"
+			},
+			"session": {
+				"change": 1250.5,
+				"change_percent": 1.3,
+				"open": 96000.0,
+				"high": 97500.0,
+				"low": 95800.0,
+				"close": 97250.5,
+				"previous_close": 96000.0,
+				"volume": 12345.6
+			}
+		},
+		{
+			"ticker": "AAPL",
+			"type": "stocks",
+			"name": "Apple Inc.",
+			"market_status": "closed",
+			"timeframe": "DELAYED",
+			"last_trade": {
+				"price": 245.12,
+				"size": 100,
+				"exchange": 4
+			},
+			"last_quote": {
+				"bid": 245.1,
+				"ask": 245.14,
+				"midpoint": 245.12
+			},
+			"session": {
+				"change": -1.38,
+				"change_percent": -0.56,
+				"close": 245.12,
+				"previous_close": 246.5,
+				"volume": 45045571
+			}
+		},
+		{
+			"ticker": "C:EURUSD",
+			"type": "fx",
+			"name": "Euro - United States Dollar",
+			"market_status": "open",
+			"last_quote": {
+				"bid": 1.0301,
+				"ask": 1.0303,
+				"midpoint": 1.0302
+			},
+			"session": {
+				"change": 0.0012,
+				"change_percent": 0.12,
+				"close": 1.0302
+			}
+		},
+		{
+			"ticker": "I:SPX",
+			"type": "indices",
+			"name": "Standard & Poor's 500",
+			"market_status": "closed",
+			"value": 5942.47,
+			"session": {
+				"change": 12.3,
+				"change_percent": 0.21,
+				"close": 5942.47
+			}
+		},
+		{
+			"ticker": "BADTICKER",
+			"error": "NOT_FOUND",
+			"message": "Ticker not found."
+		}
+	]
+}`
+
+// TestGetUnifiedSnapshot verifies that GetUnifiedSnapshot sends the joined
+// ticker list and parses heterogeneous results with their type discriminator.
+func TestGetUnifiedSnapshot(t *testing.T) {
+	var receivedTickers string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/snapshot" {
+			t.Errorf("expected path /v3/snapshot, got %s", r.URL.Path)
+		}
+		receivedTickers = r.URL.Query().Get("ticker.any_of")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(universalSnapshotJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetUnifiedSnapshot([]string{"X:BTCUSD", "AAPL", "C:EURUSD", "I:SPX", "BADTICKER"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedTickers != "X:BTCUSD,AAPL,C:EURUSD,I:SPX,BADTICKER" {
+		t.Errorf("expected joined ticker list, got %s", receivedTickers)
+	}
+
+	if result.RequestID != "universal-snap-123" {
+		t.Errorf("expected request_id universal-snap-123, got %s", result.RequestID)
+	}
+
+	if len(result.Results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(result.Results))
+	}
+
+	expectedTypes := []string{"crypto", "stocks", "fx", "indices", ""}
+	for i, want := range expectedTypes {
+		if result.Results[i].Type != want {
+			t.Errorf("result %d: expected type %q, got %q", i, want, result.Results[i].Type)
+		}
+	}
+}
+
+// TestGetUnifiedSnapshotNestedFields verifies that the optional session,
+// last trade, and last quote objects are populated only when present.
+func TestGetUnifiedSnapshotNestedFields(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v3/snapshot": universalSnapshotJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetUnifiedSnapshot([]string{"X:BTCUSD", "AAPL", "C:EURUSD", "I:SPX", "BADTICKER"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	btc := result.Results[0]
+	if btc.LastTrade == nil || btc.LastTrade.Price != 97250.5 {
+		t.Errorf("expected BTC last trade price 97250.5, got %+v", btc.LastTrade)
+	}
+	if btc.LastQuote != nil {
+		t.Errorf("expected no BTC last quote, got %+v", btc.LastQuote)
+	}
+	if btc.Session == nil || btc.Session.ChangePercent != 1.3 {
+		t.Errorf("expected BTC session change_percent 1.3, got %+v", btc.Session)
+	}
+
+	eur := result.Results[2]
+	if eur.LastTrade != nil {
+		t.Errorf("expected no EURUSD last trade, got %+v", eur.LastTrade)
+	}
+	if eur.LastQuote == nil || eur.LastQuote.Midpoint != 1.0302 {
+		t.Errorf("expected EURUSD midpoint 1.0302, got %+v", eur.LastQuote)
+	}
+
+	bad := result.Results[4]
+	if bad.Error != "NOT_FOUND" {
+		t.Errorf("expected error NOT_FOUND, got %s", bad.Error)
+	}
+	if bad.Session != nil {
+		t.Errorf("expected nil session for errored ticker, got %+v", bad.Session)
+	}
+}
+
+// TestUniversalSnapshotPrice verifies that Price picks the right source for
+// each asset type: index value, last trade, quote midpoint, then close.
+func TestUniversalSnapshotPrice(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v3/snapshot": universalSnapshotJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetUnifiedSnapshot([]string{"X:BTCUSD", "AAPL", "C:EURUSD", "I:SPX", "BADTICKER"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{97250.5, 245.12, 1.0302, 5942.47, 0}
+	for i, want := range expected {
+		if got := result.Results[i].Price(); got != want {
+			t.Errorf("result %d (%s): expected price %v, got %v", i, result.Results[i].Ticker, want, got)
+		}
+	}
+}
+
+// TestGetUnifiedSnapshotAPIError verifies that GetUnifiedSnapshot returns
+// an error when the API responds with a non-200 status code.
+func TestGetUnifiedSnapshotAPIError(t *testing.T) {
+	server := mockServer(t, map[string]string{})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.GetUnifiedSnapshot([]string{"AAPL"})
+	if err == nil {
+		t.Fatal("expected error for 404 response, got nil")
+	}
+}
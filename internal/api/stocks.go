@@ -123,16 +123,24 @@ type TickerParams struct {
 	Exchange string
 	Search   string
 	Active   string
+	Locale   string
 	Sort     string
 	Order    string
 	Limit    string
+
+	// Date lists the tickers as of a past YYYY-MM-DD date, and
+	// IncludeOTC ("true") adds OTC equities to a stocks listing.
+	Date       string
+	IncludeOTC string
 }
 
 // MarketSummaryParams holds the query parameters for fetching a daily
-// grouped market summary.
+// grouped market summary. Locale defaults to us, the only locale US
+// stocks are grouped under.
 type MarketSummaryParams struct {
 	Adjusted   string
 	IncludeOTC string
+	Locale     string
 }
 
 // GetOpenClose retrieves the daily open, close, high, low, volume, and
@@ -174,7 +182,11 @@ func (c *Client) GetBars(ticker string, p BarsParams) (*BarsResponse, error) {
 // GetMarketSummary retrieves the grouped daily OHLC summary for all US
 // stocks on the specified date, with optional OTC inclusion.
 func (c *Client) GetMarketSummary(date string, p MarketSummaryParams) (*MarketSummaryResponse, error) {
-	path := fmt.Sprintf("/v2/aggs/grouped/locale/us/market/stocks/%s", date)
+	locale, err := groupedLocale("stocks", p.Locale)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v2/aggs/grouped/locale/%s/market/stocks/%s", locale, date)
 
 	params := map[string]string{
 		"adjusted":    p.Adjusted,
@@ -190,20 +202,35 @@ func (c *Client) GetMarketSummary(date string, p MarketSummaryParams) (*MarketSu
 }
 
 // GetTickers retrieves a list of stock tickers matching the filter
-// criteria specified in the TickerParams.
+// criteria specified in the TickerParams. A locale the market is not
+// available in is rejected before the request is sent.
 func (c *Client) GetTickers(p TickerParams) (*TickersResponse, error) {
 	path := "/v3/reference/tickers"
 
+	if err := ValidateLocale(p.Market, p.Locale); err != nil {
+		return nil, err
+	}
+	if err := ValidateTickerFilters(p.Market, p.Date, p.IncludeOTC == "true"); err != nil {
+		return nil, err
+	}
+	active, err := activeFilter(p.Active)
+	if err != nil {
+		return nil, err
+	}
+
 	params := map[string]string{
-		"ticker":   p.Ticker,
-		"type":     p.Type,
-		"market":   p.Market,
-		"exchange": p.Exchange,
-		"search":   p.Search,
-		"active":   p.Active,
-		"sort":     p.Sort,
-		"order":    p.Order,
-		"limit":    p.Limit,
+		"ticker":      p.Ticker,
+		"type":        p.Type,
+		"market":      p.Market,
+		"exchange":    p.Exchange,
+		"search":      p.Search,
+		"active":      active,
+		"date":        p.Date,
+		"include_otc": p.IncludeOTC,
+		"locale":      p.Locale,
+		"sort":        p.Sort,
+		"order":       p.Order,
+		"limit":       p.Limit,
 	}
 
 	var result TickersResponse
@@ -213,3 +240,42 @@ func (c *Client) GetTickers(p TickerParams) (*TickersResponse, error) {
 
 	return &result, nil
 }
+
+// GetTickersNext retrieves the next page of reference tickers by following
+// the next_url returned in a previous TickersResponse.
+func (c *Client) GetTickersNext(nextURL string) (*TickersResponse, error) {
+	var result TickersResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RelatedCompany is one ticker the API considers related to another,
+// based on news and returns data.
+type RelatedCompany struct {
+	Ticker string `json:"ticker"`
+}
+
+// RelatedCompaniesResponse represents the API response listing the
+// companies related to a stock ticker.
+type RelatedCompaniesResponse struct {
+	Status      string           `json:"status"`
+	RequestID   string           `json:"request_id"`
+	StockSymbol string           `json:"stock_symbol"`
+	Results     []RelatedCompany `json:"results"`
+}
+
+// GetRelatedCompanies retrieves the tickers related to a stock ticker,
+// such as peers and competitors, from the related companies endpoint.
+func (c *Client) GetRelatedCompanies(ticker string) (*RelatedCompaniesResponse, error) {
+	path := fmt.Sprintf("/v1/related-companies/%s", ticker)
+
+	var result RelatedCompaniesResponse
+	if err := c.get(path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
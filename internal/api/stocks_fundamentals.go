@@ -43,14 +43,7 @@ type ShortInterestParams struct {
 // data includes short interest counts, average daily volume, and
 // estimated days to cover all short positions.
 func (c *Client) GetShortInterest(p ShortInterestParams) (*ShortInterestResponse, error) {
-	path := "/stocks/v1/short-interest"
-
-	params := map[string]string{
-		"ticker":          p.Ticker,
-		"settlement_date": p.SettlementDate,
-		"limit":           p.Limit,
-		"sort":            p.Sort,
-	}
+	path, params := p.request()
 
 	var result ShortInterestResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -60,6 +53,17 @@ func (c *Client) GetShortInterest(p ShortInterestParams) (*ShortInterestResponse
 	return &result, nil
 }
 
+// request returns the short interest endpoint path and query parameters for
+// p, shared by GetShortInterest and GetRaw.
+func (p ShortInterestParams) request() (string, map[string]string) {
+	return "/stocks/v1/short-interest", map[string]string{
+		"ticker":          p.Ticker,
+		"settlement_date": p.SettlementDate,
+		"limit":           p.Limit,
+		"sort":            p.Sort,
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Short Volume
 // ---------------------------------------------------------------------------
@@ -107,14 +111,7 @@ type ShortVolumeParams struct {
 // reported to FINRA from off-exchange trading venues and alternative
 // trading systems for the specified ticker and date filters.
 func (c *Client) GetShortVolume(p ShortVolumeParams) (*ShortVolumeResponse, error) {
-	path := "/stocks/v1/short-volume"
-
-	params := map[string]string{
-		"ticker": p.Ticker,
-		"date":   p.Date,
-		"limit":  p.Limit,
-		"sort":   p.Sort,
-	}
+	path, params := p.request()
 
 	var result ShortVolumeResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -124,6 +121,17 @@ func (c *Client) GetShortVolume(p ShortVolumeParams) (*ShortVolumeResponse, erro
 	return &result, nil
 }
 
+// request returns the short volume endpoint path and query parameters for
+// p, shared by GetShortVolume and GetRaw.
+func (p ShortVolumeParams) request() (string, map[string]string) {
+	return "/stocks/v1/short-volume", map[string]string{
+		"ticker": p.Ticker,
+		"date":   p.Date,
+		"limit":  p.Limit,
+		"sort":   p.Sort,
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Float
 // ---------------------------------------------------------------------------
@@ -159,13 +167,7 @@ type FloatParams struct {
 // public trading after excluding strategic holdings, insider positions,
 // and restricted shares.
 func (c *Client) GetFloat(p FloatParams) (*FloatResponse, error) {
-	path := "/stocks/vX/float"
-
-	params := map[string]string{
-		"ticker": p.Ticker,
-		"limit":  p.Limit,
-		"sort":   p.Sort,
-	}
+	path, params := p.request()
 
 	var result FloatResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -175,6 +177,16 @@ func (c *Client) GetFloat(p FloatParams) (*FloatResponse, error) {
 	return &result, nil
 }
 
+// request returns the float endpoint path and query parameters for
+// p, shared by GetFloat and GetRaw.
+func (p FloatParams) request() (string, map[string]string) {
+	return "/stocks/vX/float", map[string]string{
+		"ticker": p.Ticker,
+		"limit":  p.Limit,
+		"sort":   p.Sort,
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Balance Sheets
 // ---------------------------------------------------------------------------
@@ -189,7 +201,9 @@ type BalanceSheetsResponse struct {
 }
 
 // BalanceSheet represents a single balance sheet filing with assets,
-// liabilities, and equity data for a specific reporting period.
+// liabilities, and equity data for a specific reporting period. Line items
+// are pointers so an item the filing omits (nil) can be told apart from
+// one reported as zero.
 type BalanceSheet struct {
 	CIK                                    string   `json:"cik"`
 	Tickers                                []string `json:"tickers"`
@@ -198,38 +212,38 @@ type BalanceSheet struct {
 	FiscalYear                             int      `json:"fiscal_year"`
 	FiscalQuarter                          int      `json:"fiscal_quarter"`
 	Timeframe                              string   `json:"timeframe"`
-	TotalAssets                            float64  `json:"total_assets"`
-	TotalCurrentAssets                     float64  `json:"total_current_assets"`
-	TotalLiabilities                       float64  `json:"total_liabilities"`
-	TotalCurrentLiabilities                float64  `json:"total_current_liabilities"`
-	TotalEquity                            float64  `json:"total_equity"`
-	TotalEquityAttributableToParent        float64  `json:"total_equity_attributable_to_parent"`
-	TotalLiabilitiesAndEquity              float64  `json:"total_liabilities_and_equity"`
-	CashAndEquivalents                     float64  `json:"cash_and_equivalents"`
-	ShortTermInvestments                   float64  `json:"short_term_investments"`
-	Receivables                            float64  `json:"receivables"`
-	Inventories                            float64  `json:"inventories"`
-	OtherCurrentAssets                     float64  `json:"other_current_assets"`
-	PropertyPlantEquipmentNet              float64  `json:"property_plant_equipment_net"`
-	Goodwill                               float64  `json:"goodwill"`
-	IntangibleAssetsNet                    float64  `json:"intangible_assets_net"`
-	OtherAssets                            float64  `json:"other_assets"`
-	AccountsPayable                        float64  `json:"accounts_payable"`
-	AccruedAndOtherCurrentLiabilities      float64  `json:"accrued_and_other_current_liabilities"`
-	DeferredRevenueCurrent                 float64  `json:"deferred_revenue_current"`
-	DebtCurrent                            float64  `json:"debt_current"`
-	LongTermDebtAndCapitalLeaseObligations float64  `json:"long_term_debt_and_capital_lease_obligations"`
-	DeferredRevenueNoncurrent              float64  `json:"deferred_revenue_noncurrent"`
-	OtherNoncurrentLiabilities             float64  `json:"other_noncurrent_liabilities"`
-	CommitmentsAndContingencies            float64  `json:"commitments_and_contingencies"`
-	CommonStock                            float64  `json:"common_stock"`
-	PreferredStock                         float64  `json:"preferred_stock"`
-	AdditionalPaidInCapital                float64  `json:"additional_paid_in_capital"`
-	RetainedEarningsDeficit                float64  `json:"retained_earnings_deficit"`
-	AccumulatedOtherComprehensiveIncome    float64  `json:"accumulated_other_comprehensive_income"`
-	OtherEquity                            float64  `json:"other_equity"`
-	TreasuryStock                          float64  `json:"treasury_stock"`
-	NoncontrollingInterest                 float64  `json:"noncontrolling_interest"`
+	TotalAssets                            *float64 `json:"total_assets"`
+	TotalCurrentAssets                     *float64 `json:"total_current_assets"`
+	TotalLiabilities                       *float64 `json:"total_liabilities"`
+	TotalCurrentLiabilities                *float64 `json:"total_current_liabilities"`
+	TotalEquity                            *float64 `json:"total_equity"`
+	TotalEquityAttributableToParent        *float64 `json:"total_equity_attributable_to_parent"`
+	TotalLiabilitiesAndEquity              *float64 `json:"total_liabilities_and_equity"`
+	CashAndEquivalents                     *float64 `json:"cash_and_equivalents"`
+	ShortTermInvestments                   *float64 `json:"short_term_investments"`
+	Receivables                            *float64 `json:"receivables"`
+	Inventories                            *float64 `json:"inventories"`
+	OtherCurrentAssets                     *float64 `json:"other_current_assets"`
+	PropertyPlantEquipmentNet              *float64 `json:"property_plant_equipment_net"`
+	Goodwill                               *float64 `json:"goodwill"`
+	IntangibleAssetsNet                    *float64 `json:"intangible_assets_net"`
+	OtherAssets                            *float64 `json:"other_assets"`
+	AccountsPayable                        *float64 `json:"accounts_payable"`
+	AccruedAndOtherCurrentLiabilities      *float64 `json:"accrued_and_other_current_liabilities"`
+	DeferredRevenueCurrent                 *float64 `json:"deferred_revenue_current"`
+	DebtCurrent                            *float64 `json:"debt_current"`
+	LongTermDebtAndCapitalLeaseObligations *float64 `json:"long_term_debt_and_capital_lease_obligations"`
+	DeferredRevenueNoncurrent              *float64 `json:"deferred_revenue_noncurrent"`
+	OtherNoncurrentLiabilities             *float64 `json:"other_noncurrent_liabilities"`
+	CommitmentsAndContingencies            *float64 `json:"commitments_and_contingencies"`
+	CommonStock                            *float64 `json:"common_stock"`
+	PreferredStock                         *float64 `json:"preferred_stock"`
+	AdditionalPaidInCapital                *float64 `json:"additional_paid_in_capital"`
+	RetainedEarningsDeficit                *float64 `json:"retained_earnings_deficit"`
+	AccumulatedOtherComprehensiveIncome    *float64 `json:"accumulated_other_comprehensive_income"`
+	OtherEquity                            *float64 `json:"other_equity"`
+	TreasuryStock                          *float64 `json:"treasury_stock"`
+	NoncontrollingInterest                 *float64 `json:"noncontrolling_interest"`
 }
 
 // BalanceSheetsParams holds the query parameters for fetching balance
@@ -247,15 +261,7 @@ type BalanceSheetsParams struct {
 // includes assets, liabilities, and equity breakdowns sourced from SEC
 // filings.
 func (c *Client) GetBalanceSheets(p BalanceSheetsParams) (*BalanceSheetsResponse, error) {
-	path := "/stocks/financials/v1/balance-sheets"
-
-	params := map[string]string{
-		"tickers":   p.Tickers,
-		"cik":       p.CIK,
-		"timeframe": p.Timeframe,
-		"limit":     p.Limit,
-		"sort":      p.Sort,
-	}
+	path, params := p.request()
 
 	var result BalanceSheetsResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -265,6 +271,18 @@ func (c *Client) GetBalanceSheets(p BalanceSheetsParams) (*BalanceSheetsResponse
 	return &result, nil
 }
 
+// request returns the balance sheets endpoint path and query parameters for
+// p, shared by GetBalanceSheets and GetRaw.
+func (p BalanceSheetsParams) request() (string, map[string]string) {
+	return "/stocks/financials/v1/balance-sheets", map[string]string{
+		"tickers":   p.Tickers,
+		"cik":       p.CIK,
+		"timeframe": p.Timeframe,
+		"limit":     p.Limit,
+		"sort":      p.Sort,
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Income Statements
 // ---------------------------------------------------------------------------
@@ -280,41 +298,42 @@ type IncomeStatementsResponse struct {
 
 // IncomeStatement represents a single income statement filing with
 // revenue, expense, and earnings data for a specific reporting period.
+// Line items are nil when absent from the filing rather than zero.
 type IncomeStatement struct {
-	CIK                                     string   `json:"cik"`
-	Tickers                                 []string `json:"tickers"`
-	PeriodEnd                               string   `json:"period_end"`
-	FilingDate                              string   `json:"filing_date"`
-	FiscalYear                              int      `json:"fiscal_year"`
-	FiscalQuarter                           int      `json:"fiscal_quarter"`
-	Timeframe                               string   `json:"timeframe"`
-	Revenue                                 float64  `json:"revenue"`
-	CostOfRevenue                           float64  `json:"cost_of_revenue"`
-	GrossProfit                             float64  `json:"gross_profit"`
-	TotalOperatingExpenses                  float64  `json:"total_operating_expenses"`
-	OperatingIncome                         float64  `json:"operating_income"`
-	InterestIncome                          float64  `json:"interest_income"`
-	InterestExpense                         float64  `json:"interest_expense"`
-	OtherIncomeExpense                      float64  `json:"other_income_expense"`
-	IncomeBeforeIncomeTaxes                 float64  `json:"income_before_income_taxes"`
-	IncomeTaxes                             float64  `json:"income_taxes"`
-	ConsolidatedNetIncomeLoss               float64  `json:"consolidated_net_income_loss"`
-	NetIncomeLossAttributableCommonShareholders float64 `json:"net_income_loss_attributable_common_shareholders"`
-	BasicEarningsPerShare                   float64  `json:"basic_earnings_per_share"`
-	DilutedEarningsPerShare                 float64  `json:"diluted_earnings_per_share"`
-	BasicSharesOutstanding                  float64  `json:"basic_shares_outstanding"`
-	DilutedSharesOutstanding                float64  `json:"diluted_shares_outstanding"`
-	EBITDA                                  float64  `json:"ebitda"`
-	DepreciationDepletionAmortization       float64  `json:"depreciation_depletion_amortization"`
-	ResearchDevelopment                     float64  `json:"research_development"`
-	SellingGeneralAdministrative            float64  `json:"selling_general_administrative"`
-	OtherOperatingExpenses                  float64  `json:"other_operating_expenses"`
-	DiscontinuedOperations                  float64  `json:"discontinued_operations"`
-	ExtraordinaryItems                      float64  `json:"extraordinary_items"`
-	EquityInAffiliates                      float64  `json:"equity_in_affiliates"`
-	NoncontrollingInterest                  float64  `json:"noncontrolling_interest"`
-	PreferredStockDividendsDeclared         float64  `json:"preferred_stock_dividends_declared"`
-	TotalOtherIncomeExpense                 float64  `json:"total_other_income_expense"`
+	CIK                                         string   `json:"cik"`
+	Tickers                                     []string `json:"tickers"`
+	PeriodEnd                                   string   `json:"period_end"`
+	FilingDate                                  string   `json:"filing_date"`
+	FiscalYear                                  int      `json:"fiscal_year"`
+	FiscalQuarter                               int      `json:"fiscal_quarter"`
+	Timeframe                                   string   `json:"timeframe"`
+	Revenue                                     *float64 `json:"revenue"`
+	CostOfRevenue                               *float64 `json:"cost_of_revenue"`
+	GrossProfit                                 *float64 `json:"gross_profit"`
+	TotalOperatingExpenses                      *float64 `json:"total_operating_expenses"`
+	OperatingIncome                             *float64 `json:"operating_income"`
+	InterestIncome                              *float64 `json:"interest_income"`
+	InterestExpense                             *float64 `json:"interest_expense"`
+	OtherIncomeExpense                          *float64 `json:"other_income_expense"`
+	IncomeBeforeIncomeTaxes                     *float64 `json:"income_before_income_taxes"`
+	IncomeTaxes                                 *float64 `json:"income_taxes"`
+	ConsolidatedNetIncomeLoss                   *float64 `json:"consolidated_net_income_loss"`
+	NetIncomeLossAttributableCommonShareholders *float64 `json:"net_income_loss_attributable_common_shareholders"`
+	BasicEarningsPerShare                       *float64 `json:"basic_earnings_per_share"`
+	DilutedEarningsPerShare                     *float64 `json:"diluted_earnings_per_share"`
+	BasicSharesOutstanding                      *float64 `json:"basic_shares_outstanding"`
+	DilutedSharesOutstanding                    *float64 `json:"diluted_shares_outstanding"`
+	EBITDA                                      *float64 `json:"ebitda"`
+	DepreciationDepletionAmortization           *float64 `json:"depreciation_depletion_amortization"`
+	ResearchDevelopment                         *float64 `json:"research_development"`
+	SellingGeneralAdministrative                *float64 `json:"selling_general_administrative"`
+	OtherOperatingExpenses                      *float64 `json:"other_operating_expenses"`
+	DiscontinuedOperations                      *float64 `json:"discontinued_operations"`
+	ExtraordinaryItems                          *float64 `json:"extraordinary_items"`
+	EquityInAffiliates                          *float64 `json:"equity_in_affiliates"`
+	NoncontrollingInterest                      *float64 `json:"noncontrolling_interest"`
+	PreferredStockDividendsDeclared             *float64 `json:"preferred_stock_dividends_declared"`
+	TotalOtherIncomeExpense                     *float64 `json:"total_other_income_expense"`
 }
 
 // IncomeStatementsParams holds the query parameters for fetching income
@@ -332,15 +351,7 @@ type IncomeStatementsParams struct {
 // net income. Supports quarterly, annual, and trailing twelve-month
 // timeframes sourced from SEC filings.
 func (c *Client) GetIncomeStatements(p IncomeStatementsParams) (*IncomeStatementsResponse, error) {
-	path := "/stocks/financials/v1/income-statements"
-
-	params := map[string]string{
-		"tickers":   p.Tickers,
-		"cik":       p.CIK,
-		"timeframe": p.Timeframe,
-		"limit":     p.Limit,
-		"sort":      p.Sort,
-	}
+	path, params := p.request()
 
 	var result IncomeStatementsResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -350,6 +361,18 @@ func (c *Client) GetIncomeStatements(p IncomeStatementsParams) (*IncomeStatement
 	return &result, nil
 }
 
+// request returns the income statements endpoint path and query parameters for
+// p, shared by GetIncomeStatements and GetRaw.
+func (p IncomeStatementsParams) request() (string, map[string]string) {
+	return "/stocks/financials/v1/income-statements", map[string]string{
+		"tickers":   p.Tickers,
+		"cik":       p.CIK,
+		"timeframe": p.Timeframe,
+		"limit":     p.Limit,
+		"sort":      p.Sort,
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Cash Flow Statements
 // ---------------------------------------------------------------------------
@@ -364,7 +387,8 @@ type CashFlowStatementsResponse struct {
 }
 
 // CashFlowStatement represents a single cash flow statement filing with
-// operating, investing, and financing cash flow breakdowns.
+// operating, investing, and financing cash flow breakdowns. Line items are
+// nil when absent from the filing rather than zero.
 type CashFlowStatement struct {
 	CIK                                                string   `json:"cik"`
 	Tickers                                            []string `json:"tickers"`
@@ -373,31 +397,31 @@ type CashFlowStatement struct {
 	FiscalYear                                         int      `json:"fiscal_year"`
 	FiscalQuarter                                      int      `json:"fiscal_quarter"`
 	Timeframe                                          string   `json:"timeframe"`
-	NetCashFromOperatingActivities                     float64  `json:"net_cash_from_operating_activities"`
-	CashFromOperatingActivitiesContinuingOperations    float64  `json:"cash_from_operating_activities_continuing_operations"`
-	NetCashFromOperatingActivitiesDiscontinued          float64  `json:"net_cash_from_operating_activities_discontinued_operations"`
-	NetCashFromInvestingActivities                     float64  `json:"net_cash_from_investing_activities"`
-	NetCashFromInvestingActivitiesContinuingOperations  float64  `json:"net_cash_from_investing_activities_continuing_operations"`
-	NetCashFromInvestingActivitiesDiscontinued          float64  `json:"net_cash_from_investing_activities_discontinued_operations"`
-	NetCashFromFinancingActivities                     float64  `json:"net_cash_from_financing_activities"`
-	NetCashFromFinancingActivitiesContinuingOperations  float64  `json:"net_cash_from_financing_activities_continuing_operations"`
-	NetCashFromFinancingActivitiesDiscontinued          float64  `json:"net_cash_from_financing_activities_discontinued_operations"`
-	ChangeInCashAndEquivalents                         float64  `json:"change_in_cash_and_equivalents"`
-	NetIncome                                          float64  `json:"net_income"`
-	DepreciationDepletionAndAmortization               float64  `json:"depreciation_depletion_and_amortization"`
-	ChangeInOtherOperatingAssetsAndLiabilitiesNet      float64  `json:"change_in_other_operating_assets_and_liabilities_net"`
-	OtherOperatingActivities                           float64  `json:"other_operating_activities"`
-	PurchaseOfPropertyPlantAndEquipment                float64  `json:"purchase_of_property_plant_and_equipment"`
-	SaleOfPropertyPlantAndEquipment                    float64  `json:"sale_of_property_plant_and_equipment"`
-	OtherInvestingActivities                           float64  `json:"other_investing_activities"`
-	ShortTermDebtIssuancesRepayments                   float64  `json:"short_term_debt_issuances_repayments"`
-	LongTermDebtIssuancesRepayments                    float64  `json:"long_term_debt_issuances_repayments"`
-	Dividends                                          float64  `json:"dividends"`
-	OtherFinancingActivities                           float64  `json:"other_financing_activities"`
-	EffectOfCurrencyExchangeRate                       float64  `json:"effect_of_currency_exchange_rate"`
-	IncomeLossFromDiscontinuedOperations               float64  `json:"income_loss_from_discontinued_operations"`
-	NoncontrollingInterests                            float64  `json:"noncontrolling_interests"`
-	OtherCashAdjustments                               float64  `json:"other_cash_adjustments"`
+	NetCashFromOperatingActivities                     *float64 `json:"net_cash_from_operating_activities"`
+	CashFromOperatingActivitiesContinuingOperations    *float64 `json:"cash_from_operating_activities_continuing_operations"`
+	NetCashFromOperatingActivitiesDiscontinued         *float64 `json:"net_cash_from_operating_activities_discontinued_operations"`
+	NetCashFromInvestingActivities                     *float64 `json:"net_cash_from_investing_activities"`
+	NetCashFromInvestingActivitiesContinuingOperations *float64 `json:"net_cash_from_investing_activities_continuing_operations"`
+	NetCashFromInvestingActivitiesDiscontinued         *float64 `json:"net_cash_from_investing_activities_discontinued_operations"`
+	NetCashFromFinancingActivities                     *float64 `json:"net_cash_from_financing_activities"`
+	NetCashFromFinancingActivitiesContinuingOperations *float64 `json:"net_cash_from_financing_activities_continuing_operations"`
+	NetCashFromFinancingActivitiesDiscontinued         *float64 `json:"net_cash_from_financing_activities_discontinued_operations"`
+	ChangeInCashAndEquivalents                         *float64 `json:"change_in_cash_and_equivalents"`
+	NetIncome                                          *float64 `json:"net_income"`
+	DepreciationDepletionAndAmortization               *float64 `json:"depreciation_depletion_and_amortization"`
+	ChangeInOtherOperatingAssetsAndLiabilitiesNet      *float64 `json:"change_in_other_operating_assets_and_liabilities_net"`
+	OtherOperatingActivities                           *float64 `json:"other_operating_activities"`
+	PurchaseOfPropertyPlantAndEquipment                *float64 `json:"purchase_of_property_plant_and_equipment"`
+	SaleOfPropertyPlantAndEquipment                    *float64 `json:"sale_of_property_plant_and_equipment"`
+	OtherInvestingActivities                           *float64 `json:"other_investing_activities"`
+	ShortTermDebtIssuancesRepayments                   *float64 `json:"short_term_debt_issuances_repayments"`
+	LongTermDebtIssuancesRepayments                    *float64 `json:"long_term_debt_issuances_repayments"`
+	Dividends                                          *float64 `json:"dividends"`
+	OtherFinancingActivities                           *float64 `json:"other_financing_activities"`
+	EffectOfCurrencyExchangeRate                       *float64 `json:"effect_of_currency_exchange_rate"`
+	IncomeLossFromDiscontinuedOperations               *float64 `json:"income_loss_from_discontinued_operations"`
+	NoncontrollingInterests                            *float64 `json:"noncontrolling_interests"`
+	OtherCashAdjustments                               *float64 `json:"other_cash_adjustments"`
 }
 
 // CashFlowStatementsParams holds the query parameters for fetching
@@ -415,15 +439,7 @@ type CashFlowStatementsParams struct {
 // month cash flows. The data covers operating, investing, and financing
 // activities sourced from SEC filings.
 func (c *Client) GetCashFlowStatements(p CashFlowStatementsParams) (*CashFlowStatementsResponse, error) {
-	path := "/stocks/financials/v1/cash-flow-statements"
-
-	params := map[string]string{
-		"tickers":   p.Tickers,
-		"cik":       p.CIK,
-		"timeframe": p.Timeframe,
-		"limit":     p.Limit,
-		"sort":      p.Sort,
-	}
+	path, params := p.request()
 
 	var result CashFlowStatementsResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -433,6 +449,18 @@ func (c *Client) GetCashFlowStatements(p CashFlowStatementsParams) (*CashFlowSta
 	return &result, nil
 }
 
+// request returns the cash flow statements endpoint path and query parameters for
+// p, shared by GetCashFlowStatements and GetRaw.
+func (p CashFlowStatementsParams) request() (string, map[string]string) {
+	return "/stocks/financials/v1/cash-flow-statements", map[string]string{
+		"tickers":   p.Tickers,
+		"cik":       p.CIK,
+		"timeframe": p.Timeframe,
+		"limit":     p.Limit,
+		"sort":      p.Sort,
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Financial Ratios
 // ---------------------------------------------------------------------------
@@ -449,30 +477,32 @@ type RatiosResponse struct {
 
 // Ratio represents a single financial ratios record for a ticker
 // including valuation, profitability, liquidity, and leverage metrics.
+// Metrics are nil when the API does not report them, e.g. P/E for a
+// company with negative earnings.
 type Ratio struct {
-	Ticker              string  `json:"ticker"`
-	CIK                 string  `json:"cik"`
-	Date                string  `json:"date"`
-	Price               float64 `json:"price"`
-	MarketCap           float64 `json:"market_cap"`
-	EarningsPerShare    float64 `json:"earnings_per_share"`
-	PriceToEarnings     float64 `json:"price_to_earnings"`
-	PriceToBook         float64 `json:"price_to_book"`
-	PriceToSales        float64 `json:"price_to_sales"`
-	PriceToCashFlow     float64 `json:"price_to_cash_flow"`
-	PriceToFreeCashFlow float64 `json:"price_to_free_cash_flow"`
-	DividendYield       float64 `json:"dividend_yield"`
-	ReturnOnAssets      float64 `json:"return_on_assets"`
-	ReturnOnEquity      float64 `json:"return_on_equity"`
-	DebtToEquity        float64 `json:"debt_to_equity"`
-	Current             float64 `json:"current"`
-	Quick               float64 `json:"quick"`
-	Cash                float64 `json:"cash"`
-	EVToSales           float64 `json:"ev_to_sales"`
-	EVToEBITDA          float64 `json:"ev_to_ebitda"`
-	EnterpriseValue     float64 `json:"enterprise_value"`
-	FreeCashFlow        float64 `json:"free_cash_flow"`
-	AverageVolume       float64 `json:"average_volume"`
+	Ticker              string   `json:"ticker"`
+	CIK                 string   `json:"cik"`
+	Date                string   `json:"date"`
+	Price               *float64 `json:"price"`
+	MarketCap           *float64 `json:"market_cap"`
+	EarningsPerShare    *float64 `json:"earnings_per_share"`
+	PriceToEarnings     *float64 `json:"price_to_earnings"`
+	PriceToBook         *float64 `json:"price_to_book"`
+	PriceToSales        *float64 `json:"price_to_sales"`
+	PriceToCashFlow     *float64 `json:"price_to_cash_flow"`
+	PriceToFreeCashFlow *float64 `json:"price_to_free_cash_flow"`
+	DividendYield       *float64 `json:"dividend_yield"`
+	ReturnOnAssets      *float64 `json:"return_on_assets"`
+	ReturnOnEquity      *float64 `json:"return_on_equity"`
+	DebtToEquity        *float64 `json:"debt_to_equity"`
+	Current             *float64 `json:"current"`
+	Quick               *float64 `json:"quick"`
+	Cash                *float64 `json:"cash"`
+	EVToSales           *float64 `json:"ev_to_sales"`
+	EVToEBITDA          *float64 `json:"ev_to_ebitda"`
+	EnterpriseValue     *float64 `json:"enterprise_value"`
+	FreeCashFlow        *float64 `json:"free_cash_flow"`
+	AverageVolume       *float64 `json:"average_volume"`
 }
 
 // RatiosParams holds the query parameters for fetching financial
@@ -488,13 +518,7 @@ type RatiosParams struct {
 // companies. Includes metrics such as P/E, P/B, ROE, ROA, debt-to-equity,
 // and current/quick/cash ratios.
 func (c *Client) GetRatios(p RatiosParams) (*RatiosResponse, error) {
-	path := "/stocks/financials/v1/ratios"
-
-	params := map[string]string{
-		"ticker": p.Ticker,
-		"limit":  p.Limit,
-		"sort":   p.Sort,
-	}
+	path, params := p.request()
 
 	var result RatiosResponse
 	if err := c.get(path, params, &result); err != nil {
@@ -503,3 +527,13 @@ func (c *Client) GetRatios(p RatiosParams) (*RatiosResponse, error) {
 
 	return &result, nil
 }
+
+// request returns the ratios endpoint path and query parameters for
+// p, shared by GetRatios and GetRaw.
+func (p RatiosParams) request() (string, map[string]string) {
+	return "/stocks/financials/v1/ratios", map[string]string{
+		"ticker": p.Ticker,
+		"limit":  p.Limit,
+		"sort":   p.Sort,
+	}
+}
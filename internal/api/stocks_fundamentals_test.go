@@ -6,6 +6,7 @@
 package api
 
 import (
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -667,24 +668,51 @@ func TestGetBalanceSheets(t *testing.T) {
 		t.Errorf("expected timeframe annual, got %s", bs.Timeframe)
 	}
 
-	if bs.TotalAssets != 364980000000 {
-		t.Errorf("expected total_assets 364980000000, got %f", bs.TotalAssets)
+	if floatValue(bs.TotalAssets) != 364980000000 {
+		t.Errorf("expected total_assets 364980000000, got %f", floatValue(bs.TotalAssets))
 	}
 
-	if bs.TotalCurrentAssets != 152987000000 {
-		t.Errorf("expected total_current_assets 152987000000, got %f", bs.TotalCurrentAssets)
+	if floatValue(bs.TotalCurrentAssets) != 152987000000 {
+		t.Errorf("expected total_current_assets 152987000000, got %f", floatValue(bs.TotalCurrentAssets))
 	}
 
-	if bs.TotalLiabilities != 308030000000 {
-		t.Errorf("expected total_liabilities 308030000000, got %f", bs.TotalLiabilities)
+	if floatValue(bs.TotalLiabilities) != 308030000000 {
+		t.Errorf("expected total_liabilities 308030000000, got %f", floatValue(bs.TotalLiabilities))
 	}
 
-	if bs.TotalEquity != 56950000000 {
-		t.Errorf("expected total_equity 56950000000, got %f", bs.TotalEquity)
+	if floatValue(bs.TotalEquity) != 56950000000 {
+		t.Errorf("expected total_equity 56950000000, got %f", floatValue(bs.TotalEquity))
 	}
 
-	if bs.CashAndEquivalents != 29943000000 {
-		t.Errorf("expected cash_and_equivalents 29943000000, got %f", bs.CashAndEquivalents)
+	if floatValue(bs.CashAndEquivalents) != 29943000000 {
+		t.Errorf("expected cash_and_equivalents 29943000000, got %f", floatValue(bs.CashAndEquivalents))
+	}
+}
+
+// TestGetBalanceSheetsMissingVsZero verifies that a line item reported as
+// zero decodes to a pointer to 0 while an omitted line item stays nil.
+func TestGetBalanceSheetsMissingVsZero(t *testing.T) {
+	sparseJSON := `{"status":"OK","results":[{"tickers":["XYZ"],"total_assets":1000,"goodwill":0}]}`
+	server := mockServer(t, map[string]string{
+		"/stocks/financials/v1/balance-sheets": sparseJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetBalanceSheets(BalanceSheetsParams{Tickers: "XYZ"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bs := result.Results[0]
+	if bs.Goodwill == nil || *bs.Goodwill != 0 {
+		t.Errorf("expected goodwill to be a reported zero, got %v", bs.Goodwill)
+	}
+	if bs.IntangibleAssetsNet != nil {
+		t.Errorf("expected missing intangible_assets_net to be nil, got %v", *bs.IntangibleAssetsNet)
+	}
+	if floatValue(bs.TotalAssets) != 1000 {
+		t.Errorf("expected total_assets 1000, got %f", floatValue(bs.TotalAssets))
 	}
 }
 
@@ -779,36 +807,36 @@ func TestGetIncomeStatements(t *testing.T) {
 		t.Errorf("expected tickers [AAPL], got %v", is.Tickers)
 	}
 
-	if is.Revenue != 391035000000 {
-		t.Errorf("expected revenue 391035000000, got %f", is.Revenue)
+	if floatValue(is.Revenue) != 391035000000 {
+		t.Errorf("expected revenue 391035000000, got %f", floatValue(is.Revenue))
 	}
 
-	if is.GrossProfit != 180683000000 {
-		t.Errorf("expected gross_profit 180683000000, got %f", is.GrossProfit)
+	if floatValue(is.GrossProfit) != 180683000000 {
+		t.Errorf("expected gross_profit 180683000000, got %f", floatValue(is.GrossProfit))
 	}
 
-	if is.OperatingIncome != 123216000000 {
-		t.Errorf("expected operating_income 123216000000, got %f", is.OperatingIncome)
+	if floatValue(is.OperatingIncome) != 123216000000 {
+		t.Errorf("expected operating_income 123216000000, got %f", floatValue(is.OperatingIncome))
 	}
 
-	if is.ConsolidatedNetIncomeLoss != 93736000000 {
-		t.Errorf("expected consolidated_net_income_loss 93736000000, got %f", is.ConsolidatedNetIncomeLoss)
+	if floatValue(is.ConsolidatedNetIncomeLoss) != 93736000000 {
+		t.Errorf("expected consolidated_net_income_loss 93736000000, got %f", floatValue(is.ConsolidatedNetIncomeLoss))
 	}
 
-	if is.BasicEarningsPerShare != 6.11 {
-		t.Errorf("expected basic_earnings_per_share 6.11, got %f", is.BasicEarningsPerShare)
+	if floatValue(is.BasicEarningsPerShare) != 6.11 {
+		t.Errorf("expected basic_earnings_per_share 6.11, got %f", floatValue(is.BasicEarningsPerShare))
 	}
 
-	if is.DilutedEarningsPerShare != 6.08 {
-		t.Errorf("expected diluted_earnings_per_share 6.08, got %f", is.DilutedEarningsPerShare)
+	if floatValue(is.DilutedEarningsPerShare) != 6.08 {
+		t.Errorf("expected diluted_earnings_per_share 6.08, got %f", floatValue(is.DilutedEarningsPerShare))
 	}
 
-	if is.EBITDA != 134661000000 {
-		t.Errorf("expected ebitda 134661000000, got %f", is.EBITDA)
+	if floatValue(is.EBITDA) != 134661000000 {
+		t.Errorf("expected ebitda 134661000000, got %f", floatValue(is.EBITDA))
 	}
 
-	if is.ResearchDevelopment != 31370000000 {
-		t.Errorf("expected research_development 31370000000, got %f", is.ResearchDevelopment)
+	if floatValue(is.ResearchDevelopment) != 31370000000 {
+		t.Errorf("expected research_development 31370000000, got %f", floatValue(is.ResearchDevelopment))
 	}
 }
 
@@ -911,32 +939,32 @@ func TestGetCashFlowStatements(t *testing.T) {
 		t.Errorf("expected timeframe annual, got %s", cf.Timeframe)
 	}
 
-	if cf.NetCashFromOperatingActivities != 118254000000 {
-		t.Errorf("expected net_cash_from_operating_activities 118254000000, got %f", cf.NetCashFromOperatingActivities)
+	if floatValue(cf.NetCashFromOperatingActivities) != 118254000000 {
+		t.Errorf("expected net_cash_from_operating_activities 118254000000, got %f", floatValue(cf.NetCashFromOperatingActivities))
 	}
 
-	if cf.NetCashFromInvestingActivities != -7166000000 {
-		t.Errorf("expected net_cash_from_investing_activities -7166000000, got %f", cf.NetCashFromInvestingActivities)
+	if floatValue(cf.NetCashFromInvestingActivities) != -7166000000 {
+		t.Errorf("expected net_cash_from_investing_activities -7166000000, got %f", floatValue(cf.NetCashFromInvestingActivities))
 	}
 
-	if cf.NetCashFromFinancingActivities != -121983000000 {
-		t.Errorf("expected net_cash_from_financing_activities -121983000000, got %f", cf.NetCashFromFinancingActivities)
+	if floatValue(cf.NetCashFromFinancingActivities) != -121983000000 {
+		t.Errorf("expected net_cash_from_financing_activities -121983000000, got %f", floatValue(cf.NetCashFromFinancingActivities))
 	}
 
-	if cf.ChangeInCashAndEquivalents != -10895000000 {
-		t.Errorf("expected change_in_cash_and_equivalents -10895000000, got %f", cf.ChangeInCashAndEquivalents)
+	if floatValue(cf.ChangeInCashAndEquivalents) != -10895000000 {
+		t.Errorf("expected change_in_cash_and_equivalents -10895000000, got %f", floatValue(cf.ChangeInCashAndEquivalents))
 	}
 
-	if cf.NetIncome != 93736000000 {
-		t.Errorf("expected net_income 93736000000, got %f", cf.NetIncome)
+	if floatValue(cf.NetIncome) != 93736000000 {
+		t.Errorf("expected net_income 93736000000, got %f", floatValue(cf.NetIncome))
 	}
 
-	if cf.DepreciationDepletionAndAmortization != 11445000000 {
-		t.Errorf("expected depreciation_depletion_and_amortization 11445000000, got %f", cf.DepreciationDepletionAndAmortization)
+	if floatValue(cf.DepreciationDepletionAndAmortization) != 11445000000 {
+		t.Errorf("expected depreciation_depletion_and_amortization 11445000000, got %f", floatValue(cf.DepreciationDepletionAndAmortization))
 	}
 
-	if cf.Dividends != -15234000000 {
-		t.Errorf("expected dividends -15234000000, got %f", cf.Dividends)
+	if floatValue(cf.Dividends) != -15234000000 {
+		t.Errorf("expected dividends -15234000000, got %f", floatValue(cf.Dividends))
 	}
 }
 
@@ -1038,48 +1066,75 @@ func TestGetRatios(t *testing.T) {
 		t.Errorf("expected date 2025-02-14, got %s", r.Date)
 	}
 
-	if r.Price != 244.60 {
-		t.Errorf("expected price 244.60, got %f", r.Price)
+	if floatValue(r.Price) != 244.60 {
+		t.Errorf("expected price 244.60, got %f", floatValue(r.Price))
 	}
 
-	if r.MarketCap != 3680000000000 {
-		t.Errorf("expected market_cap 3680000000000, got %f", r.MarketCap)
+	if floatValue(r.MarketCap) != 3680000000000 {
+		t.Errorf("expected market_cap 3680000000000, got %f", floatValue(r.MarketCap))
 	}
 
-	if r.EarningsPerShare != 6.08 {
-		t.Errorf("expected earnings_per_share 6.08, got %f", r.EarningsPerShare)
+	if floatValue(r.EarningsPerShare) != 6.08 {
+		t.Errorf("expected earnings_per_share 6.08, got %f", floatValue(r.EarningsPerShare))
 	}
 
-	if r.PriceToEarnings != 40.23 {
-		t.Errorf("expected price_to_earnings 40.23, got %f", r.PriceToEarnings)
+	if floatValue(r.PriceToEarnings) != 40.23 {
+		t.Errorf("expected price_to_earnings 40.23, got %f", floatValue(r.PriceToEarnings))
 	}
 
-	if r.PriceToBook != 64.62 {
-		t.Errorf("expected price_to_book 64.62, got %f", r.PriceToBook)
+	if floatValue(r.PriceToBook) != 64.62 {
+		t.Errorf("expected price_to_book 64.62, got %f", floatValue(r.PriceToBook))
 	}
 
-	if r.DividendYield != 0.41 {
-		t.Errorf("expected dividend_yield 0.41, got %f", r.DividendYield)
+	if floatValue(r.DividendYield) != 0.41 {
+		t.Errorf("expected dividend_yield 0.41, got %f", floatValue(r.DividendYield))
 	}
 
-	if r.ReturnOnAssets != 25.69 {
-		t.Errorf("expected return_on_assets 25.69, got %f", r.ReturnOnAssets)
+	if floatValue(r.ReturnOnAssets) != 25.69 {
+		t.Errorf("expected return_on_assets 25.69, got %f", floatValue(r.ReturnOnAssets))
 	}
 
-	if r.ReturnOnEquity != 164.59 {
-		t.Errorf("expected return_on_equity 164.59, got %f", r.ReturnOnEquity)
+	if floatValue(r.ReturnOnEquity) != 164.59 {
+		t.Errorf("expected return_on_equity 164.59, got %f", floatValue(r.ReturnOnEquity))
 	}
 
-	if r.DebtToEquity != 1.87 {
-		t.Errorf("expected debt_to_equity 1.87, got %f", r.DebtToEquity)
+	if floatValue(r.DebtToEquity) != 1.87 {
+		t.Errorf("expected debt_to_equity 1.87, got %f", floatValue(r.DebtToEquity))
 	}
 
-	if r.Current != 0.87 {
-		t.Errorf("expected current 0.87, got %f", r.Current)
+	if floatValue(r.Current) != 0.87 {
+		t.Errorf("expected current 0.87, got %f", floatValue(r.Current))
 	}
 
-	if r.EVToEBITDA != 29.51 {
-		t.Errorf("expected ev_to_ebitda 29.51, got %f", r.EVToEBITDA)
+	if floatValue(r.EVToEBITDA) != 29.51 {
+		t.Errorf("expected ev_to_ebitda 29.51, got %f", floatValue(r.EVToEBITDA))
+	}
+}
+
+// TestGetRatiosMissingMetrics verifies that ratios the API omits, such as
+// P/E for a loss-making company, decode as nil rather than zero.
+func TestGetRatiosMissingMetrics(t *testing.T) {
+	sparseJSON := `{"status":"OK","count":1,"results":[{"ticker":"XYZ","price":12.5,"price_to_book":0.8}]}`
+	server := mockServer(t, map[string]string{
+		"/stocks/financials/v1/ratios": sparseJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetRatios(RatiosParams{Ticker: "XYZ"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := result.Results[0]
+	if r.PriceToEarnings != nil {
+		t.Errorf("expected missing price_to_earnings to be nil, got %v", *r.PriceToEarnings)
+	}
+	if r.DividendYield != nil {
+		t.Errorf("expected missing dividend_yield to be nil, got %v", *r.DividendYield)
+	}
+	if floatValue(r.PriceToBook) != 0.8 {
+		t.Errorf("expected price_to_book 0.8, got %f", floatValue(r.PriceToBook))
 	}
 }
 
@@ -1146,3 +1201,12 @@ func TestGetRatiosAPIError(t *testing.T) {
 		t.Fatal("expected error for 403 response, got nil")
 	}
 }
+
+// floatValue dereferences an optional fundamentals metric for comparison,
+// returning NaN for a missing value so any expected number fails to match.
+func floatValue(f *float64) float64 {
+	if f == nil {
+		return math.NaN()
+	}
+	return *f
+}
@@ -201,3 +201,20 @@ func (c *Client) GetMACD(ticker string, p MACDParams) (*MACDResponse, error) {
 
 	return &result, nil
 }
+
+// GetIndicatorUnderlying fetches the aggregate bars an indicator was
+// computed from by following the results.underlying.url of an indicator
+// response. Like a pagination next_url, the URL is resolved against the
+// configured base URL and authorized before it is sent.
+func (c *Client) GetIndicatorUnderlying(underlyingURL string) (*BarsResponse, error) {
+	if underlyingURL == "" {
+		return nil, fmt.Errorf("indicator response has no underlying URL")
+	}
+
+	var result BarsResponse
+	if err := c.getNext(underlyingURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -241,6 +241,51 @@ func TestGetSMAUnderlyingURL(t *testing.T) {
 	}
 }
 
+// TestGetIndicatorUnderlying verifies that the underlying URL from an
+// indicator response is followed on the configured base URL with the API
+// key attached and decoded as aggregate bars.
+func TestGetIndicatorUnderlying(t *testing.T) {
+	var receivedKey, receivedLimit string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/aggs/ticker/AAPL/range/1/day/1731042000000/1736553600000" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		receivedKey = r.URL.Query().Get("apiKey")
+		receivedLimit = r.URL.Query().Get("limit")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ticker":"AAPL","status":"OK","resultsCount":1,"results":[{"o":245,"h":248,"l":244,"c":247.5,"v":1000,"t":1736485200000}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	underlying := "https://api.polygon.io/v2/aggs/ticker/AAPL/range/1/day/1731042000000/1736553600000?limit=52&sort=desc"
+
+	bars, err := client.GetIndicatorUnderlying(underlying)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedKey != "test-api-key" || receivedLimit != "52" {
+		t.Errorf("expected apiKey and limit=52 on the request, got %q and %q", receivedKey, receivedLimit)
+	}
+	if len(bars.Results) != 1 || bars.Results[0].Close != 247.5 {
+		t.Errorf("expected one bar closing at 247.5, got %+v", bars.Results)
+	}
+
+	resolved, err := client.ResolveURL(underlying)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := server.URL + "/v2/aggs/ticker/AAPL/range/1/day/1731042000000/1736553600000?limit=52&sort=desc"; resolved != want {
+		t.Errorf("expected resolved URL %s, got %s", want, resolved)
+	}
+
+	if _, err := client.GetIndicatorUnderlying(""); err == nil {
+		t.Error("expected error for an empty underlying URL")
+	}
+}
+
 // TestGetSMAAPIError verifies that GetSMA returns an error when the
 // API responds with a non-200 status code.
 func TestGetSMAAPIError(t *testing.T) {
@@ -5,6 +5,12 @@
 
 package api
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 // MarketStatusExchanges holds the open/closed status for each major
 // stock exchange (NYSE, NASDAQ, OTC) as reported by the market status API.
 type MarketStatusExchanges struct {
@@ -85,6 +91,20 @@ type Exchange struct {
 	URL            string `json:"url,omitempty"`
 }
 
+// Lookup finds the exchange whose name, acronym, or MIC matches the given
+// value case-insensitively. Exchanges are checked in response order and
+// the first match wins. Returns false if no exchange matches.
+func (r *ExchangesResponse) Lookup(value string) (Exchange, bool) {
+	for _, e := range r.Results {
+		if strings.EqualFold(e.Name, value) ||
+			(e.Acronym != "" && strings.EqualFold(e.Acronym, value)) ||
+			(e.MIC != "" && strings.EqualFold(e.MIC, value)) {
+			return e, true
+		}
+	}
+	return Exchange{}, false
+}
+
 // ExchangesParams holds the optional query parameters for filtering
 // exchanges by asset class and locale.
 type ExchangesParams struct {
@@ -122,10 +142,15 @@ func (c *Client) GetMarketHolidays() ([]MarketHoliday, error) {
 
 // GetExchanges retrieves a list of known exchanges filtered by the
 // optional asset class and locale parameters. Each exchange includes
-// identifiers like MIC codes, participant IDs, and URLs.
+// identifiers like MIC codes, participant IDs, and URLs. A locale the
+// asset class is not available in is rejected before the request is sent.
 func (c *Client) GetExchanges(p ExchangesParams) (*ExchangesResponse, error) {
 	path := "/v3/reference/exchanges"
 
+	if err := ValidateLocale(p.AssetClass, p.Locale); err != nil {
+		return nil, err
+	}
+
 	params := map[string]string{
 		"asset_class": p.AssetClass,
 		"locale":      p.Locale,
@@ -138,3 +163,43 @@ func (c *Client) GetExchanges(p ExchangesParams) (*ExchangesResponse, error) {
 
 	return &result, nil
 }
+
+// ExchangeAssetClasses lists the asset classes GetAssetClassExchanges
+// accepts, in the order exchanges are listed when no class is given.
+var ExchangeAssetClasses = []string{"stocks", "options", "crypto", "fx", "futures"}
+
+// GetAssetClassExchanges lists the exchanges of one asset class through a
+// single entry point. Futures exchanges come from the futures endpoint and
+// are converted to Exchange with AssetClass set to "futures"; every other
+// class uses the shared reference endpoint. An empty asset class lists
+// the exchanges of every class, futures included.
+func (c *Client) GetAssetClassExchanges(assetClass string) (*ExchangesResponse, error) {
+	assetClass = strings.ToLower(assetClass)
+
+	switch assetClass {
+	case "":
+		result, err := c.GetExchanges(ExchangesParams{})
+		if err != nil {
+			return nil, err
+		}
+		futures, err := c.GetFuturesExchanges(FuturesExchangesParams{})
+		if err != nil {
+			return nil, err
+		}
+		result.Results = append(result.Results, futures.Exchanges()...)
+		result.Count = len(result.Results)
+		return result, nil
+	case "futures":
+		futures, err := c.GetFuturesExchanges(FuturesExchangesParams{})
+		if err != nil {
+			return nil, err
+		}
+		exchanges := futures.Exchanges()
+		return &ExchangesResponse{Status: "OK", Count: len(exchanges), Results: exchanges}, nil
+	}
+
+	if !slices.Contains(ExchangeAssetClasses, assetClass) {
+		return nil, fmt.Errorf("unsupported asset class %q (use %s)", assetClass, strings.Join(ExchangeAssetClasses, ", "))
+	}
+	return c.GetExchanges(ExchangesParams{AssetClass: assetClass})
+}
@@ -553,3 +553,73 @@ func TestGetExchangesEmptyParams(t *testing.T) {
 		t.Errorf("expected status OK, got %s", result.Status)
 	}
 }
+
+// TestExchangesResponseLookup verifies that Lookup resolves an exchange by
+// name, acronym, or MIC regardless of case, and reports unknown values.
+func TestExchangesResponseLookup(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v3/reference/exchanges": exchangesJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetExchanges(ExchangesParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]int{
+		"amex":                    1,
+		"XNYS":                    10,
+		"new york stock exchange": 10,
+		"Nasdaq":                  12,
+	}
+
+	for value, wantID := range tests {
+		e, ok := result.Lookup(value)
+		if !ok {
+			t.Errorf("expected %q to resolve, got no match", value)
+			continue
+		}
+		if e.ID != wantID {
+			t.Errorf("expected %q to resolve to ID %d, got %d", value, wantID, e.ID)
+		}
+	}
+
+	if _, ok := result.Lookup("bogus"); ok {
+		t.Error("expected no match for unknown exchange")
+	}
+}
+
+// TestGetAssetClassExchanges verifies that futures are fetched from the
+// futures endpoint and tagged with the futures asset class, that an empty
+// asset class merges every class, and that unknown classes are rejected.
+func TestGetAssetClassExchanges(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v3/reference/exchanges": exchangesJSON,
+		"/futures/vX/exchanges":   futuresExchangesJSON,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	futures, err := client.GetAssetClassExchanges("Futures")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if futures.Count != 2 || futures.Results[0].AssetClass != "futures" || futures.Results[0].MIC != "XCME" {
+		t.Errorf("unexpected futures exchanges: %+v", futures)
+	}
+
+	all, err := client.GetAssetClassExchanges("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if all.Count != 5 || len(all.Results) != 5 || all.Results[4].Acronym != "ICE" {
+		t.Errorf("expected 3 reference and 2 futures exchanges, got %+v", all)
+	}
+
+	if _, err := client.GetAssetClassExchanges("bonds"); err == nil {
+		t.Error("expected an error for an unsupported asset class")
+	}
+}
@@ -20,6 +20,12 @@ type SnapshotBar struct {
 	VWAP   float64 `json:"vw"`
 }
 
+// IsZero reports whether the bar carries no data, which is how a bar
+// omitted from the response (e.g. for an illiquid ticker) decodes.
+func (b SnapshotBar) IsZero() bool {
+	return b == SnapshotBar{}
+}
+
 // SnapshotMinBar represents the most recent minute bar with additional
 // fields for accumulated volume, timestamp, and number of transactions.
 type SnapshotMinBar struct {
@@ -34,6 +40,12 @@ type SnapshotMinBar struct {
 	AccumulatedVolume float64 `json:"av"`
 }
 
+// IsZero reports whether the minute bar carries no data, which is how a
+// "min" object omitted from the response decodes.
+func (b SnapshotMinBar) IsZero() bool {
+	return b == SnapshotMinBar{}
+}
+
 // SnapshotTicker represents a single ticker's snapshot data containing
 // the current day's bar, previous day's bar, latest minute bar, the
 // calculated change values, and the last update timestamp.
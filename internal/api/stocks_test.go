@@ -709,3 +709,37 @@ func TestGetTickersWithTickerFilter(t *testing.T) {
 	client := newTestClient(server.URL)
 	client.GetTickers(TickerParams{Ticker: "AAPL"})
 }
+
+// TestGetRelatedCompanies verifies that GetRelatedCompanies requests the
+// ticker's related companies path and parses the related tickers.
+func TestGetRelatedCompanies(t *testing.T) {
+	server := mockServer(t, map[string]string{
+		"/v1/related-companies/AAPL": `{
+			"request_id": "related-1",
+			"status": "OK",
+			"stock_symbol": "AAPL",
+			"results": [{"ticker": "MSFT"}, {"ticker": "GOOGL"}, {"ticker": "AMZN"}]
+		}`,
+	})
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetRelatedCompanies("AAPL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.StockSymbol != "AAPL" {
+		t.Errorf("expected stock_symbol AAPL, got %s", result.StockSymbol)
+	}
+
+	want := []string{"MSFT", "GOOGL", "AMZN"}
+	if len(result.Results) != len(want) {
+		t.Fatalf("expected %d related companies, got %d", len(want), len(result.Results))
+	}
+	for i, ticker := range want {
+		if result.Results[i].Ticker != ticker {
+			t.Errorf("index %d: expected %s, got %s", i, ticker, result.Results[i].Ticker)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package api
 
 import (
 	"fmt"
+	"iter"
 )
 
 // TradesResponse represents the API response for tick-level trade data
@@ -47,6 +48,7 @@ type TradesParams struct {
 	Order        string
 	Limit        string
 	Sort         string
+	Cursor       string
 }
 
 // LastTradeResponse represents the API response for the most recent trade
@@ -114,6 +116,7 @@ type QuotesParams struct {
 	Order        string
 	Limit        string
 	Sort         string
+	Cursor       string
 }
 
 // LastQuoteResponse represents the API response for the most recent NBBO
@@ -159,6 +162,7 @@ func (c *Client) GetTrades(ticker string, p TradesParams) (*TradesResponse, erro
 		"order":         p.Order,
 		"limit":         p.Limit,
 		"sort":          p.Sort,
+		"cursor":        p.Cursor,
 	}
 
 	var result TradesResponse
@@ -169,6 +173,52 @@ func (c *Client) GetTrades(ticker string, p TradesParams) (*TradesResponse, erro
 	return &result, nil
 }
 
+// GetTradesNext retrieves the next page of stock trades by following the
+// next_url returned in a previous TradesResponse.
+func (c *Client) GetTradesNext(nextURL string) (*TradesResponse, error) {
+	var result TradesResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// TradesPages returns an iterator over the pages of a trades query: the
+// page GetTrades returns, then, when all is set, each page reached by
+// following next_url. Every request goes through f, so a long pull backs
+// off when the rate limit runs low. Only the current page is held, which
+// lets callers that handle pages as they arrive pull any number of trades
+// in constant memory. A failed request is yielded as the error with a nil
+// page and ends the iteration.
+func (c *Client) TradesPages(f *AdaptiveFetcher, ticker string, p TradesParams, all bool) iter.Seq2[*TradesResponse, error] {
+	return func(yield func(*TradesResponse, error) bool) {
+		var page *TradesResponse
+		err := f.Do(func() error {
+			var err error
+			page, err = c.GetTrades(ticker, p)
+			return err
+		})
+
+		for {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) || !all || page.NextURL == "" {
+				return
+			}
+
+			next := page.NextURL
+			err = f.Do(func() error {
+				var err error
+				page, err = c.GetTradesNext(next)
+				return err
+			})
+		}
+	}
+}
+
 // GetLastTrade retrieves the most recent trade for a specific stock ticker.
 // Returns the last available trade with price, size, exchange, and timestamp
 // information useful for monitoring current market activity.
@@ -198,6 +248,7 @@ func (c *Client) GetQuotes(ticker string, p QuotesParams) (*QuotesResponse, erro
 		"order":         p.Order,
 		"limit":         p.Limit,
 		"sort":          p.Sort,
+		"cursor":        p.Cursor,
 	}
 
 	var result QuotesResponse
@@ -208,6 +259,17 @@ func (c *Client) GetQuotes(ticker string, p QuotesParams) (*QuotesResponse, erro
 	return &result, nil
 }
 
+// GetQuotesNext retrieves the next page of stock quotes by following the
+// next_url returned in a previous QuotesResponse.
+func (c *Client) GetQuotesNext(nextURL string) (*QuotesResponse, error) {
+	var result QuotesResponse
+	if err := c.getNext(nextURL, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetLastQuote retrieves the most recent NBBO quote for a specific stock
 // ticker. Returns the last available bid/ask prices, sizes, and exchange
 // information for real-time market monitoring.
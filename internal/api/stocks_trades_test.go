@@ -6,8 +6,10 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -317,6 +319,160 @@ func TestGetTradesAPIError(t *testing.T) {
 	}
 }
 
+// TestGetTradesNext verifies that GetTradesNext follows the next_url with
+// its cursor and parses the next page of trades.
+func TestGetTradesNext(t *testing.T) {
+	var receivedPath, receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(tradesJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetTradesNext(server.URL + "/v3/trades/AAPL?cursor=YXA9MQ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedPath != "/v3/trades/AAPL" {
+		t.Errorf("expected path /v3/trades/AAPL, got %s", receivedPath)
+	}
+
+	if receivedCursor != "YXA9MQ" {
+		t.Errorf("expected cursor YXA9MQ, got %s", receivedCursor)
+	}
+
+	if len(result.Results) == 0 {
+		t.Error("expected trades in next page, got none")
+	}
+}
+
+// TestGetTradesNextRewritesHost verifies that following a fixture next_url
+// pointing at the real API host is redirected to the configured base URL,
+// so pagination hits the test server instead of the public API.
+func TestGetTradesNextRewritesHost(t *testing.T) {
+	var hits int
+	var receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(tradesJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	first, err := client.GetTrades("AAPL", TradesParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(first.NextURL, "https://api.massive.com/") {
+		t.Fatalf("expected fixture next_url on the real host, got %s", first.NextURL)
+	}
+
+	if _, err := client.GetTradesNext(first.NextURL); err != nil {
+		t.Fatalf("unexpected error following next_url: %v", err)
+	}
+
+	if hits != 2 {
+		t.Errorf("expected both requests to hit the test server, got %d hits", hits)
+	}
+
+	if receivedCursor != "YXA9MQ" {
+		t.Errorf("expected cursor YXA9MQ, got %s", receivedCursor)
+	}
+}
+
+// tradesPagesServer serves three pages of one trade each, linked by
+// next_url cursors, and fails the cursor named in failCursor with a 400.
+func tradesPagesServer(t *testing.T, failCursor string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		if cursor != "" && cursor == failCursor {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"ERROR","error":"bad cursor"}`))
+			return
+		}
+		next := map[string]string{"": "p2", "p2": "p3"}[cursor]
+		nextURL := ""
+		if next != "" {
+			nextURL = "https://api.massive.com/v3/trades/AAPL?cursor=" + next
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"OK","next_url":%q,"results":[{"id":"%s"}]}`, nextURL, cursor)
+	}))
+}
+
+// TestTradesPages verifies the iterator yields only the first page
+// without all, follows next_url to the last page with it, and stops when
+// the caller breaks out.
+func TestTradesPages(t *testing.T) {
+	server := tradesPagesServer(t, "")
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	tests := []struct {
+		name  string
+		all   bool
+		limit int
+		want  []string
+	}{
+		{"first page only", false, 0, []string{""}},
+		{"all pages", true, 0, []string{"", "p2", "p3"}},
+		{"caller stops early", true, 2, []string{"", "p2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for page, err := range client.TradesPages(NewAdaptiveFetcher(client, 1), "AAPL", TradesParams{}, tt.all) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, page.Results[0].ID)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("pages = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTradesPagesError verifies a failed page is yielded as an error
+// after the pages before it, and ends the iteration.
+func TestTradesPagesError(t *testing.T) {
+	server := tradesPagesServer(t, "p3")
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	var pages int
+	var errs []error
+	for page, err := range client.TradesPages(NewAdaptiveFetcher(client, 1), "AAPL", TradesParams{}, true) {
+		if err != nil {
+			if page != nil {
+				t.Error("expected a nil page with the error")
+			}
+			errs = append(errs, err)
+			continue
+		}
+		pages++
+	}
+
+	if pages != 2 || len(errs) != 1 {
+		t.Errorf("got %d pages and %d errors, want 2 pages then 1 error", pages, len(errs))
+	}
+}
+
 // TestGetLastTrade verifies that GetLastTrade correctly parses the API
 // response and returns the expected last trade data for AAPL.
 func TestGetLastTrade(t *testing.T) {
@@ -604,6 +760,33 @@ func TestGetQuotesAPIError(t *testing.T) {
 	}
 }
 
+// TestGetQuotesNext verifies that GetQuotesNext follows the next_url with
+// its cursor and parses the next page of quotes.
+func TestGetQuotesNext(t *testing.T) {
+	var receivedCursor string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedCursor = r.URL.Query().Get("cursor")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(quotesJSON))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetQuotesNext(server.URL + "/v3/quotes/AAPL?cursor=cXVvdGVz")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedCursor != "cXVvdGVz" {
+		t.Errorf("expected cursor cXVvdGVz, got %s", receivedCursor)
+	}
+
+	if len(result.Results) == 0 {
+		t.Error("expected quotes in next page, got none")
+	}
+}
+
 // TestGetLastQuote verifies that GetLastQuote correctly parses the API
 // response and returns the expected last NBBO quote data for AAPL.
 func TestGetLastQuote(t *testing.T) {
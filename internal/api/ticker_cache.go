@@ -0,0 +1,163 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TickerCacheTTL is how long a synced ticker index is considered fresh.
+// Ticker lists change slowly, so a daily refresh is enough for completion
+// and validation.
+const TickerCacheTTL = 24 * time.Hour
+
+// tickerCachePageSize is the page size used when pulling the full ticker
+// list. It is the reference endpoint's maximum.
+const tickerCachePageSize = "1000"
+
+// TickerIndexEntry is one ticker in the on-disk index.
+type TickerIndexEntry struct {
+	Ticker string `json:"ticker"`
+	Name   string `json:"name"`
+}
+
+// TickerIndex is the on-disk list of active tickers for one market
+// (stocks, crypto, fx, otc, or indices) along with when it was fetched.
+// Entries are sorted by ticker.
+type TickerIndex struct {
+	Market    string             `json:"market"`
+	FetchedAt time.Time          `json:"fetched_at"`
+	Tickers   []TickerIndexEntry `json:"tickers"`
+}
+
+// Age returns how long ago the index was fetched.
+func (ix *TickerIndex) Age() time.Duration {
+	return time.Since(ix.FetchedAt)
+}
+
+// Fresh reports whether the index was fetched within ttl.
+func (ix *TickerIndex) Fresh(ttl time.Duration) bool {
+	return ix.Age() < ttl
+}
+
+// Contains reports whether ticker is in the index, ignoring case.
+func (ix *TickerIndex) Contains(ticker string) bool {
+	ticker = strings.ToUpper(ticker)
+	i := sort.Search(len(ix.Tickers), func(i int) bool {
+		return ix.Tickers[i].Ticker >= ticker
+	})
+	return i < len(ix.Tickers) && ix.Tickers[i].Ticker == ticker
+}
+
+// Match returns the entries whose ticker starts with prefix, ignoring case.
+func (ix *TickerIndex) Match(prefix string) []TickerIndexEntry {
+	prefix = strings.ToUpper(prefix)
+	start := sort.Search(len(ix.Tickers), func(i int) bool {
+		return ix.Tickers[i].Ticker >= prefix
+	})
+
+	var matches []TickerIndexEntry
+	for _, e := range ix.Tickers[start:] {
+		if !strings.HasPrefix(e.Ticker, prefix) {
+			break
+		}
+		matches = append(matches, e)
+	}
+	return matches
+}
+
+// tickerIndexPath returns the index file path for market inside dir.
+func tickerIndexPath(dir, market string) string {
+	return filepath.Join(dir, "tickers-"+market+".json")
+}
+
+// LoadTickerIndex reads the ticker index for market from dir without
+// touching the network. The returned error wraps os.ErrNotExist when the
+// market has never been synced.
+func LoadTickerIndex(dir, market string) (*TickerIndex, error) {
+	data, err := os.ReadFile(tickerIndexPath(dir, market))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ticker cache: %w", err)
+	}
+
+	var ix TickerIndex
+	if err := json.Unmarshal(data, &ix); err != nil {
+		return nil, fmt.Errorf("failed to parse ticker cache: %w", err)
+	}
+
+	return &ix, nil
+}
+
+// RefreshTickerCache pulls every active ticker for market from the
+// reference tickers endpoint, following pagination, and writes the result
+// to the client's cache directory. The index file is replaced atomically
+// so readers never see a partial list.
+func (c *Client) RefreshTickerCache(market string) (*TickerIndex, error) {
+	if c.cacheDir == "" {
+		return nil, errors.New("ticker cache directory is not set")
+	}
+
+	page, err := c.GetTickers(TickerParams{Market: market, Active: "true", Limit: tickerCachePageSize})
+	if err != nil {
+		return nil, err
+	}
+
+	ix := &TickerIndex{Market: market, FetchedAt: time.Now().UTC()}
+	for {
+		for _, t := range page.Results {
+			ix.Tickers = append(ix.Tickers, TickerIndexEntry{Ticker: strings.ToUpper(t.Ticker), Name: t.Name})
+		}
+
+		if page.NextURL == "" {
+			break
+		}
+
+		page, err = c.GetTickersNext(page.NextURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(ix.Tickers, func(i, j int) bool {
+		return ix.Tickers[i].Ticker < ix.Tickers[j].Ticker
+	})
+
+	if err := writeTickerIndex(c.cacheDir, ix); err != nil {
+		return nil, err
+	}
+
+	return ix, nil
+}
+
+// writeTickerIndex saves ix to dir via a temp file and rename.
+func writeTickerIndex(dir string, ix *TickerIndex) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(ix)
+	if err != nil {
+		return fmt.Errorf("failed to encode ticker cache: %w", err)
+	}
+
+	path := tickerIndexPath(dir, ix.Market)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write ticker cache: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write ticker cache: %w", err)
+	}
+
+	return nil
+}
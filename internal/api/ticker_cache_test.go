@@ -0,0 +1,103 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestRefreshTickerCache verifies that every page of tickers is pulled,
+// the index is sorted and written to disk, and it loads back offline.
+func TestRefreshTickerCache(t *testing.T) {
+	var market, active string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "p2" {
+			w.Write([]byte(`{"status":"OK","results":[{"ticker":"AAPL","name":"Apple Inc."}]}`))
+			return
+		}
+		market = r.URL.Query().Get("market")
+		active = r.URL.Query().Get("active")
+		w.Write([]byte(`{"status":"OK","next_url":"https://api.massive.com/v3/reference/tickers?cursor=p2","results":[{"ticker":"MSFT","name":"Microsoft Corp"},{"ticker":"AMZN","name":"Amazon.com Inc."}]}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := newTestClient(server.URL)
+	client.SetCacheDir(dir)
+
+	ix, err := client.RefreshTickerCache("stocks")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if market != "stocks" || active != "true" {
+		t.Errorf("expected market=stocks active=true, got market=%s active=%s", market, active)
+	}
+
+	if len(ix.Tickers) != 3 || ix.Tickers[0].Ticker != "AAPL" || ix.Tickers[2].Ticker != "MSFT" {
+		t.Errorf("expected 3 sorted tickers, got %+v", ix.Tickers)
+	}
+
+	loaded, err := LoadTickerIndex(dir, "stocks")
+	if err != nil {
+		t.Fatalf("unexpected error loading cache: %v", err)
+	}
+
+	if len(loaded.Tickers) != 3 || !loaded.Fresh(TickerCacheTTL) {
+		t.Errorf("expected a fresh index with 3 tickers, got %+v", loaded)
+	}
+}
+
+// TestRefreshTickerCacheRequiresDir verifies that refreshing without a
+// cache directory fails before any request is made.
+func TestRefreshTickerCacheRequiresDir(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+	if _, err := client.RefreshTickerCache("stocks"); err == nil {
+		t.Error("expected error without a cache directory, got nil")
+	}
+}
+
+// TestLoadTickerIndexMissing verifies that an unsynced market reports
+// os.ErrNotExist.
+func TestLoadTickerIndexMissing(t *testing.T) {
+	_, err := LoadTickerIndex(t.TempDir(), "crypto")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+}
+
+// TestTickerIndexLookup verifies case-insensitive Contains and Match, and
+// that Fresh honors the TTL.
+func TestTickerIndexLookup(t *testing.T) {
+	ix := &TickerIndex{
+		FetchedAt: time.Now().Add(-2 * time.Hour),
+		Tickers: []TickerIndexEntry{
+			{Ticker: "AAPL"}, {Ticker: "AMD"}, {Ticker: "AMZN"}, {Ticker: "MSFT"},
+		},
+	}
+
+	if !ix.Contains("amd") || ix.Contains("AM") || ix.Contains("ZZZZ") {
+		t.Error("unexpected Contains results")
+	}
+
+	matches := ix.Match("am")
+	if len(matches) != 2 || matches[0].Ticker != "AMD" || matches[1].Ticker != "AMZN" {
+		t.Errorf("expected AMD and AMZN, got %+v", matches)
+	}
+
+	if len(ix.Match("")) != 4 {
+		t.Error("expected empty prefix to match every ticker")
+	}
+
+	if ix.Fresh(time.Hour) || !ix.Fresh(TickerCacheTTL) {
+		t.Error("unexpected Fresh results for a two hour old index")
+	}
+}
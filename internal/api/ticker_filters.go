@@ -0,0 +1,57 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ActiveAll is the --active value that asks for both active and delisted
+// tickers. It is sent as no active filter at all.
+const ActiveAll = "all"
+
+// activeFilter maps an active status of true, false, or all onto the
+// value sent as the active query param. All and empty both send no
+// filter; anything else is rejected.
+func activeFilter(active string) (string, error) {
+	switch strings.ToLower(active) {
+	case "", ActiveAll:
+		return "", nil
+	case "true", "false":
+		return strings.ToLower(active), nil
+	}
+
+	return "", fmt.Errorf("invalid active status %q: must be true, false, or %s", active, ActiveAll)
+}
+
+// ValidateTickerFilters checks the reference tickers filters that only
+// make sense together: an as-of date must be YYYY-MM-DD and not in the
+// future, and OTC inclusion only applies to equities, so it is rejected
+// for any market other than stocks or otc. An empty market searches every
+// market and accepts OTC inclusion.
+func ValidateTickerFilters(market, date string, includeOTC bool) error {
+	if date != "" {
+		day, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q: must be YYYY-MM-DD", date)
+		}
+		if day.After(time.Now()) {
+			return fmt.Errorf("invalid date %q: must not be in the future", date)
+		}
+	}
+
+	switch strings.ToLower(market) {
+	case "", "stocks", "otc":
+	default:
+		if includeOTC {
+			return fmt.Errorf("OTC inclusion is not available for %s tickers: use the stocks or otc market", market)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// TestActiveFilter verifies that true and false pass through, all and
+// empty send no filter, and any other status is rejected.
+func TestActiveFilter(t *testing.T) {
+	tests := []struct {
+		active, want string
+		wantErr      bool
+	}{
+		{"", "", false},
+		{"all", "", false},
+		{"ALL", "", false},
+		{"true", "true", false},
+		{"False", "false", false},
+		{"yes", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := activeFilter(tt.active)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("activeFilter(%q): expected error %v, got %v", tt.active, tt.wantErr, err)
+		}
+		if got != tt.want {
+			t.Errorf("activeFilter(%q): expected %q, got %q", tt.active, tt.want, got)
+		}
+	}
+}
+
+// TestValidateTickerFilters verifies that malformed or future dates are
+// rejected and that OTC inclusion is only accepted for equity markets.
+func TestValidateTickerFilters(t *testing.T) {
+	tests := []struct {
+		market, date string
+		includeOTC   bool
+		wantErr      bool
+	}{
+		{"", "", true, false},
+		{"stocks", "2024-01-02", true, false},
+		{"otc", "", true, false},
+		{"crypto", "", true, true},
+		{"fx", "2024-01-02", false, false},
+		{"stocks", "01/02/2024", false, true},
+		{"stocks", "2999-01-01", false, true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateTickerFilters(tt.market, tt.date, tt.includeOTC)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateTickerFilters(%q, %q, %v): expected error %v, got %v", tt.market, tt.date, tt.includeOTC, tt.wantErr, err)
+		}
+	}
+}
+
+// TestGetTickersFilters verifies that GetTickers sends the date and
+// include_otc params, drops the active filter for all, and rejects OTC
+// inclusion for crypto without sending a request.
+func TestGetTickersFilters(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK","results":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if _, err := client.GetTickers(TickerParams{Market: "stocks", Active: "all", Date: "2024-01-02", IncludeOTC: "true"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetTickers(TickerParams{Market: "crypto", IncludeOTC: "true"}); err == nil {
+		t.Error("expected an error for OTC inclusion on crypto tickers")
+	}
+	if _, err := client.GetCryptoTickers(CryptoTickersParams{Active: "false", Date: "2024-01-02"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	if q := queries[0]; q.Has("active") || q.Get("date") != "2024-01-02" || q.Get("include_otc") != "true" {
+		t.Errorf("unexpected stocks query: %v", q)
+	}
+	if q := queries[1]; q.Get("active") != "false" || q.Get("date") != "2024-01-02" || q.Get("market") != "crypto" {
+		t.Errorf("unexpected crypto query: %v", q)
+	}
+}
@@ -0,0 +1,147 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// nanosecondDigits is the length of a Unix nanosecond timestamp for any
+// date between 2001 and 2286. Shorter integers are most likely seconds,
+// milliseconds, or microseconds and are rejected as ambiguous.
+const nanosecondDigits = 19
+
+// datePattern matches the YYYY-MM-DD form accepted by the trade and quote
+// endpoints.
+var datePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// timestampParamKeys lists the query parameters that carry a trade or
+// quote timestamp filter.
+var timestampParamKeys = []string{"timestamp", "timestamp.gte", "timestamp.gt", "timestamp.lte", "timestamp.lt"}
+
+// normalizeTimestampParam validates a trade or quote timestamp filter and
+// returns it in a form every tick endpoint understands. Dates (YYYY-MM-DD)
+// and 19-digit nanosecond timestamps pass through unchanged, RFC3339 times
+// are converted to nanoseconds, and an empty value stays empty. Integers
+// of any other length are rejected since the unit cannot be inferred.
+func normalizeTimestampParam(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", nil
+	}
+
+	if datePattern.MatchString(s) {
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			return "", fmt.Errorf("invalid timestamp %q: %w", s, err)
+		}
+		return s, nil
+	}
+
+	if _, err := strconv.ParseUint(s, 10, 64); err == nil {
+		if len(s) != nanosecondDigits {
+			return "", fmt.Errorf("invalid timestamp %q: integer timestamps must be in nanoseconds (%d digits)", s, nanosecondDigits)
+		}
+		return s, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return strconv.FormatInt(t.UnixNano(), 10), nil
+	}
+
+	return "", fmt.Errorf("invalid timestamp %q: expected YYYY-MM-DD, RFC3339, or a nanosecond timestamp", s)
+}
+
+// normalizeTimestampParams applies normalizeTimestampParam to every
+// timestamp filter in params, rewriting them in place.
+func normalizeTimestampParams(params map[string]string) error {
+	for _, key := range timestampParamKeys {
+		value, err := normalizeTimestampParam(params[key])
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		params[key] = value
+	}
+	return nil
+}
+
+// ParseTimestamp parses a precise point in time given as an RFC3339 time
+// (with optional fractional seconds) or a 19-digit Unix nanosecond
+// timestamp. Dates are not accepted; callers that also take dates handle
+// them separately.
+func ParseTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if _, err := strconv.ParseUint(s, 10, 64); err == nil {
+		if len(s) != nanosecondDigits {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q: integer timestamps must be in nanoseconds (%d digits)", s, nanosecondDigits)
+		}
+		ns, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q: %w", s, err)
+		}
+		return time.Unix(0, ns).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q: expected RFC3339 or a nanosecond timestamp", s)
+	}
+	return t, nil
+}
+
+// EpochTime converts a Unix timestamp whose precision is not known in
+// advance to a time. Snapshot endpoints report updated times in
+// nanoseconds, milliseconds, or seconds depending on the asset class, so
+// the unit is inferred from the magnitude: any plausible date in
+// nanoseconds has at least 18 digits, in microseconds 15, in milliseconds
+// 12, and seconds fewer.
+func EpochTime(v int64) time.Time {
+	switch {
+	case v >= 1e17:
+		return time.Unix(0, v)
+	case v >= 1e14:
+		return time.UnixMicro(v)
+	case v >= 1e11:
+		return time.UnixMilli(v)
+	default:
+		return time.Unix(v, 0)
+	}
+}
+
+// TimeUnit is the precision of an integer timestamp field returned by the
+// API. Aggregates, indicators, and last trade/quote endpoints report
+// milliseconds, while v3 trades and quotes, futures, and crypto
+// participant times report nanoseconds.
+type TimeUnit int
+
+const (
+	// UnitMilliseconds marks a timestamp in Unix milliseconds.
+	UnitMilliseconds TimeUnit = iota
+	// UnitNanoseconds marks a timestamp in Unix nanoseconds.
+	UnitNanoseconds
+)
+
+// Time converts value, a Unix timestamp in unit u, to a local time.
+func (u TimeUnit) Time(value int64) time.Time {
+	if u == UnitNanoseconds {
+		return time.Unix(0, value)
+	}
+	return time.UnixMilli(value)
+}
+
+// FormatTimestamp renders value, a Unix timestamp in unit, with layout in
+// local time. A zero value means the field was missing and renders as "-"
+// rather than a 1970 date.
+func FormatTimestamp(value int64, unit TimeUnit, layout string) string {
+	if value == 0 {
+		return "-"
+	}
+	return unit.Time(value).Format(layout)
+}
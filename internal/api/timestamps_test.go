@@ -0,0 +1,168 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestNormalizeTimestampParam verifies each accepted timestamp form and
+// that ambiguous or malformed input is rejected.
+func TestNormalizeTimestampParam(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", ""},
+		{"date", "2025-01-06", "2025-01-06"},
+		{"nanoseconds", "1736150400000000000", "1736150400000000000"},
+		{"rfc3339", "2025-01-06T08:00:00Z", "1736150400000000000"},
+		{"rfc3339 offset", "2025-01-06T03:00:00-05:00", "1736150400000000000"},
+		{"rfc3339 fractional", "2025-01-06T08:00:00.5Z", "1736150400500000000"},
+		{"surrounding space", " 2025-01-06 ", "2025-01-06"},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeTimestampParam(tt.input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+
+	for _, input := range []string{
+		"1736150400",       // seconds
+		"1736150400000",    // milliseconds
+		"1736150400000000", // microseconds
+		"-1736150400000000000",
+		"2025-13-01",
+		"2025/01/06",
+		"yesterday",
+	} {
+		if _, err := normalizeTimestampParam(input); err == nil {
+			t.Errorf("expected error for %q, got nil", input)
+		}
+	}
+}
+
+// TestGetForexQuotesNormalizesTimestamps verifies that an RFC3339 filter
+// is sent as nanoseconds and that an ambiguous filter fails before any
+// request is made.
+func TestGetForexQuotesNormalizesTimestamps(t *testing.T) {
+	var got string
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		got = r.URL.Query().Get("timestamp.gte")
+		w.Write([]byte(`{"status":"OK","results":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if _, err := client.GetForexQuotes("C:EURUSD", ForexQuotesParams{TimestampGte: "2025-01-06T08:00:00Z"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "1736150400000000000" {
+		t.Errorf("expected nanosecond timestamp.gte, got %q", got)
+	}
+
+	if _, err := client.GetFuturesTrades("ESZ5", FuturesTradesParams{TimestampLt: "1736150400"}); err == nil {
+		t.Error("expected error for seconds timestamp, got nil")
+	}
+	if requests != 1 {
+		t.Error("expected no request to be sent for an invalid timestamp")
+	}
+}
+
+// TestParseTimestamp verifies RFC3339 and nanosecond input and that dates
+// and integers of other lengths are rejected.
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"1736150400000000001", 1736150400000000001},
+		{"2025-01-06T08:00:00Z", 1736150400000000000},
+		{"2025-01-06T03:00:00.25-05:00", 1736150400250000000},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTimestamp(tt.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got.UnixNano() != tt.want {
+			t.Errorf("%q: expected %d, got %d", tt.input, tt.want, got.UnixNano())
+		}
+	}
+
+	for _, input := range []string{"", "2025-01-06", "1736150400000", "99999999999999999999", "yesterday"} {
+		if _, err := ParseTimestamp(input); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
+
+// TestEpochTime verifies that seconds, milliseconds, microseconds, and
+// nanoseconds for the same instant all convert to that instant.
+func TestEpochTime(t *testing.T) {
+	want := time.Date(2025, 1, 6, 5, 0, 0, 0, time.UTC)
+
+	for _, v := range []int64{1736139600, 1736139600000, 1736139600000000, 1736139600000000000} {
+		if got := EpochTime(v); !got.Equal(want) {
+			t.Errorf("%d: expected %v, got %v", v, want, got.UTC())
+		}
+	}
+}
+
+// TestFormatTimestamp verifies that known millisecond and nanosecond
+// timestamps from API responses render to the expected dates, and that a
+// missing value renders as "-".
+func TestFormatTimestamp(t *testing.T) {
+	local := time.Local
+	time.Local = time.UTC
+	defer func() { time.Local = local }()
+
+	tests := []struct {
+		value  int64
+		unit   TimeUnit
+		layout string
+		want   string
+	}{
+		{1736225999000, UnitMilliseconds, "2006-01-02 15:04:05", "2025-01-07 04:59:59"},
+		{1704171600000, UnitMilliseconds, "2006-01-02", "2024-01-02"},
+		{1736225999000000000, UnitNanoseconds, "2006-01-02 15:04:05", "2025-01-07 04:59:59"},
+		{1710460800123000000, UnitNanoseconds, "2006-01-02 15:04:05.000", "2024-03-15 00:00:00.123"},
+		{0, UnitMilliseconds, "2006-01-02", "-"},
+		{0, UnitNanoseconds, "2006-01-02", "-"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatTimestamp(tt.value, tt.unit, tt.layout); got != tt.want {
+			t.Errorf("FormatTimestamp(%d, %d): expected %s, got %s", tt.value, tt.unit, tt.want, got)
+		}
+	}
+}
+
+// TestFormatTimestampWrongUnit documents the failure mode the unit guards
+// against: reading nanoseconds as milliseconds lands far in the future,
+// and milliseconds as nanoseconds lands in 1970.
+func TestFormatTimestampWrongUnit(t *testing.T) {
+	if year := UnitMilliseconds.Time(1736225999000000000).Year(); year < 3000 {
+		t.Errorf("expected nanoseconds read as milliseconds to overflow far past 3000, got %d", year)
+	}
+	if year := UnitNanoseconds.Time(1736225999000).UTC().Year(); year != 1970 {
+		t.Errorf("expected milliseconds read as nanoseconds to land in 1970, got %d", year)
+	}
+}
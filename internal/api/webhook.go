@@ -0,0 +1,62 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// PostJSON sends body as a JSON POST to target, such as a Slack or
+// Discord webhook, using the client's HTTP client and its timeout. Extra
+// headers are added as given; the API key is never attached, since the
+// target is not the Massive API. It returns the response status line so
+// callers can report it. A non-2xx response is an error carrying the
+// status and the start of the body. Webhook URLs often embed a secret, so
+// errors never include the URL.
+func (c *Client) PostJSON(target string, headers http.Header, body []byte) (string, error) {
+	u, err := url.Parse(target)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid POST URL %q: must be an http or https URL", target)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+	for name, values := range headers {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("POST failed: %w", redactURLError(err))
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.Status, fmt.Errorf("POST returned %s: %s", resp.Status, bytes.TrimSpace(respBody))
+	}
+
+	return resp.Status, nil
+}
+
+// redactURLError strips the request URL from a transport error, which
+// *url.Error includes in its message, leaving the underlying cause.
+func redactURLError(err error) error {
+	if uerr, ok := err.(*url.Error); ok {
+		return uerr.Err
+	}
+	return err
+}
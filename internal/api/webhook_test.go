@@ -0,0 +1,75 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestPostJSON verifies that PostJSON sends the body as JSON with the
+// extra headers, never attaches the API key, and reports the status.
+func TestPostJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected JSON content type, got %q", r.Header.Get("Content-Type"))
+		}
+		if r.Header.Get("X-Token") != "abc" {
+			t.Errorf("expected X-Token header, got %q", r.Header.Get("X-Token"))
+		}
+		if r.URL.Query().Has("apiKey") || r.Header.Get("Authorization") != "" {
+			t.Error("expected no API key on a webhook POST")
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"ok":true}` {
+			t.Errorf("unexpected body %q", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("secret")
+	client.SetAuthMode(AuthBearer)
+	status, err := client.PostJSON(server.URL+"/hook", http.Header{"X-Token": {"abc"}}, []byte(`{"ok":true}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != "204 No Content" {
+		t.Errorf("expected status 204 No Content, got %q", status)
+	}
+}
+
+// TestPostJSONErrors verifies that a non-2xx response is an error with
+// the status and body, and that non-HTTP URLs are rejected.
+func TestPostJSONErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("invalid_payload\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient("secret")
+	status, err := client.PostJSON(server.URL+"/hook/token", nil, []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), "400 Bad Request: invalid_payload") {
+		t.Errorf("expected a 400 error with the body, got %v", err)
+	}
+	if strings.Contains(err.Error(), "token") {
+		t.Errorf("expected the URL to be left out of the error, got %v", err)
+	}
+	if status != "400 Bad Request" {
+		t.Errorf("expected status 400 Bad Request, got %q", status)
+	}
+
+	if _, err := client.PostJSON("ftp://example.com/hook", nil, nil); err == nil {
+		t.Error("expected an error for a non-HTTP URL")
+	}
+}
@@ -10,13 +10,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 )
 
 const (
 	configDir  = ".config/massive"
 	configFile = "config.json"
+	cacheDir   = "cache"
 )
 
+// envRefPattern matches ${ENV_VAR} references inside config values so they
+// can be expanded from the environment at load time.
+var envRefPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
 // configDirOverride allows tests to redirect config storage to a temp directory.
 var configDirOverride string
 
@@ -27,13 +34,19 @@ func SetConfigDir(dir string) {
 }
 
 // Config holds the application configuration including API credentials,
-// the base URL for the Massive REST API, and S3 credentials for flat file access.
+// the base URL for the Massive REST API, S3 credentials for flat file access,
+// and the default output format. Keyring means the API key lives in the OS
+// keyring (see StoreAPIKeyInKeyring) rather than in APIKey. Precision maps
+// an asset class to its default price precision (see GetPrecision).
 type Config struct {
-	APIKey      string `json:"api_key"`
-	BaseURL     string `json:"base_url"`
-	S3AccessKey string `json:"s3_access_key,omitempty"`
-	S3SecretKey string `json:"s3_secret_key,omitempty"`
-	S3Endpoint  string `json:"s3_endpoint,omitempty"`
+	APIKey      string            `json:"api_key"`
+	Keyring     bool              `json:"keyring,omitempty"`
+	BaseURL     string            `json:"base_url"`
+	S3AccessKey string            `json:"s3_access_key,omitempty"`
+	S3SecretKey string            `json:"s3_secret_key,omitempty"`
+	S3Endpoint  string            `json:"s3_endpoint,omitempty"`
+	Output      string            `json:"output,omitempty"`
+	Precision   map[string]string `json:"precision,omitempty"`
 }
 
 // DefaultConfig returns a Config with default values. The base URL defaults
@@ -69,6 +82,17 @@ func configDirPath() (string, error) {
 	return filepath.Join(home, configDir), nil
 }
 
+// CacheDir returns the directory for local caches such as the ticker
+// index, ~/.config/massive/cache/ by default. The directory is not
+// created until something is written to it.
+func CacheDir() (string, error) {
+	dir, err := configDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cacheDir), nil
+}
+
 // Load reads the configuration from disk. If the config file does not exist,
 // it returns a default configuration. Returns an error if the file exists
 // but cannot be read or parsed.
@@ -124,22 +148,109 @@ func Save(cfg *Config) error {
 	return nil
 }
 
-// GetAPIKey returns the API key by checking the MASSIVE_API_KEY environment
-// variable first, then falling back to the config file. Returns an error
-// if no API key is found in either location.
+// GetAPIKey resolves the API key using the following precedence: the
+// MASSIVE_API_KEY environment variable, then the file named by
+// MASSIVE_API_KEY_FILE (for Docker/Kubernetes secrets), then the OS
+// keyring when the config sets keyring: true, then the api_key value in
+// the config file. A keyring that is unavailable or holds no key falls
+// through to the config value. Any ${ENV_VAR} references in the config
+// value are expanded from the environment. Returns an error if no API key
+// can be resolved from any of these sources.
 func GetAPIKey() (string, error) {
 	if key := os.Getenv("MASSIVE_API_KEY"); key != "" {
 		return key, nil
 	}
 
+	if path := os.Getenv("MASSIVE_API_KEY_FILE"); path != "" {
+		return readAPIKeyFile(path)
+	}
+
 	cfg, err := Load()
 	if err != nil {
 		return "", err
 	}
 
+	var keyringErr error
+	if cfg.Keyring {
+		key, err := keyringAPIKey()
+		if err == nil {
+			return key, nil
+		}
+		keyringErr = err
+	}
+
 	if cfg.APIKey == "" {
-		return "", fmt.Errorf("API key not configured. Run 'massive config init' or set MASSIVE_API_KEY environment variable")
+		if keyringErr != nil {
+			return "", fmt.Errorf("API key not available from the OS keyring (%v). Run 'massive auth login' or set MASSIVE_API_KEY or MASSIVE_API_KEY_FILE environment variable", keyringErr)
+		}
+		return "", fmt.Errorf("API key not configured. Run 'massive config init' or set MASSIVE_API_KEY or MASSIVE_API_KEY_FILE environment variable")
+	}
+
+	return expandEnvRefs(cfg.APIKey)
+}
+
+// GetOutputFormat resolves the default output format using the following
+// precedence: the MASSIVE_OUTPUT environment variable, then the output
+// value in the config file. Only "table" and "json" are accepted. Returns
+// an empty string when neither source sets a format.
+func GetOutputFormat() (string, error) {
+	format, source := os.Getenv("MASSIVE_OUTPUT"), "MASSIVE_OUTPUT"
+	if format == "" {
+		cfg, err := Load()
+		if err != nil {
+			return "", err
+		}
+		format, source = cfg.Output, "config output"
+	}
+
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case "", "table", "json":
+		return format, nil
+	}
+
+	return "", fmt.Errorf("%s must be table or json, got %q", source, format)
+}
+
+// readAPIKeyFile reads an API key from the given file path, trimming any
+// surrounding whitespace or trailing newline. Returns an error if the file
+// cannot be read or is empty.
+func readAPIKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read MASSIVE_API_KEY_FILE %s: %w", path, err)
+	}
+
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("MASSIVE_API_KEY_FILE %s is empty", path)
+	}
+
+	return key, nil
+}
+
+// expandEnvRefs replaces every ${ENV_VAR} reference in the given value with
+// the matching environment variable. Returns an error naming the variable
+// if a referenced variable is unset or empty, or if the expanded value is
+// empty.
+func expandEnvRefs(value string) (string, error) {
+	var missing string
+	expanded := envRefPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := envRefPattern.FindStringSubmatch(ref)[1]
+		v := os.Getenv(name)
+		if v == "" && missing == "" {
+			missing = name
+		}
+		return v
+	})
+
+	if missing != "" {
+		return "", fmt.Errorf("config api_key references environment variable %s, which is not set", missing)
+	}
+
+	if strings.TrimSpace(expanded) == "" {
+		return "", fmt.Errorf("config api_key expanded to an empty value")
 	}
 
-	return cfg.APIKey, nil
+	return expanded, nil
 }
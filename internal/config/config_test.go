@@ -8,6 +8,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -168,6 +169,7 @@ func TestGetAPIKeyFromConfig(t *testing.T) {
 	setupTestDir(t)
 
 	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", "")
 
 	cfg := &Config{
 		APIKey:  "config-test-key",
@@ -218,6 +220,7 @@ func TestGetAPIKeyNotConfigured(t *testing.T) {
 	setupTestDir(t)
 
 	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", "")
 
 	_, err := GetAPIKey()
 	if err == nil {
@@ -225,6 +228,177 @@ func TestGetAPIKeyNotConfigured(t *testing.T) {
 	}
 }
 
+// TestGetAPIKeyFromFile verifies that GetAPIKey reads the key from the file
+// named by MASSIVE_API_KEY_FILE, trimming the trailing newline.
+func TestGetAPIKeyFromFile(t *testing.T) {
+	dir := setupTestDir(t)
+
+	path := filepath.Join(dir, "api_key")
+	if err := os.WriteFile(path, []byte("file-key\n"), 0600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", path)
+
+	key, err := GetAPIKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key != "file-key" {
+		t.Errorf("expected file-key, got %s", key)
+	}
+}
+
+// TestGetAPIKeyFileTakesPrecedenceOverConfig verifies that the key file is
+// used before the config file, while MASSIVE_API_KEY still wins over both.
+func TestGetAPIKeyFileTakesPrecedenceOverConfig(t *testing.T) {
+	dir := setupTestDir(t)
+
+	if err := Save(&Config{APIKey: "config-key", BaseURL: "https://api.massive.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	path := filepath.Join(dir, "api_key")
+	if err := os.WriteFile(path, []byte("file-key"), 0600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", path)
+
+	key, err := GetAPIKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key != "file-key" {
+		t.Errorf("expected file-key, got %s", key)
+	}
+
+	t.Setenv("MASSIVE_API_KEY", "env-key")
+
+	key, err = GetAPIKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key != "env-key" {
+		t.Errorf("expected env-key, got %s", key)
+	}
+}
+
+// TestGetAPIKeyFileMissing verifies that GetAPIKey returns an error when
+// MASSIVE_API_KEY_FILE points at a file that does not exist.
+func TestGetAPIKeyFileMissing(t *testing.T) {
+	dir := setupTestDir(t)
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", filepath.Join(dir, "missing"))
+
+	_, err := GetAPIKey()
+	if err == nil {
+		t.Error("expected error for missing key file, got nil")
+	}
+}
+
+// TestGetAPIKeyFileEmpty verifies that GetAPIKey returns an error when the
+// key file exists but contains only whitespace.
+func TestGetAPIKeyFileEmpty(t *testing.T) {
+	dir := setupTestDir(t)
+
+	path := filepath.Join(dir, "api_key")
+	if err := os.WriteFile(path, []byte("  \n"), 0600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", path)
+
+	_, err := GetAPIKey()
+	if err == nil {
+		t.Error("expected error for empty key file, got nil")
+	}
+}
+
+// TestGetAPIKeyExpandsEnvRefs verifies that ${ENV_VAR} references in the
+// config file api_key are expanded from the environment.
+func TestGetAPIKeyExpandsEnvRefs(t *testing.T) {
+	setupTestDir(t)
+
+	if err := Save(&Config{APIKey: "${MY_MASSIVE_SECRET}", BaseURL: "https://api.massive.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", "")
+	t.Setenv("MY_MASSIVE_SECRET", "expanded-key")
+
+	key, err := GetAPIKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if key != "expanded-key" {
+		t.Errorf("expected expanded-key, got %s", key)
+	}
+}
+
+// TestGetAPIKeyEnvRefUnset verifies that GetAPIKey returns an error naming
+// the variable when the config references an unset environment variable.
+func TestGetAPIKeyEnvRefUnset(t *testing.T) {
+	setupTestDir(t)
+
+	if err := Save(&Config{APIKey: "${MY_MASSIVE_SECRET}", BaseURL: "https://api.massive.com"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", "")
+	t.Setenv("MY_MASSIVE_SECRET", "")
+
+	_, err := GetAPIKey()
+	if err == nil {
+		t.Fatal("expected error for unset environment variable, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "MY_MASSIVE_SECRET") {
+		t.Errorf("expected error to name MY_MASSIVE_SECRET, got %v", err)
+	}
+}
+
+// TestGetOutputFormat verifies the MASSIVE_OUTPUT and config file sources,
+// their precedence, the unset case, and rejection of unknown formats.
+func TestGetOutputFormat(t *testing.T) {
+	setupTestDir(t)
+	t.Setenv("MASSIVE_OUTPUT", "")
+
+	format, err := GetOutputFormat()
+	if err != nil || format != "" {
+		t.Fatalf("expected no format, got %q (err %v)", format, err)
+	}
+
+	if err := Save(&Config{Output: "json"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	format, err = GetOutputFormat()
+	if err != nil || format != "json" {
+		t.Errorf("expected json from config, got %q (err %v)", format, err)
+	}
+
+	t.Setenv("MASSIVE_OUTPUT", "Table")
+	format, err = GetOutputFormat()
+	if err != nil || format != "table" {
+		t.Errorf("expected env table to take precedence, got %q (err %v)", format, err)
+	}
+
+	t.Setenv("MASSIVE_OUTPUT", "csv")
+	if _, err := GetOutputFormat(); err == nil {
+		t.Error("expected error for an unknown format")
+	}
+}
+
 // TestSaveOverwritesExisting verifies that saving a config overwrites
 // any previously saved configuration.
 func TestSaveOverwritesExisting(t *testing.T) {
@@ -253,3 +427,18 @@ func TestSaveOverwritesExisting(t *testing.T) {
 		t.Errorf("expected custom base URL, got %s", loaded.BaseURL)
 	}
 }
+
+// TestCacheDir verifies the cache directory lives under the config
+// directory.
+func TestCacheDir(t *testing.T) {
+	dir := setupTestDir(t)
+
+	got, err := CacheDir()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := filepath.Join(dir, "cache"); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
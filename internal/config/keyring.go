@@ -0,0 +1,73 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Keyring entry the API key is stored under: the macOS Keychain, the
+// Secret Service on Linux, or the Windows Credential Manager.
+const (
+	KeyringService = "massive-cli"
+	KeyringUser    = "api_key"
+)
+
+// StoreAPIKeyInKeyring saves key in the OS keyring and sets keyring: true
+// in the config file so GetAPIKey reads it from there. Any api_key left in
+// the config file is removed, so the key is no longer stored in plain text.
+func StoreAPIKeyInKeyring(key string) error {
+	if key == "" {
+		return fmt.Errorf("API key cannot be empty")
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+
+	if err := keyring.Set(KeyringService, KeyringUser, key); err != nil {
+		return fmt.Errorf("failed to store API key in keyring: %w", err)
+	}
+
+	cfg.Keyring = true
+	cfg.APIKey = ""
+	return Save(cfg)
+}
+
+// DeleteAPIKeyFromKeyring removes the API key from the OS keyring and
+// turns keyring off in the config file. A key that was already missing
+// from the keyring is not an error.
+func DeleteAPIKeyFromKeyring() error {
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+
+	if err := keyring.Delete(KeyringService, KeyringUser); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to remove API key from keyring: %w", err)
+	}
+
+	cfg.Keyring = false
+	return Save(cfg)
+}
+
+// keyringAPIKey reads the API key from the OS keyring. It fails when no
+// keyring is available, such as on a headless Linux host without a
+// Secret Service, or when no key has been stored.
+func keyringAPIKey() (string, error) {
+	key, err := keyring.Get(KeyringService, KeyringUser)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", keyring.ErrNotFound
+	}
+	return key, nil
+}
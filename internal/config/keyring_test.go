@@ -0,0 +1,100 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+// TestKeyringLoginLogout verifies that storing a key puts it in the
+// keyring, turns keyring on, and drops the plain-text api_key, that
+// GetAPIKey then reads it from the keyring, and that deleting removes it.
+func TestKeyringLoginLogout(t *testing.T) {
+	setupTestDir(t)
+	keyring.MockInit()
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", "")
+
+	if err := Save(&Config{APIKey: "plain-key"}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if err := StoreAPIKeyInKeyring("keyring-key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if !cfg.Keyring || cfg.APIKey != "" {
+		t.Errorf("expected keyring on and no api_key, got %+v", cfg)
+	}
+
+	key, err := GetAPIKey()
+	if err != nil || key != "keyring-key" {
+		t.Errorf("expected keyring-key, got %q (%v)", key, err)
+	}
+
+	if err := DeleteAPIKeyFromKeyring(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := keyring.Get(KeyringService, KeyringUser); !errors.Is(err, keyring.ErrNotFound) {
+		t.Errorf("expected the key to be removed, got %v", err)
+	}
+	if cfg, _ := Load(); cfg.Keyring {
+		t.Error("expected keyring to be turned off")
+	}
+	if err := DeleteAPIKeyFromKeyring(); err != nil {
+		t.Errorf("expected a second logout to succeed, got %v", err)
+	}
+}
+
+// TestGetAPIKeyKeyringFallback verifies that an unavailable keyring falls
+// back to the config api_key, and that without one the error points at
+// the keyring.
+func TestGetAPIKeyKeyringFallback(t *testing.T) {
+	setupTestDir(t)
+	keyring.MockInitWithError(errors.New("no secret service"))
+
+	t.Setenv("MASSIVE_API_KEY", "")
+	t.Setenv("MASSIVE_API_KEY_FILE", "")
+
+	if err := Save(&Config{APIKey: "config-key", Keyring: true}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	if key, err := GetAPIKey(); err != nil || key != "config-key" {
+		t.Errorf("expected config-key, got %q (%v)", key, err)
+	}
+
+	if err := Save(&Config{Keyring: true}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	_, err := GetAPIKey()
+	if err == nil || !strings.Contains(err.Error(), "keyring") {
+		t.Errorf("expected a keyring error, got %v", err)
+	}
+}
+
+// TestGetAPIKeyEnvBeforeKeyring verifies that MASSIVE_API_KEY still takes
+// precedence over a key stored in the keyring.
+func TestGetAPIKeyEnvBeforeKeyring(t *testing.T) {
+	setupTestDir(t)
+	keyring.MockInit()
+
+	if err := StoreAPIKeyInKeyring("keyring-key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Setenv("MASSIVE_API_KEY", "env-key")
+
+	if key, err := GetAPIKey(); err != nil || key != "env-key" {
+		t.Errorf("expected env-key, got %q (%v)", key, err)
+	}
+}
@@ -0,0 +1,74 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Price precision modes other than a fixed number of decimals.
+// PrecisionAuto picks decimals by the price's magnitude; PrecisionTick
+// rounds futures prices to the contract's trade tick size.
+const (
+	PrecisionAuto = "auto"
+	PrecisionTick = "tick"
+)
+
+// MaxPrecision is the largest number of decimals a precision may ask for.
+const MaxPrecision = 12
+
+// DefaultPrecision holds the built-in price precision for asset classes
+// whose prices the tables' fixed four decimals misformat: crypto spans
+// from sub-cent tokens to five-figure coins, and forex is quoted to
+// fractional pips. Other asset classes keep each table's own format.
+var DefaultPrecision = map[string]string{
+	"crypto": PrecisionAuto,
+	"forex":  "5",
+}
+
+// ValidatePrecision checks a price precision for an asset class: a number
+// of decimals from 0 to MaxPrecision, auto, or tick for futures. Empty
+// means unset and is accepted.
+func ValidatePrecision(assetClass, value string) error {
+	switch value {
+	case "", PrecisionAuto:
+		return nil
+	case PrecisionTick:
+		if assetClass != "futures" {
+			return fmt.Errorf("precision tick only applies to futures")
+		}
+		return nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > MaxPrecision {
+		return fmt.Errorf("invalid precision %q: expected 0-%d, %s, or %s (futures)", value, MaxPrecision, PrecisionAuto, PrecisionTick)
+	}
+	return nil
+}
+
+// GetPrecision returns the default price precision for an asset class:
+// the config file's precision entry for it, then DefaultPrecision. Returns
+// an empty string when neither sets one, meaning each table's own format.
+func GetPrecision(assetClass string) (string, error) {
+	cfg, err := Load()
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := cfg.Precision[assetClass]
+	if !ok {
+		return DefaultPrecision[assetClass], nil
+	}
+
+	value = strings.ToLower(strings.TrimSpace(value))
+	if err := ValidatePrecision(assetClass, value); err != nil {
+		return "", fmt.Errorf("config precision.%s: %w", assetClass, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,71 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestValidatePrecision verifies decimal counts, auto, and futures-only
+// tick are accepted and anything else is rejected.
+func TestValidatePrecision(t *testing.T) {
+	tests := []struct {
+		assetClass string
+		value      string
+		wantErr    bool
+	}{
+		{"crypto", "", false},
+		{"crypto", "0", false},
+		{"forex", "5", false},
+		{"stocks", "12", false},
+		{"crypto", "auto", false},
+		{"futures", "tick", false},
+		{"stocks", "tick", true},
+		{"stocks", "13", true},
+		{"stocks", "-1", true},
+		{"stocks", "two", true},
+	}
+
+	for _, tt := range tests {
+		err := ValidatePrecision(tt.assetClass, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidatePrecision(%q, %q) error = %v, wantErr %v", tt.assetClass, tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+// TestGetPrecision verifies built-in defaults, config overrides (including
+// an explicit empty value restoring table formats), and invalid entries.
+func TestGetPrecision(t *testing.T) {
+	setupTestDir(t)
+
+	for assetClass, want := range map[string]string{"crypto": "auto", "forex": "5", "stocks": ""} {
+		got, err := GetPrecision(assetClass)
+		if err != nil || got != want {
+			t.Errorf("GetPrecision(%q) = %q (err %v), want default %q", assetClass, got, err, want)
+		}
+	}
+
+	cfg := &Config{Precision: map[string]string{"crypto": " 2 ", "forex": "", "futures": "TICK"}}
+	if err := Save(cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	for assetClass, want := range map[string]string{"crypto": "2", "forex": "", "futures": "tick", "stocks": ""} {
+		got, err := GetPrecision(assetClass)
+		if err != nil || got != want {
+			t.Errorf("GetPrecision(%q) = %q (err %v), want %q from config", assetClass, got, err, want)
+		}
+	}
+
+	if err := Save(&Config{Precision: map[string]string{"stocks": "tick"}}); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	_, err := GetPrecision("stocks")
+	if err == nil || !strings.Contains(err.Error(), "precision.stocks") {
+		t.Errorf("expected an error naming precision.stocks, got %v", err)
+	}
+}
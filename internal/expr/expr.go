@@ -0,0 +1,486 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+// Package expr parses and evaluates small boolean expressions over named
+// numeric fields, such as "change_pct > 5 || price < 40000". Expressions
+// support comparisons (> >= < <= == !=), arithmetic (+ - * /), the logical
+// operators && || and !, and parentheses. Commands use it for row filters
+// and alert rules.
+package expr
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr is a parsed boolean expression, ready to evaluate against fields.
+type Expr struct {
+	src    string
+	root   node
+	fields []string
+}
+
+// Parse compiles src into an Expr. It fails on syntax errors and when the
+// expression as a whole is not a true/false test, such as "price + 1".
+func Parse(src string) (*Expr, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos+1)
+	}
+	if !root.boolean() {
+		return nil, fmt.Errorf("expression %q is not a comparison", src)
+	}
+
+	seen := make(map[string]bool)
+	var fields []string
+	collectFields(root, seen, &fields)
+	sort.Strings(fields)
+
+	return &Expr{src: src, root: root, fields: fields}, nil
+}
+
+// String returns the source the expression was parsed from.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Fields returns the sorted, distinct field names the expression refers
+// to, so callers can reject unknown fields before fetching any data.
+func (e *Expr) Fields() []string {
+	return e.fields
+}
+
+// Eval evaluates the expression against fields. It fails when a referenced
+// field is missing or a division by zero occurs.
+func (e *Expr) Eval(fields map[string]float64) (bool, error) {
+	v, err := e.root.eval(fields)
+	if err != nil {
+		return false, err
+	}
+	return v != 0, nil
+}
+
+// -------------------------------------------------------------------
+// Lexer
+// -------------------------------------------------------------------
+
+// tokenKind identifies the category of a lexed token.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+// token is a single lexeme with its byte offset in the source.
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+	pos  int
+}
+
+// operators lists the operator lexemes, two-character ones first so they
+// win over their one-character prefixes.
+var operators = []string{"||", "&&", ">=", "<=", "==", "!=", ">", "<", "!", "+", "-", "*", "/"}
+
+// lex splits src into tokens, ending with a tokEOF token.
+func lex(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(src) && (isDigit(src[i]) || src[i] == '.' || src[i] == 'e' || src[i] == 'E' ||
+				((src[i] == '+' || src[i] == '-') && (src[i-1] == 'e' || src[i-1] == 'E'))) {
+				i++
+			}
+			n, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", src[start:i], start+1)
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: src[start:i], num: n, pos: start})
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(src) && (src[i] == '_' || isDigit(src[i]) || unicode.IsLetter(rune(src[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: src[start:i], pos: start})
+		default:
+			op := ""
+			for _, candidate := range operators {
+				if strings.HasPrefix(src[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+			}
+			tokens = append(tokens, token{kind: tokOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: tokEOF, text: "end of expression", pos: len(src)}), nil
+}
+
+// isDigit reports whether b is an ASCII digit.
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// -------------------------------------------------------------------
+// Parser
+// -------------------------------------------------------------------
+
+// node is an expression tree node. Boolean nodes evaluate to 1 or 0.
+type node interface {
+	eval(fields map[string]float64) (float64, error)
+	boolean() bool
+}
+
+// numberNode is a numeric literal.
+type numberNode struct{ value float64 }
+
+// fieldNode is a reference to a named field.
+type fieldNode struct{ name string }
+
+// notNode negates a boolean operand.
+type notNode struct{ operand node }
+
+// negNode negates a numeric operand.
+type negNode struct{ operand node }
+
+// binaryNode applies a binary operator to two operands.
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n numberNode) eval(map[string]float64) (float64, error) { return n.value, nil }
+func (n numberNode) boolean() bool                            { return false }
+
+func (n fieldNode) eval(fields map[string]float64) (float64, error) {
+	v, ok := fields[n.name]
+	if !ok {
+		return 0, fmt.Errorf("unknown field %q", n.name)
+	}
+	return v, nil
+}
+func (n fieldNode) boolean() bool { return false }
+
+func (n notNode) eval(fields map[string]float64) (float64, error) {
+	v, err := n.operand.eval(fields)
+	if err != nil {
+		return 0, err
+	}
+	return truth(v == 0), nil
+}
+func (n notNode) boolean() bool { return true }
+
+func (n negNode) eval(fields map[string]float64) (float64, error) {
+	v, err := n.operand.eval(fields)
+	return -v, err
+}
+func (n negNode) boolean() bool { return false }
+
+func (n binaryNode) eval(fields map[string]float64) (float64, error) {
+	l, err := n.left.eval(fields)
+	if err != nil {
+		return 0, err
+	}
+
+	// Short-circuit the logical operators like Go does.
+	switch n.op {
+	case "&&":
+		if l == 0 {
+			return 0, nil
+		}
+	case "||":
+		if l != 0 {
+			return 1, nil
+		}
+	}
+
+	r, err := n.right.eval(fields)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		return truth(r != 0), nil
+	case ">":
+		return truth(l > r), nil
+	case ">=":
+		return truth(l >= r), nil
+	case "<":
+		return truth(l < r), nil
+	case "<=":
+		return truth(l <= r), nil
+	case "==":
+		return truth(l == r), nil
+	case "!=":
+		return truth(l != r), nil
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	}
+
+	return 0, fmt.Errorf("unknown operator %q", n.op)
+}
+
+func (n binaryNode) boolean() bool {
+	switch n.op {
+	case "+", "-", "*", "/":
+		return false
+	}
+	return true
+}
+
+// truth converts a condition to the 1/0 value boolean nodes evaluate to.
+func truth(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// collectFields appends each distinct field name under n to fields.
+func collectFields(n node, seen map[string]bool, fields *[]string) {
+	switch n := n.(type) {
+	case fieldNode:
+		if !seen[n.name] {
+			seen[n.name] = true
+			*fields = append(*fields, n.name)
+		}
+	case notNode:
+		collectFields(n.operand, seen, fields)
+	case negNode:
+		collectFields(n.operand, seen, fields)
+	case binaryNode:
+		collectFields(n.left, seen, fields)
+		collectFields(n.right, seen, fields)
+	}
+}
+
+// parser is a recursive-descent parser over a token slice. Precedence
+// from lowest to highest: ||, &&, !, comparisons, + -, * /, unary minus.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// acceptOp consumes the current token if it is one of ops.
+func (p *parser) acceptOp(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != tokOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+// parseOr parses a chain of || operands.
+func (p *parser) parseOr() (node, error) {
+	return p.parseLogical("||", p.parseAnd)
+}
+
+// parseAnd parses a chain of && operands.
+func (p *parser) parseAnd() (node, error) {
+	return p.parseLogical("&&", p.parseNot)
+}
+
+// parseLogical parses operands joined by op, requiring each to be boolean.
+func (p *parser) parseLogical(op string, operand func() (node, error)) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.peek()
+		if _, ok := p.acceptOp(op); !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if !left.boolean() || !right.boolean() {
+			return nil, fmt.Errorf("%s at position %d needs comparisons on both sides", op, tok.pos+1)
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+}
+
+// parseNot parses an optional ! prefix.
+func (p *parser) parseNot() (node, error) {
+	tok := p.peek()
+	if _, ok := p.acceptOp("!"); ok {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if !operand.boolean() {
+			return nil, fmt.Errorf("! at position %d needs a comparison", tok.pos+1)
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+// parseComparison parses a sum optionally compared with another sum.
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	op, ok := p.acceptOp(">=", "<=", "==", "!=", ">", "<")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if left.boolean() || right.boolean() {
+		return nil, fmt.Errorf("%s at position %d compares a condition instead of a number", op, tok.pos+1)
+	}
+	return binaryNode{op: op, left: left, right: right}, nil
+}
+
+// parseSum parses terms joined by + and -.
+func (p *parser) parseSum() (node, error) {
+	return p.parseArithmetic([]string{"+", "-"}, p.parseProduct)
+}
+
+// parseProduct parses factors joined by * and /.
+func (p *parser) parseProduct() (node, error) {
+	return p.parseArithmetic([]string{"*", "/"}, p.parseUnary)
+}
+
+// parseArithmetic parses operands joined by any of ops, requiring each to
+// be numeric.
+func (p *parser) parseArithmetic(ops []string, operand func() (node, error)) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.peek()
+		op, ok := p.acceptOp(ops...)
+		if !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if left.boolean() || right.boolean() {
+			return nil, fmt.Errorf("%s at position %d needs numbers on both sides", op, tok.pos+1)
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+}
+
+// parseUnary parses an optional unary minus.
+func (p *parser) parseUnary() (node, error) {
+	tok := p.peek()
+	if _, ok := p.acceptOp("-"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if operand.boolean() {
+			return nil, fmt.Errorf("- at position %d needs a number", tok.pos+1)
+		}
+		return negNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a number, a field name, or a parenthesized
+// expression.
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		return numberNode{value: tok.num}, nil
+	case tokIdent:
+		return fieldNode{name: tok.text}, nil
+	case tokLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, fmt.Errorf("expected ) at position %d, got %q", closing.pos+1, closing.text)
+		}
+		return inner, nil
+	}
+
+	if tok.kind == tokEOF {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos+1)
+}
@@ -0,0 +1,134 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package expr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestEval verifies operators, precedence, and parentheses evaluate as in Go.
+func TestEval(t *testing.T) {
+	fields := map[string]float64{"price": 42000, "change_pct": 3.5, "volume": 1200, "prev_close": 40000}
+
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{"change_pct > 5 || price < 40000", false},
+		{"change_pct > 3 || price < 40000", true},
+		{"change_pct > 3 && price < 40000", false},
+		{"price >= 42000 && price <= 42000", true},
+		{"price == 42000", true},
+		{"price != 42000", false},
+		{"!(price > 50000)", true},
+		{"!price > 50000", true},
+		{"price > prev_close * 1.05", false},
+		{"price / prev_close - 1 > 0.04", true},
+		{"-change_pct < -3", true},
+		{"volume > 1e3", true},
+		{"change_pct > .5", true},
+		{"price > 1 || change_pct > 1 && volume < 0", true},
+		{"(price > 1 || change_pct > 1) && volume < 0", false},
+		{"price - prev_close - 1000 == 1000", true},
+	}
+
+	for _, tt := range tests {
+		e, err := Parse(tt.src)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.src, err)
+		}
+		got, err := e.Eval(fields)
+		if err != nil {
+			t.Fatalf("Eval(%q): %v", tt.src, err)
+		}
+		if got != tt.want {
+			t.Errorf("Eval(%q) = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+// TestParseErrors verifies malformed and non-boolean expressions are
+// rejected with a useful message.
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		src     string
+		wantErr string
+	}{
+		{"", "unexpected end"},
+		{"price", "not a comparison"},
+		{"price + 1", "not a comparison"},
+		{"price > ", "unexpected end"},
+		{"price > 5 5", "unexpected \"5\""},
+		{"(price > 5", "expected )"},
+		{"price > 5 && volume", "needs comparisons"},
+		{"(price > 5) > 1", "compares a condition"},
+		{"(price > 5) + 1 > 0", "needs numbers"},
+		{"price $ 5", "unexpected character"},
+		{"price > 1.2.3", "invalid number"},
+		{"!price", "needs a comparison"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.src)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Parse(%q) error = %v, want containing %q", tt.src, err, tt.wantErr)
+		}
+	}
+}
+
+// TestEvalErrors verifies missing fields and division by zero fail.
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		src     string
+		wantErr string
+	}{
+		{"missing > 1", `unknown field "missing"`},
+		{"price / zero > 1", "division by zero"},
+	}
+
+	for _, tt := range tests {
+		e, err := Parse(tt.src)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.src, err)
+		}
+		_, err = e.Eval(map[string]float64{"price": 1, "zero": 0})
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Eval(%q) error = %v, want containing %q", tt.src, err, tt.wantErr)
+		}
+	}
+}
+
+// TestEvalShortCircuit verifies the right side of && and || is skipped
+// when the left side decides the result, as in Go.
+func TestEvalShortCircuit(t *testing.T) {
+	for _, src := range []string{"price > 0 || missing > 1", "price < 0 && missing > 1"} {
+		e, err := Parse(src)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", src, err)
+		}
+		if _, err := e.Eval(map[string]float64{"price": 1}); err != nil {
+			t.Errorf("Eval(%q): %v", src, err)
+		}
+	}
+}
+
+// TestFields verifies referenced fields are returned sorted and distinct.
+func TestFields(t *testing.T) {
+	e, err := Parse("price > 5 || (change_pct < -2 && price < prev_close)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"change_pct", "prev_close", "price"}
+	if got := e.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+	if got := e.String(); got != "price > 5 || (change_pct < -2 && price < prev_close)" {
+		t.Errorf("String() = %q", got)
+	}
+}
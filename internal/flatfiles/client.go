@@ -99,6 +99,11 @@ func NewS3Client(accessKey, secretKey, endpoint string) *S3Client {
 	}
 }
 
+// Bucket returns the name of the S3 bucket the client reads flat files from.
+func (s *S3Client) Bucket() string {
+	return s.bucket
+}
+
 // ListFiles lists all available files in S3 that match the given asset class,
 // data type, year, and month. It constructs the appropriate S3 key prefix and
 // performs a ListObjectsV2 call to retrieve matching objects. Returns a slice
@@ -0,0 +1,226 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+// Package parquet writes small, flat Apache Parquet files without any
+// third-party dependency. Files hold a single row group of required
+// INT64, DOUBLE, or millisecond timestamp columns, each stored as one
+// uncompressed PLAIN-encoded data page, which pandas, Polars, Spark, and
+// DuckDB all read directly.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// magic opens and closes every Parquet file.
+const magic = "PAR1"
+
+// CreatedBy is recorded in the footer of every file written.
+const CreatedBy = "massive-cli"
+
+// Type is the logical type of a column.
+type Type int
+
+const (
+	// Int64 is a signed 64-bit integer column read from Column.Int64s.
+	Int64 Type = iota
+	// Double is a 64-bit float column read from Column.Doubles.
+	Double
+	// TimestampMillis is a UTC timestamp column of epoch milliseconds read
+	// from Column.Int64s.
+	TimestampMillis
+)
+
+// Parquet physical types and enum values used in the metadata.
+const (
+	physicalInt64            = 2
+	physicalDouble           = 5
+	repetitionRequired       = 0
+	convertedTimestampMillis = 9
+	encodingPlain            = 0
+	codecUncompressed        = 0
+	pageTypeData             = 0
+	encodingRLE              = 3
+)
+
+// Column is one named column of a file. Exactly one of Int64s or Doubles
+// is used, depending on Type.
+type Column struct {
+	Name    string
+	Type    Type
+	Int64s  []int64
+	Doubles []float64
+}
+
+// len returns the number of values in the column.
+func (c Column) len() int {
+	if c.Type == Double {
+		return len(c.Doubles)
+	}
+	return len(c.Int64s)
+}
+
+// physical returns the Parquet physical type that stores the column.
+func (c Column) physical() int32 {
+	if c.Type == Double {
+		return physicalDouble
+	}
+	return physicalInt64
+}
+
+// plain returns the column's values in PLAIN encoding: 8 little-endian
+// bytes per value.
+func (c Column) plain() []byte {
+	out := make([]byte, 0, 8*c.len())
+	if c.Type == Double {
+		for _, v := range c.Doubles {
+			out = binary.LittleEndian.AppendUint64(out, math.Float64bits(v))
+		}
+		return out
+	}
+	for _, v := range c.Int64s {
+		out = binary.LittleEndian.AppendUint64(out, uint64(v))
+	}
+	return out
+}
+
+// columnChunk records where a column's data page landed in the file.
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+// Write encodes columns as a Parquet file to w. All columns must have a
+// name and the same number of values.
+func Write(w io.Writer, columns []Column) error {
+	if len(columns) == 0 {
+		return errors.New("parquet: no columns")
+	}
+
+	rows := columns[0].len()
+	for _, c := range columns {
+		if c.Name == "" {
+			return errors.New("parquet: column has no name")
+		}
+		if c.Type != Int64 && c.Type != Double && c.Type != TimestampMillis {
+			return fmt.Errorf("parquet: column %s has unknown type %d", c.Name, c.Type)
+		}
+		if c.len() != rows {
+			return fmt.Errorf("parquet: column %s has %d values, expected %d", c.Name, c.len(), rows)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+
+	chunks := make([]columnChunk, len(columns))
+	for i, c := range columns {
+		data := c.plain()
+		header := pageHeader(rows, len(data))
+
+		chunks[i] = columnChunk{offset: int64(buf.Len()), size: int64(len(header) + len(data))}
+		buf.Write(header)
+		buf.Write(data)
+	}
+
+	footer := fileMetaData(columns, chunks, rows)
+	buf.Write(footer)
+	buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(footer))))
+	buf.WriteString(magic)
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("parquet: %w", err)
+	}
+
+	return nil
+}
+
+// pageHeader encodes the header of an uncompressed PLAIN data page
+// holding rows values in size bytes. Required columns carry no
+// repetition or definition levels.
+func pageHeader(rows, size int) []byte {
+	t := newThriftWriter()
+	t.i32(1, pageTypeData)
+	t.i32(2, int32(size))
+	t.i32(3, int32(size))
+	t.begin(5)
+	t.i32(1, int32(rows))
+	t.i32(2, encodingPlain)
+	t.i32(3, encodingRLE)
+	t.i32(4, encodingRLE)
+	t.end()
+	return t.bytes()
+}
+
+// fileMetaData encodes the file footer: the flat schema and a single row
+// group pointing at each column's data page.
+func fileMetaData(columns []Column, chunks []columnChunk, rows int) []byte {
+	t := newThriftWriter()
+	t.i32(1, 1)
+
+	t.list(2, thriftStruct, len(columns)+1)
+	t.beginElem()
+	t.string(4, "schema")
+	t.i32(5, int32(len(columns)))
+	t.end()
+	for _, c := range columns {
+		t.beginElem()
+		t.i32(1, c.physical())
+		t.i32(3, repetitionRequired)
+		t.string(4, c.Name)
+		if c.Type == TimestampMillis {
+			t.i32(6, convertedTimestampMillis)
+			t.begin(10)
+			t.begin(8)
+			t.bool(1, true)
+			t.begin(2)
+			t.begin(1)
+			t.end()
+			t.end()
+			t.end()
+			t.end()
+		}
+		t.end()
+	}
+
+	t.i64(3, int64(rows))
+
+	var total int64
+	for _, ch := range chunks {
+		total += ch.size
+	}
+
+	t.list(4, thriftStruct, 1)
+	t.beginElem()
+	t.list(1, thriftStruct, len(columns))
+	for i, c := range columns {
+		t.beginElem()
+		t.i64(2, chunks[i].offset)
+		t.begin(3)
+		t.i32(1, c.physical())
+		t.list(2, thriftI32, 1)
+		t.zigzag(encodingPlain)
+		t.list(3, thriftBinary, 1)
+		t.rawString(c.Name)
+		t.i32(4, codecUncompressed)
+		t.i64(5, int64(rows))
+		t.i64(6, chunks[i].size)
+		t.i64(7, chunks[i].size)
+		t.i64(9, chunks[i].offset)
+		t.end()
+		t.end()
+	}
+	t.i64(2, total)
+	t.i64(3, int64(rows))
+	t.end()
+
+	t.string(6, CreatedBy)
+	return t.bytes()
+}
@@ -0,0 +1,415 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package parquet_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cloudmanic/massive-cli/internal/parquet"
+)
+
+// The reader below is written from the Parquet format specification
+// (parquet.thrift) and the Thrift compact protocol specification rather
+// than from the writer: it lives in an external test package, shares no
+// constants with the package under test, and rejects any footer or page
+// header field whose id or wire type disagrees with the IDL.
+
+// Thrift compact protocol wire types.
+const (
+	wireTrue   = 1
+	wireFalse  = 2
+	wireI32    = 5
+	wireI64    = 6
+	wireBinary = 8
+	wireList   = 9
+	wireStruct = 12
+)
+
+// idlField is one field of a parquet.thrift struct: the IDL type it must
+// be encoded as, the element type for lists, the struct name for struct
+// fields and struct lists, and whether the IDL marks it required.
+type idlField struct {
+	name     string
+	wire     byte
+	elem     byte
+	of       string
+	required bool
+}
+
+// idl transcribes the parquet.thrift structs that a flat file written by
+// this package can contain. Enums are i32 on the wire.
+var idl = map[string]map[int16]idlField{
+	"FileMetaData": {
+		1: {name: "version", wire: wireI32, required: true},
+		2: {name: "schema", wire: wireList, elem: wireStruct, of: "SchemaElement", required: true},
+		3: {name: "num_rows", wire: wireI64, required: true},
+		4: {name: "row_groups", wire: wireList, elem: wireStruct, of: "RowGroup", required: true},
+		6: {name: "created_by", wire: wireBinary},
+	},
+	"SchemaElement": {
+		1:  {name: "type", wire: wireI32},
+		2:  {name: "type_length", wire: wireI32},
+		3:  {name: "repetition_type", wire: wireI32},
+		4:  {name: "name", wire: wireBinary, required: true},
+		5:  {name: "num_children", wire: wireI32},
+		6:  {name: "converted_type", wire: wireI32},
+		10: {name: "logicalType", wire: wireStruct, of: "LogicalType"},
+	},
+	"LogicalType": {
+		8: {name: "TIMESTAMP", wire: wireStruct, of: "TimestampType"},
+	},
+	"TimestampType": {
+		1: {name: "isAdjustedToUTC", wire: wireTrue, required: true},
+		2: {name: "unit", wire: wireStruct, of: "TimeUnit", required: true},
+	},
+	"TimeUnit": {
+		1: {name: "MILLIS", wire: wireStruct, of: "MilliSeconds"},
+		2: {name: "MICROS", wire: wireStruct, of: "MicroSeconds"},
+		3: {name: "NANOS", wire: wireStruct, of: "NanoSeconds"},
+	},
+	"MilliSeconds": {},
+	"MicroSeconds": {},
+	"NanoSeconds":  {},
+	"RowGroup": {
+		1: {name: "columns", wire: wireList, elem: wireStruct, of: "ColumnChunk", required: true},
+		2: {name: "total_byte_size", wire: wireI64, required: true},
+		3: {name: "num_rows", wire: wireI64, required: true},
+	},
+	"ColumnChunk": {
+		1: {name: "file_path", wire: wireBinary},
+		2: {name: "file_offset", wire: wireI64, required: true},
+		3: {name: "meta_data", wire: wireStruct, of: "ColumnMetaData"},
+	},
+	"ColumnMetaData": {
+		1:  {name: "type", wire: wireI32, required: true},
+		2:  {name: "encodings", wire: wireList, elem: wireI32, required: true},
+		3:  {name: "path_in_schema", wire: wireList, elem: wireBinary, required: true},
+		4:  {name: "codec", wire: wireI32, required: true},
+		5:  {name: "num_values", wire: wireI64, required: true},
+		6:  {name: "total_uncompressed_size", wire: wireI64, required: true},
+		7:  {name: "total_compressed_size", wire: wireI64, required: true},
+		9:  {name: "data_page_offset", wire: wireI64, required: true},
+		11: {name: "dictionary_page_offset", wire: wireI64},
+	},
+	"PageHeader": {
+		1: {name: "type", wire: wireI32, required: true},
+		2: {name: "uncompressed_page_size", wire: wireI32, required: true},
+		3: {name: "compressed_page_size", wire: wireI32, required: true},
+		4: {name: "crc", wire: wireI32},
+		5: {name: "data_page_header", wire: wireStruct, of: "DataPageHeader"},
+	},
+	"DataPageHeader": {
+		1: {name: "num_values", wire: wireI32, required: true},
+		2: {name: "encoding", wire: wireI32, required: true},
+		3: {name: "definition_level_encoding", wire: wireI32, required: true},
+		4: {name: "repetition_level_encoding", wire: wireI32, required: true},
+	},
+}
+
+// record is a decoded Thrift struct keyed by field name. Integers decode
+// to int64, binary to string, lists to []any, and structs to record.
+type record map[string]any
+
+// decoder reads the Thrift compact protocol against the idl table.
+type decoder struct {
+	t    *testing.T
+	data []byte
+	pos  int
+}
+
+// uvarint reads an unsigned LEB128 varint.
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		d.t.Fatalf("bad varint at offset %d", d.pos)
+	}
+	d.pos += n
+	return v
+}
+
+// signed reads a zigzag varint.
+func (d *decoder) signed() int64 {
+	v := d.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// next reads one byte.
+func (d *decoder) next() byte {
+	if d.pos >= len(d.data) {
+		d.t.Fatalf("unexpected end of data")
+	}
+	b := d.data[d.pos]
+	d.pos++
+	return b
+}
+
+// read decodes one value of wire type typ. of names the struct type for
+// structs and struct lists; elem is the expected list element type.
+func (d *decoder) read(typ, elem byte, of string) any {
+	switch typ {
+	case wireI32, wireI64:
+		return d.signed()
+	case wireBinary:
+		n := int(d.uvarint())
+		s := string(d.data[d.pos : d.pos+n])
+		d.pos += n
+		return s
+	case wireList:
+		header := d.next()
+		n, got := int(header>>4), header&0x0f
+		if n == 15 {
+			n = int(d.uvarint())
+		}
+		if got != elem {
+			d.t.Fatalf("%s list: expected element type %d, got %d", of, elem, got)
+		}
+		out := make([]any, n)
+		for i := range out {
+			out[i] = d.read(elem, 0, of)
+		}
+		return out
+	case wireStruct:
+		return d.object(of)
+	}
+	d.t.Fatalf("unexpected wire type %d", typ)
+	return nil
+}
+
+// object decodes a struct named name, failing on fields that are not in
+// the IDL, have the wrong wire type, or are required but missing.
+func (d *decoder) object(name string) record {
+	fields, ok := idl[name]
+	if !ok {
+		d.t.Fatalf("no IDL for struct %s", name)
+	}
+
+	out := record{}
+	var id int16
+	for {
+		header := d.next()
+		if header == 0 {
+			break
+		}
+		typ := header & 0x0f
+		if delta := int16(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(d.signed())
+		}
+
+		f, ok := fields[id]
+		if !ok {
+			d.t.Fatalf("%s: unexpected field id %d", name, id)
+		}
+		if typ == wireTrue || typ == wireFalse {
+			if f.wire != wireTrue {
+				d.t.Fatalf("%s.%s: expected wire type %d, got bool", name, f.name, f.wire)
+			}
+			out[f.name] = typ == wireTrue
+			continue
+		}
+		if typ != f.wire {
+			d.t.Fatalf("%s.%s: expected wire type %d, got %d", name, f.name, f.wire, typ)
+		}
+		out[f.name] = d.read(typ, f.elem, f.of)
+	}
+
+	for _, f := range fields {
+		if _, ok := out[f.name]; f.required && !ok {
+			d.t.Fatalf("%s: missing required field %s", name, f.name)
+		}
+	}
+	return out
+}
+
+// column is one column as read back from a file.
+type column struct {
+	name      string
+	physical  int64
+	converted int64
+	values    []uint64
+}
+
+// readFile checks the PAR1 framing, decodes the footer, and reads each
+// column's data pages back as raw 8-byte PLAIN values.
+func readFile(t *testing.T, data []byte) (record, []column) {
+	t.Helper()
+
+	if len(data) < 12 || string(data[:4]) != "PAR1" || string(data[len(data)-4:]) != "PAR1" {
+		t.Fatalf("missing PAR1 magic")
+	}
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footerStart := len(data) - 8 - size
+	d := &decoder{t: t, data: data[:len(data)-8], pos: footerStart}
+	meta := d.object("FileMetaData")
+	if d.pos != len(data)-8 {
+		t.Fatalf("footer length %d does not match its encoding (%d bytes)", size, d.pos-footerStart)
+	}
+
+	schema := meta["schema"].([]any)
+	root := schema[0].(record)
+	leaves := schema[1:]
+	if root["num_children"] != int64(len(leaves)) {
+		t.Fatalf("root has %v children, schema has %d leaves", root["num_children"], len(leaves))
+	}
+
+	var columns []column
+	for _, g := range meta["row_groups"].([]any) {
+		chunks := g.(record)["columns"].([]any)
+		if len(chunks) != len(leaves) {
+			t.Fatalf("row group has %d chunks for %d leaves", len(chunks), len(leaves))
+		}
+		for i, c := range chunks {
+			leaf := leaves[i].(record)
+			cm := c.(record)["meta_data"].(record)
+			if cm["type"] != leaf["type"] {
+				t.Fatalf("column %v: chunk type %v differs from schema type %v", leaf["name"], cm["type"], leaf["type"])
+			}
+			if cm["codec"] != int64(0) {
+				t.Fatalf("column %v: expected UNCOMPRESSED, got codec %v", leaf["name"], cm["codec"])
+			}
+
+			col := column{name: leaf["name"].(string), physical: leaf["type"].(int64)}
+			if v, ok := leaf["converted_type"]; ok {
+				col.converted = v.(int64)
+			} else {
+				col.converted = -1
+			}
+
+			start := int(cm["data_page_offset"].(int64))
+			end := start + int(cm["total_compressed_size"].(int64))
+			for p := start; p < end; {
+				pd := &decoder{t: t, data: data, pos: p}
+				page := pd.object("PageHeader")
+				if page["type"] != int64(0) {
+					t.Fatalf("column %s: expected DATA_PAGE, got %v", col.name, page["type"])
+				}
+				dph := page["data_page_header"].(record)
+				if dph["encoding"] != int64(0) {
+					t.Fatalf("column %s: expected PLAIN, got encoding %v", col.name, dph["encoding"])
+				}
+				n := int(dph["num_values"].(int64))
+				body := data[pd.pos : pd.pos+int(page["compressed_page_size"].(int64))]
+				if len(body) != 8*n {
+					t.Fatalf("column %s: page of %d values has %d bytes", col.name, n, len(body))
+				}
+				for j := range n {
+					col.values = append(col.values, binary.LittleEndian.Uint64(body[8*j:]))
+				}
+				p = pd.pos + len(body)
+			}
+			if int64(len(col.values)) != cm["num_values"].(int64) {
+				t.Fatalf("column %s: read %d values, metadata says %v", col.name, len(col.values), cm["num_values"])
+			}
+			columns = append(columns, col)
+		}
+	}
+
+	return meta, columns
+}
+
+// TestWriteRoundTrip verifies that typed columns survive a write and an
+// independent decode, and that the footer records the row count, physical
+// and converted types, and creator.
+func TestWriteRoundTrip(t *testing.T) {
+	in := []parquet.Column{
+		{Name: "timestamp", Type: parquet.TimestampMillis, Int64s: []int64{1704171600000, 1704258000000, 1704344400000}},
+		{Name: "close", Type: parquet.Double, Doubles: []float64{185.64, 184.25, -0.5}},
+		{Name: "transactions", Type: parquet.Int64, Int64s: []int64{1, 0, -42}},
+	}
+
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, in); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	meta, out := readFile(t, buf.Bytes())
+	if meta["num_rows"] != int64(3) {
+		t.Errorf("expected 3 rows, got %v", meta["num_rows"])
+	}
+	if meta["created_by"] != parquet.CreatedBy {
+		t.Errorf("expected created_by %q, got %v", parquet.CreatedBy, meta["created_by"])
+	}
+
+	// Physical types INT64 = 2 and DOUBLE = 5; converted type
+	// TIMESTAMP_MILLIS = 9, with -1 meaning none.
+	want := []struct {
+		name      string
+		physical  int64
+		converted int64
+		values    string
+	}{
+		{"timestamp", 2, 9, "[1704171600000 1704258000000 1704344400000]"},
+		{"close", 5, -1, "[185.64 184.25 -0.5]"},
+		{"transactions", 2, -1, "[1 0 -42]"},
+	}
+	if len(out) != len(want) {
+		t.Fatalf("expected %d columns, got %d", len(want), len(out))
+	}
+	for i, w := range want {
+		got := out[i]
+		if got.name != w.name || got.physical != w.physical || got.converted != w.converted {
+			t.Errorf("column %d: expected %s/%d/%d, got %s/%d/%d", i, w.name, w.physical, w.converted, got.name, got.physical, got.converted)
+		}
+
+		var values []any
+		for _, v := range got.values {
+			if got.physical == 5 {
+				values = append(values, math.Float64frombits(v))
+			} else {
+				values = append(values, int64(v))
+			}
+		}
+		if fmt.Sprint(values) != w.values {
+			t.Errorf("column %s: expected %s, got %v", w.name, w.values, values)
+		}
+	}
+}
+
+// TestWriteManyRows verifies list and page sizes beyond the short thrift
+// encodings, using more than 15 columns and thousands of rows.
+func TestWriteManyRows(t *testing.T) {
+	var in []parquet.Column
+	for i := range 20 {
+		values := make([]float64, 5000)
+		for j := range values {
+			values[j] = float64(i*10000 + j)
+		}
+		in = append(in, parquet.Column{Name: fmt.Sprintf("c%d", i), Type: parquet.Double, Doubles: values})
+	}
+
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, in); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, out := readFile(t, buf.Bytes())
+	if len(out) != 20 {
+		t.Fatalf("expected 20 columns, got %d", len(out))
+	}
+	if got := math.Float64frombits(out[19].values[4999]); got != 194999 {
+		t.Errorf("expected last value 194999, got %v", got)
+	}
+}
+
+// TestWriteRejectsMismatchedColumns verifies that ragged or unnamed
+// columns are rejected.
+func TestWriteRejectsMismatchedColumns(t *testing.T) {
+	tests := [][]parquet.Column{
+		nil,
+		{{Name: "", Type: parquet.Int64, Int64s: []int64{1}}},
+		{{Name: "a", Type: parquet.Int64, Int64s: []int64{1}}, {Name: "b", Type: parquet.Double, Doubles: []float64{1, 2}}},
+	}
+	for i, columns := range tests {
+		if err := parquet.Write(&bytes.Buffer{}, columns); err == nil {
+			t.Errorf("case %d: expected an error", i)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Thrift compact protocol type ids used in Parquet metadata.
+const (
+	thriftBoolTrue  = 1
+	thriftBoolFalse = 2
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftStruct    = 12
+)
+
+// thriftWriter encodes the Thrift compact protocol, the serialization
+// Parquet uses for page headers and the file footer. Only the subset
+// needed by this package is implemented: i32, i64, bool, binary, lists,
+// and nested structs. lastID tracks the previous field id of each open
+// struct so field headers can use the short delta form.
+type thriftWriter struct {
+	buf    bytes.Buffer
+	lastID []int16
+}
+
+// newThriftWriter returns a writer positioned inside a top-level struct.
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{lastID: []int16{0}}
+}
+
+// varint writes an unsigned LEB128 varint.
+func (t *thriftWriter) varint(v uint64) {
+	t.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+// zigzag writes a signed value as a zigzag-encoded varint.
+func (t *thriftWriter) zigzag(v int64) {
+	t.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+// field writes the header of field id with the given compact type,
+// using the one-byte delta form when the id follows closely on the
+// previous field of the current struct.
+func (t *thriftWriter) field(id int16, typ byte) {
+	top := len(t.lastID) - 1
+	if delta := id - t.lastID[top]; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.zigzag(int64(id))
+	}
+	t.lastID[top] = id
+}
+
+// i32 writes an i32 field.
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.zigzag(int64(v))
+}
+
+// i64 writes an i64 field.
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.zigzag(v)
+}
+
+// bool writes a bool field, whose value is carried in the header type.
+func (t *thriftWriter) bool(id int16, v bool) {
+	if v {
+		t.field(id, thriftBoolTrue)
+	} else {
+		t.field(id, thriftBoolFalse)
+	}
+}
+
+// string writes a binary field holding s.
+func (t *thriftWriter) string(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.rawString(s)
+}
+
+// rawString writes a length-prefixed string without a field header, as
+// used for list elements.
+func (t *thriftWriter) rawString(s string) {
+	t.varint(uint64(len(s)))
+	t.buf.WriteString(s)
+}
+
+// list writes the header of a list field of n elements of type elem.
+// The caller writes the elements next, using the raw* helpers or
+// beginElem/end for structs.
+func (t *thriftWriter) list(id int16, elem byte, n int) {
+	t.field(id, thriftList)
+	if n < 15 {
+		t.buf.WriteByte(byte(n)<<4 | elem)
+	} else {
+		t.buf.WriteByte(0xf0 | elem)
+		t.varint(uint64(n))
+	}
+}
+
+// begin opens a nested struct field. Close it with end.
+func (t *thriftWriter) begin(id int16) {
+	t.field(id, thriftStruct)
+	t.lastID = append(t.lastID, 0)
+}
+
+// beginElem opens a struct that is a list element and so has no field
+// header. Close it with end.
+func (t *thriftWriter) beginElem() {
+	t.lastID = append(t.lastID, 0)
+}
+
+// end writes the stop byte of the innermost open struct and closes it.
+func (t *thriftWriter) end() {
+	t.buf.WriteByte(0)
+	t.lastID = t.lastID[:len(t.lastID)-1]
+}
+
+// bytes closes the top-level struct and returns the encoding.
+func (t *thriftWriter) bytes() []byte {
+	t.end()
+	return t.buf.Bytes()
+}
@@ -0,0 +1,33 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package version
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Build metadata injected at build time via -ldflags, e.g.
+// -X github.com/cloudmanic/massive-cli/internal/version.Version=v1.2.3.
+// The defaults identify local development builds.
+var (
+	Version = "dev"
+	Commit  = "none"
+	Date    = "unknown"
+)
+
+// GoVersion returns the Go toolchain version the binary was built with.
+func GoVersion() string {
+	return runtime.Version()
+}
+
+// String returns the build metadata as printed by `massive version` and
+// --version: the version line first, then commit, build date, and the Go
+// version with the target platform.
+func String() string {
+	return fmt.Sprintf("massive version %s\ncommit: %s\nbuilt: %s\ngo: %s %s/%s\n",
+		Version, Commit, Date, GoVersion(), runtime.GOOS, runtime.GOARCH)
+}
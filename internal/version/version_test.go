@@ -0,0 +1,31 @@
+//
+// Date: 2026-10-16
+// Copyright (c) 2026. All rights reserved.
+//
+
+package version
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestString verifies that the version text leads with the version line
+// and includes the commit, build date, and Go version.
+func TestString(t *testing.T) {
+	origVersion, origCommit, origDate := Version, Commit, Date
+	t.Cleanup(func() { Version, Commit, Date = origVersion, origCommit, origDate })
+
+	Version, Commit, Date = "v1.2.3", "abc1234", "2026-10-16T12:00:00Z"
+	s := String()
+
+	if !strings.HasPrefix(s, "massive version v1.2.3\n") {
+		t.Errorf("expected version line first, got %q", s)
+	}
+	for _, want := range []string{"commit: abc1234", "built: 2026-10-16T12:00:00Z", "go: " + runtime.Version()} {
+		if !strings.Contains(s, want) {
+			t.Errorf("expected %q in %q", want, s)
+		}
+	}
+}